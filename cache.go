@@ -0,0 +1,106 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// defaultCacheDir is the directory, relative to the scanned one, where
+// the interfaces parsed from each file are cached between runs.
+const defaultCacheDir = ".gsmock-cache"
+
+// cacheEntry is the cached result of scanning a file.
+type cacheEntry struct {
+	Interfaces []Interface
+	Warnings   string
+}
+
+// scanFileCached is like scanFile, but reuses the result of a previous
+// scan if neither the file nor the scan options changed since.
+//
+// Entries are keyed by a hash of the tool version, the scan options,
+// the file path and its content, so a new tool version or any edit
+// invalidates them. Cache errors are not fatal: the file is scanned again.
+func scanFileCached(ctx scanContext, file string) []Interface {
+	if ctx.CacheDir == "" {
+		return scanFile(ctx, file)
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		panic(fmt.Errorf("error reading file(%s): %w", file, err))
+	}
+	if ctx.FuncVars && bytes.Contains(content, []byte(funcVarDirective)) {
+		return scanFile(ctx, file) // the types of function variables may be declared in other files
+	}
+	entryFile := filepath.Join(ctx.CacheDir, cacheKey(ctx, file, content)+".json")
+
+	if b, err := os.ReadFile(entryFile); err == nil {
+		var e cacheEntry
+		if err = json.Unmarshal(b, &e); err == nil {
+			_, _ = io.WriteString(stdErr, e.Warnings)
+			return e.Interfaces
+		}
+	}
+
+	// Capture the warnings so that they are reported on cache hits too
+	warnings := bytes.NewBuffer(nil)
+	oldErr := stdErr
+	stdErr = io.MultiWriter(oldErr, warnings)
+	defer func() { stdErr = oldErr }()
+
+	ret := scanFile(ctx, file)
+	if b, err := json.Marshal(cacheEntry{Interfaces: ret, Warnings: warnings.String()}); err == nil {
+		writeCacheEntry(ctx.CacheDir, entryFile, b)
+	}
+	return ret
+}
+
+// cacheKey returns the cache key of scanning file with the given content.
+func cacheKey(ctx scanContext, file string, content []byte) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\n%s\n%s\n", ToolVersion, file, ctx.Qualifier+" "+ctx.QualifierPath)
+	_, _ = fmt.Fprintf(h, "%t %t\n", ctx.GRPCServices, ctx.SkipBroken)
+	_, _ = fmt.Fprintf(h, "%s\n", strings.Join(slices.Sorted(maps.Keys(ctx.IncludeInterfaces)), ","))
+	_, _ = fmt.Fprintf(h, "%s\n", strings.Join(slices.Sorted(maps.Keys(ctx.ExcludeInterfaces)), ","))
+	_, _ = fmt.Fprintf(h, "%s\n", strings.Join(slices.Sorted(maps.Keys(ctx.Funcs)), ","))
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeCacheEntry stores a cache entry, creating the cache directory with
+// a .gitignore file if needed. Failures only cost a scan on the next run.
+func writeCacheEntry(cacheDir, entryFile string, b []byte) {
+	if err := os.MkdirAll(cacheDir, os.ModePerm); err != nil {
+		return
+	}
+	ignoreFile := filepath.Join(cacheDir, ".gitignore")
+	if _, err := os.Stat(ignoreFile); os.IsNotExist(err) {
+		_ = os.WriteFile(ignoreFile, []byte("*\n"), 0644)
+	}
+	_ = os.WriteFile(entryFile, b, 0644)
+}
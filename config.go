@@ -0,0 +1,154 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultConfigFile is the configuration file of a project, usually at
+// the root of its repository.
+const defaultConfigFile = ".gsmock.yaml"
+
+// projectConfig is the configuration file of a project, which lists the
+// mocks generated by a single "gs-mock --config" run instead of go:generate
+// lines. The options at the top level apply to all mocks not setting them.
+type projectConfig struct {
+	MockSuffix    string       `yaml:"mock-suffix"`
+	GoVersion     string       `yaml:"go-version"`
+	Registry      string       `yaml:"registry"`
+	ImportAliases []string     `yaml:"import-aliases"`
+	NoCache       bool         `yaml:"no-cache"`
+	Mocks         []mockConfig `yaml:"mocks"`
+}
+
+// mockConfig describes the generation of an output file, with the options
+// of the flags of the same names. Directories are relative to the
+// configuration file.
+type mockConfig struct {
+	Dir           string   `yaml:"dir"` // directory of the scanned package, the default "." if empty
+	Output        string   `yaml:"output"`
+	Interfaces    string   `yaml:"interfaces"`
+	DestDir       string   `yaml:"dest-dir"`
+	Pkg           string   `yaml:"pkg"`
+	Source        string   `yaml:"source"`
+	ForDeps       string   `yaml:"for-deps"`
+	Funcs         string   `yaml:"funcs"`
+	Instantiate   string   `yaml:"instantiate"`
+	Subsets       []string `yaml:"subsets"`
+	GRPCServices  bool     `yaml:"grpc-services"`
+	AllowEmpty    bool     `yaml:"allow-empty"`
+	SkipBroken    bool     `yaml:"skip-broken"`
+	MockSuffix    string   `yaml:"mock-suffix"`
+	GoVersion     string   `yaml:"go-version"`
+	ImportAliases []string `yaml:"import-aliases"`
+}
+
+// loadConfig reads a project configuration file. Unknown options are
+// rejected, so that misspelled ones don't go unnoticed.
+func loadConfig(file string) projectConfig {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		panic(fmt.Errorf("error reading config(%s): %w", file, err))
+	}
+	var c projectConfig
+	d := yaml.NewDecoder(bytes.NewReader(b))
+	d.KnownFields(true)
+	if err = d.Decode(&c); err != nil && !errors.Is(err, io.EOF) {
+		panic(fmt.Errorf("error parsing config(%s): %w", file, err))
+	}
+	return c
+}
+
+// runConfigs returns the generator configurations of the mocks listed by
+// the project configuration c, read from file.
+func (c projectConfig) runConfigs(file string) []runConfig {
+	if len(c.Mocks) == 0 {
+		panic(fmt.Sprintf("no mocks listed in config(%s)", file))
+	}
+	root := filepath.Dir(file)
+	relative := func(dir string) string {
+		if dir == "" || filepath.IsAbs(dir) {
+			return dir
+		}
+		return filepath.Join(root, dir)
+	}
+	cacheDir := defaultCacheDir
+	if c.NoCache {
+		cacheDir = ""
+	}
+	var ret []runConfig
+	for k, m := range c.Mocks {
+		if m.Output == "" {
+			panic(fmt.Sprintf("mocks[%d] of config(%s) has no output", k, file))
+		}
+		param := runConfig{
+			SourceDir:      relative(m.Dir),
+			OutputFile:     m.Output,
+			DestDir:        relative(m.DestDir),
+			Pkg:            m.Pkg,
+			Source:         m.Source,
+			GoVersion:      m.GoVersion,
+			MockInterfaces: m.Interfaces,
+			ImportAliases:  m.ImportAliases,
+			ForDeps:        m.ForDeps,
+			GRPCServices:   m.GRPCServices,
+			CacheDir:       cacheDir,
+			AllowEmpty:     m.AllowEmpty,
+			SkipBroken:     m.SkipBroken,
+			Registry:       relative(c.Registry),
+			Instantiate:    m.Instantiate,
+			Subsets:        m.Subsets,
+			Funcs:          m.Funcs,
+			MockSuffix:     m.MockSuffix,
+		}
+		if param.SourceDir == "" {
+			param.SourceDir = root
+		}
+		if param.GoVersion == "" {
+			param.GoVersion = c.GoVersion
+		}
+		if param.MockSuffix == "" {
+			param.MockSuffix = c.MockSuffix
+		}
+		if param.ImportAliases == nil {
+			param.ImportAliases = c.ImportAliases
+		}
+		ret = append(ret, param)
+	}
+	return ret
+}
+
+// runProject generates the mocks listed by the project configuration file,
+// and reports the files written.
+func runProject(file string) {
+	for _, param := range loadConfig(file).runConfigs(file) {
+		run(param)
+		dir := param.SourceDir
+		if len(param.DestDir) > 0 {
+			dir = param.DestDir
+		}
+		_, _ = fmt.Fprintf(stdOut, "generated %s\n", filepath.Join(dir, param.OutputFile))
+	}
+}
@@ -0,0 +1,326 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// scanDeps returns the interfaces to be mocked for the dependencies of the
+// given structs in dir: the interface types of their fields, whether they
+// are declared in the same package or imported from other packages.
+// Interfaces of other packages are mocked in the package of the structs,
+// with their types qualified by the name of the declaring package.
+func scanDeps(dir string, ctx scanContext, structNames []string) []Interface {
+	var (
+		pkgName    string
+		localNames []string
+		depNames   = make(map[string][]string) // import path => interface names
+	)
+
+	// Struct names may be qualified by the package name, e.g. "app.Server".
+	qualifiers := make(map[string]string)
+	for k, name := range structNames {
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			structNames[k] = name[i+1:]
+			qualifiers[name[i+1:]] = name[:i]
+		}
+	}
+
+	found := make(map[string]bool)
+	var files []string // the files parsed, without the skipped broken ones
+	for _, file := range goFiles(dir, ctx.OutputFile) {
+		node := ctx.parseFile(file, parser.AllErrors)
+		if node == nil {
+			continue
+		}
+		files = append(files, file)
+		pkgName = node.Name.Name
+		imports := importNames(node)
+		for _, decl := range node.Decls {
+			d, ok := decl.(*ast.GenDecl)
+			if !ok || d.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range d.Specs {
+				s := spec.(*ast.TypeSpec)
+				t, ok := s.Type.(*ast.StructType)
+				if !ok || !slices.Contains(structNames, s.Name.Name) {
+					continue
+				}
+				found[s.Name.Name] = true
+				for _, field := range t.Fields.List {
+					typ := field.Type
+					switch x := typ.(type) {
+					case *ast.IndexExpr:
+						typ = x.X
+					case *ast.IndexListExpr:
+						typ = x.X
+					}
+					switch x := typ.(type) {
+					case *ast.Ident:
+						if ctx.mock(x.Name) && !slices.Contains(localNames, x.Name) {
+							localNames = append(localNames, x.Name)
+						}
+					case *ast.SelectorExpr:
+						pkg, ok := x.X.(*ast.Ident)
+						if !ok || !ctx.mock(x.Sel.Name) {
+							continue
+						}
+						pkgPath, ok := imports[pkg.Name]
+						if !ok {
+							continue
+						}
+						if !slices.Contains(depNames[pkgPath], x.Sel.Name) {
+							depNames[pkgPath] = append(depNames[pkgPath], x.Sel.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for _, name := range structNames {
+		if !found[name] {
+			panic(fmt.Sprintf("struct %s not found", name))
+		}
+		if q, ok := qualifiers[name]; ok && q != pkgName {
+			panic(fmt.Sprintf("struct %s.%s not found in package %s", q, name, pkgName))
+		}
+	}
+
+	var ret []Interface
+	if len(localNames) > 0 {
+		c := ctx
+		c.IncludeInterfaces = toSet(localNames)
+		for _, file := range files {
+			ret = append(ret, scanFileCached(c, file)...)
+		}
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		panic(fmt.Errorf("error resolving directory(%s): %w", dir, err))
+	}
+	for _, pkgPath := range slices.Sorted(maps.Keys(depNames)) {
+		bp, err := build.Import(pkgPath, absDir, 0)
+		if err != nil {
+			panic(fmt.Errorf("error importing package(%s): %w", pkgPath, err))
+		}
+		c := ctx
+		c.IncludeInterfaces = toSet(depNames[pkgPath])
+		c.Funcs = nil // only those of the scanned package
+		c.Qualifier = bp.Name
+		c.QualifierPath = pkgPath
+		for _, f := range bp.GoFiles {
+			for _, i := range scanFileCached(c, filepath.Join(bp.Dir, f)) {
+				i.Package = pkgName
+				i.PkgPath = pkgPath
+				ret = append(ret, i)
+			}
+		}
+	}
+
+	if len(ret) == 0 {
+		panic(fmt.Sprintf("no interface dependencies found in %s", strings.Join(structNames, ", ")))
+	}
+	mockNames := make(map[string]bool)
+	for _, i := range ret {
+		if mockNames[i.Name] {
+			panic(fmt.Sprintf("duplicate mock name %sMockImpl", i.Name))
+		}
+		mockNames[i.Name] = true
+	}
+	return ret
+}
+
+// goFiles returns the Go source files of dir that are scanned for
+// interfaces, skipping test files and the generated output file.
+func goFiles(dir string, outputFile string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		panic(fmt.Errorf("error reading directory: %w", err))
+	}
+	var ret []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".go" {
+			continue
+		}
+		if strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+		if entry.Name() == outputFile {
+			continue
+		}
+		ret = append(ret, filepath.Join(dir, entry.Name()))
+	}
+	return ret
+}
+
+// importNames returns the imports of a file as a map of local name => import path.
+func importNames(node *ast.File) map[string]string {
+	ret := make(map[string]string)
+	for _, spec := range node.Imports {
+		pkgPath := strings.Trim(spec.Path.Value, "\"")
+		if spec.Name != nil {
+			ret[spec.Name.Name] = pkgPath
+		} else {
+			ret[assumedPkgName(pkgPath)] = pkgPath
+		}
+	}
+	return ret
+}
+
+// assumedPkgName returns the name of the package imported by pkgPath
+// without an alias, assumed from the path as the packages are not loaded:
+// its last element, skipping a major version suffix ("foo/v2" gives "foo"),
+// without a "go-" prefix and from the first character that is not allowed
+// in identifiers ("gopkg.in/yaml.v3" gives "yaml", "go-result" "result").
+func assumedPkgName(pkgPath string) string {
+	name := path.Base(pkgPath)
+	if v := strings.TrimPrefix(name, "v"); v != name && v != "" && strings.Trim(v, "0123456789") == "" {
+		if dir := path.Dir(pkgPath); dir != "." {
+			name = path.Base(dir)
+		}
+	}
+	name = strings.TrimPrefix(name, "go-")
+	if i := strings.IndexFunc(name, func(c rune) bool {
+		return c != '_' && !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	}); i > 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// qualifyTypes qualifies the identifiers of the types used by interface s,
+// which is declared in package pkg, so that they can be referenced from
+// another package. It panics if s refers to unexported names of pkg.
+func qualifyTypes(s *ast.TypeSpec, pkg string) {
+	typeParams := make(map[string]struct{})
+	if s.TypeParams != nil {
+		for _, f := range s.TypeParams.List {
+			for _, n := range f.Names {
+				typeParams[n.Name] = struct{}{}
+			}
+		}
+	}
+	q := qualifier{iface: s.Name.Name, pkg: pkg, typeParams: typeParams}
+	if s.TypeParams != nil {
+		q.fieldList(s.TypeParams)
+	}
+	t := s.Type.(*ast.InterfaceType)
+	for _, m := range t.Methods.List {
+		if len(m.Names) > 0 && !ast.IsExported(m.Names[0].Name) {
+			panic(fmt.Sprintf("cannot mock %s.%s: unexported method %s", pkg, q.iface, m.Names[0].Name))
+		}
+	}
+	q.fieldList(t.Methods)
+}
+
+// qualifier rewrites the identifiers of a package's types
+// into selector expressions qualified by the package name.
+type qualifier struct {
+	iface      string
+	pkg        string
+	typeParams map[string]struct{}
+}
+
+// fieldList qualifies the types of all fields in l.
+func (q qualifier) fieldList(l *ast.FieldList) {
+	if l == nil {
+		return
+	}
+	for _, f := range l.List {
+		f.Type = q.expr(f.Type)
+	}
+}
+
+// expr returns e with the identifiers of the package's types qualified.
+func (q qualifier) expr(e ast.Expr) ast.Expr {
+	switch x := e.(type) {
+	case *ast.Ident:
+		if _, ok := q.typeParams[x.Name]; ok {
+			return x
+		}
+		if types.Universe.Lookup(x.Name) != nil {
+			return x
+		}
+		if !ast.IsExported(x.Name) {
+			panic(fmt.Sprintf("cannot mock %s.%s: unexported type %s", q.pkg, q.iface, x.Name))
+		}
+		return &ast.SelectorExpr{X: ast.NewIdent(q.pkg), Sel: x}
+	case *ast.StarExpr:
+		x.X = q.expr(x.X)
+	case *ast.ParenExpr:
+		x.X = q.expr(x.X)
+	case *ast.Ellipsis:
+		x.Elt = q.expr(x.Elt)
+	case *ast.ArrayType:
+		if x.Len != nil {
+			x.Len = q.expr(x.Len)
+		}
+		x.Elt = q.expr(x.Elt)
+	case *ast.MapType:
+		x.Key = q.expr(x.Key)
+		x.Value = q.expr(x.Value)
+	case *ast.ChanType:
+		x.Value = q.expr(x.Value)
+	case *ast.FuncType:
+		q.fieldList(x.TypeParams)
+		q.fieldList(x.Params)
+		q.fieldList(x.Results)
+	case *ast.InterfaceType:
+		q.fieldList(x.Methods)
+	case *ast.StructType:
+		q.fieldList(x.Fields)
+	case *ast.IndexExpr:
+		x.X = q.expr(x.X)
+		x.Index = q.expr(x.Index)
+	case *ast.IndexListExpr:
+		x.X = q.expr(x.X)
+		for k := range x.Indices {
+			x.Indices[k] = q.expr(x.Indices[k])
+		}
+	case *ast.UnaryExpr: // ~T in constraints
+		x.X = q.expr(x.X)
+	case *ast.BinaryExpr: // A | B in constraints
+		x.X = q.expr(x.X)
+		x.Y = q.expr(x.Y)
+	}
+	return e
+}
+
+// toSet converts a slice of names into a set.
+func toSet(names []string) map[string]struct{} {
+	ret := make(map[string]struct{})
+	for _, n := range names {
+		ret[n] = struct{}{}
+	}
+	return ret
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// scanDest scans the interfaces of the package in srcDir for mocks
+// generated into the package in destDir. The types of the source package
+// are then qualified by its import path as seen from destDir, and its
+// unexported interfaces are skipped. A destDir holding the source package
+// itself is scanned as usual, so that the package never imports itself.
+func scanDest(srcDir string, destDir string, ctx scanContext) []Interface {
+	srcPath := importPathFrom(srcDir, destDir)
+	if srcPath == importPathOf(destDir) {
+		return scanDir(srcDir, ctx)
+	}
+	c := ctx
+	c.OutputFile = "" // relative to destDir
+	c.Qualifier = packageName(srcDir, "")
+	c.QualifierPath = srcPath
+	destPkg := destPackageName(destDir, ctx.OutputFile)
+	var ret []Interface
+	for _, i := range scanDir(srcDir, c) {
+		i.Package = destPkg
+		i.PkgPath = srcPath
+		ret = append(ret, i)
+	}
+	return ret
+}
+
+// destPackageName returns the name of the package in destDir, which is
+// named after the directory if it has no Go files yet.
+func destPackageName(destDir string, outputFile string) string {
+	if _, err := os.Stat(destDir); err == nil && len(goFiles(destDir, outputFile)) > 0 {
+		return packageName(destDir, outputFile)
+	}
+	absDir, err := filepath.Abs(destDir)
+	if err != nil {
+		panic(fmt.Errorf("error resolving directory(%s): %w", destDir, err))
+	}
+	return assumedPkgName(filepath.ToSlash(absDir))
+}
@@ -0,0 +1,302 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"go/types"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Delegate describes an interface of another package embedded by a mocked
+// interface. Its methods are delegated to a field of the mock, which is set
+// by a setter and panics with a descriptive message while it is nil.
+type Delegate struct {
+	Field   string           // Name of the field holding the implementation
+	Type    string           // Embedded interface type (e.g., "io.Writer")
+	Methods []DelegateMethod // Methods of the embedded interface
+}
+
+// DelegateMethod describes a method delegated to the field of a Delegate.
+type DelegateMethod struct {
+	Name    string // Method name
+	Params  string // Method parameters as string (e.g., "p []byte")
+	Args    string // Arguments of the delegated call (e.g., "p")
+	Results string // Return types as a string (e.g., "(int, error)")
+}
+
+// qualifiedType matches the embedded interfaces of other packages which
+// can be delegated, i.e. the non-generic ones.
+var qualifiedType = regexp.MustCompile(`^(\w+)\.(\w+)$`)
+
+// resolveDelegates replaces the interfaces of other packages embedded by
+// the mocks, which would otherwise be nil interface fields, by delegates.
+// Their methods are resolved by loading the packages type-checked from
+// dir; embedded interfaces whose methods can't be resolved, e.g. those
+// referring to unexported names, remain embedded.
+func resolveDelegates(interfaces []Interface, dir string) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		panic(fmt.Errorf("error resolving directory(%s): %w", dir, err))
+	}
+	l := newPackageLoader(absDir)
+	for k := range interfaces {
+		i := &interfaces[k]
+		if i.MockPackage != "" || i.EmbedInterfaces == "" {
+			continue
+		}
+		names := make(map[string]struct{}) // method names of the mock
+		for _, m := range i.Methods {
+			names[m.Name] = struct{}{}
+		}
+		var embeds strings.Builder
+		for line := range strings.SplitSeq(i.EmbedInterfaces, "\n") {
+			embed := strings.TrimSpace(line)
+			if embed == "" {
+				continue
+			}
+			if d, ok := newDelegate(i, embed, l, names); ok {
+				i.Delegates = append(i.Delegates, d)
+				continue
+			}
+			embeds.WriteString("\t" + embed + "\n")
+		}
+		i.EmbedInterfaces = embeds.String()
+	}
+}
+
+// newDelegate returns the delegate of the interface embedded by i, and
+// whether it could be resolved without colliding with the names in use,
+// which it then reserves, along with the imports it needs.
+func newDelegate(i *Interface, embed string, l *packageLoader, names map[string]struct{}) (Delegate, bool) {
+	m := qualifiedType.FindStringSubmatch(embed)
+	if m == nil || embed == i.SelfType {
+		return Delegate{}, false
+	}
+	pkgName, typeName := m[1], m[2]
+	pkgPath, ok := i.Imports[pkgName]
+	if !ok {
+		return Delegate{}, false
+	}
+	for _, n := range []string{typeName, "Set" + typeName} {
+		if _, ok := names[n]; ok {
+			return Delegate{}, false
+		}
+	}
+	r := &delegateResolver{pkgPath: pkgPath, pkgName: pkgName, imports: map[string]string{pkgName: pkgPath}}
+	methods, ok := r.methods(l.load(pkgPath), typeName)
+	if !ok {
+		return Delegate{}, false
+	}
+
+	d := Delegate{Field: typeName, Type: embed}
+	reserved := map[string]struct{}{"impl": {}}
+	for _, f := range methods {
+		name := f.Name()
+		if _, ok := names[name]; ok {
+			continue // mocked, or delegated to a previous field
+		}
+		sig := f.Signature()
+		var paramNames, paramTypes []string
+		for k := range sig.Params().Len() {
+			v := sig.Params().At(k)
+			t := v.Type()
+			prefix := ""
+			if sig.Variadic() && k == sig.Params().Len()-1 {
+				t, prefix = t.(*types.Slice).Elem(), "..."
+			}
+			n := v.Name()
+			if n == "_" {
+				n = ""
+			}
+			paramNames = append(paramNames, n)
+			paramTypes = append(paramTypes, prefix+r.typeText(t))
+		}
+		paramNames = uniqueParamNames(paramNames, reserved)
+		var params []string
+		for k, n := range paramNames {
+			params = append(params, n+" "+paramTypes[k])
+		}
+		args := strings.Join(paramNames, ", ")
+		if sig.Variadic() {
+			args += "..."
+		}
+		var results []string
+		for v := range sig.Results().Variables() {
+			results = append(results, r.typeText(v.Type()))
+		}
+		method := DelegateMethod{
+			Name:   name,
+			Params: strings.Join(params, ", "),
+			Args:   args,
+		}
+		if len(results) > 0 {
+			method.Results = "(" + strings.Join(results, ", ") + ")"
+		}
+		d.Methods = append(d.Methods, method)
+		names[name] = struct{}{}
+	}
+	if r.conflict {
+		return Delegate{}, false
+	}
+	for name, path := range r.imports {
+		if p, ok := i.Imports[name]; ok && p != path {
+			return Delegate{}, false
+		}
+	}
+
+	names[d.Field] = struct{}{}
+	names["Set"+d.Field] = struct{}{}
+	for name, path := range r.imports {
+		i.Imports[name] = path
+	}
+	return d, true
+}
+
+// delegateResolver resolves the method set of an interface of another
+// package, collecting the imports its qualified types need.
+type delegateResolver struct {
+	pkgPath  string            // import path of the package of the interface
+	pkgName  string            // name qualifying the types of that package
+	imports  map[string]string // package name => import path
+	conflict bool              // whether a package name denotes two paths
+}
+
+// methods returns the methods of interface typeName of package p,
+// followed by those of the interfaces it embeds, in declaration order.
+// It returns false if p is nil, if the interface is generic, or if it
+// has methods or refers to types that are not exported.
+func (r *delegateResolver) methods(p *types.Package, typeName string) ([]*types.Func, bool) {
+	if p == nil {
+		return nil, false
+	}
+	obj, ok := p.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return nil, false
+	}
+	t := types.Unalias(obj.Type())
+	if n, ok := t.(*types.Named); ok && n.TypeParams().Len() > 0 {
+		return nil, false
+	}
+	iface, ok := t.Underlying().(*types.Interface)
+	if !ok {
+		return nil, false
+	}
+	for f := range iface.Methods() {
+		if !f.Exported() || !exportedType(f.Type()) {
+			return nil, false
+		}
+	}
+	return declaredMethods(iface, make(map[string]bool)), true
+}
+
+// declaredMethods returns the methods of iface not in seen, those declared
+// by iface in source order followed by those of its embedded interfaces.
+func declaredMethods(iface *types.Interface, seen map[string]bool) []*types.Func {
+	explicit := slices.Collect(iface.ExplicitMethods())
+	slices.SortFunc(explicit, func(a, b *types.Func) int {
+		return cmp.Compare(a.Pos(), b.Pos())
+	})
+	var ret []*types.Func
+	for _, f := range explicit {
+		if !seen[f.Name()] {
+			seen[f.Name()] = true
+			ret = append(ret, f)
+		}
+	}
+	for t := range iface.EmbeddedTypes() {
+		if e, ok := t.Underlying().(*types.Interface); ok {
+			ret = append(ret, declaredMethods(e, seen)...)
+		}
+	}
+	return ret
+}
+
+// typeText returns the text of t, with the types of the package of the
+// interface qualified by its name in the mocked file, and those of other
+// packages by their own names, which are collected as imports.
+func (r *delegateResolver) typeText(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		name := p.Name()
+		if p.Path() == r.pkgPath {
+			name = r.pkgName
+		}
+		if path, ok := r.imports[name]; ok && path != p.Path() {
+			r.conflict = true
+		}
+		r.imports[name] = p.Path()
+		return name
+	})
+}
+
+// exportedType reports whether t only refers to names another package
+// can refer to, i.e. to no unexported types, fields or methods.
+func exportedType(t types.Type) bool {
+	switch t := t.(type) {
+	case *types.Named:
+		if t.Obj().Pkg() != nil && !t.Obj().Exported() {
+			return false
+		}
+		for a := range t.TypeArgs().Types() {
+			if !exportedType(a) {
+				return false
+			}
+		}
+	case *types.Alias:
+		return t.Obj().Pkg() == nil || t.Obj().Exported() && exportedType(types.Unalias(t))
+	case *types.Pointer:
+		return exportedType(t.Elem())
+	case *types.Slice:
+		return exportedType(t.Elem())
+	case *types.Array:
+		return exportedType(t.Elem())
+	case *types.Chan:
+		return exportedType(t.Elem())
+	case *types.Map:
+		return exportedType(t.Key()) && exportedType(t.Elem())
+	case *types.Signature:
+		for v := range t.Params().Variables() {
+			if !exportedType(v.Type()) {
+				return false
+			}
+		}
+		for v := range t.Results().Variables() {
+			if !exportedType(v.Type()) {
+				return false
+			}
+		}
+	case *types.Struct:
+		for f := range t.Fields() {
+			if !f.Exported() || !exportedType(f.Type()) {
+				return false
+			}
+		}
+	case *types.Interface:
+		for f := range t.Methods() {
+			if !f.Exported() || !exportedType(f.Type()) {
+				return false
+			}
+		}
+	case *types.TypeParam:
+		return false
+	}
+	return true
+}
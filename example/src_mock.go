@@ -1,6 +1,6 @@
-// Code generated by gs-mock v0.0.8. DO NOT EDIT.
+// Code generated by gs-mock v0.0.9. DO NOT EDIT.
 // Tool: https://github.com/go-spring/gs-mock
-// gs mock -o src_mock.go -i '!RepositoryV2,,GenericService,Service,,Repository'
+// gs mock -o src_mock.go -i '!RepositoryV2,,GenericService,Service,,Repository,Query,now'
 
 package example
 
@@ -10,6 +10,7 @@ import (
 	"github.com/go-spring/gs-mock/gsmock"
 	"io"
 	"net/http"
+	"time"
 )
 
 // RepositoryMockImpl is a generated mock implementation of the Repository interface.
@@ -17,24 +18,59 @@ type RepositoryMockImpl[T ~int | ~uint, Req *http.Request] struct {
 	r *gsmock.Manager
 }
 
+// Names of the mocked methods of Repository, as reported by diagnostics and
+// transcripts, and as looked up by gsmock.Manager.CallsByName.
+const (
+	RepositoryMethodFindByID = "FindByID"
+	RepositoryMethodSave     = "Save"
+)
+
 // NewRepositoryMockImpl creates a new mock instance for Repository with the given
 // gsmock.Manager. Returns an initialized struct ready for registering mock behavior.
+// It fails fast if the gsmock runtime is incompatible with the generated code.
 func NewRepositoryMockImpl[T ~int | ~uint, Req *http.Request](r *gsmock.Manager) *RepositoryMockImpl[T, Req] {
+	r.RequireVersion("v0.0.9")
+	gsmock.RegisterStamp[Repository[T, Req]]("d3c0ccfb")
 	return &RepositoryMockImpl[T, Req]{r: r}
 }
 
+// RepositoryStubs holds optional implementations of the methods of Repository,
+// registered at once by ApplyStubs.
+type RepositoryStubs[T ~int | ~uint, Req *http.Request] struct {
+	FindByID func(id string) (T, error)
+	Save     func(item T) error
+}
+
+// ApplyStubs registers the non-nil functions of stubs as the Handle mocks
+// of their methods.
+func (impl *RepositoryMockImpl[T, Req]) ApplyStubs(stubs RepositoryStubs[T, Req]) {
+	if stubs.FindByID != nil {
+		impl.MockFindByID().Handle(stubs.FindByID)
+	}
+	if stubs.Save != nil {
+		impl.MockSave().Handle(stubs.Save)
+	}
+}
+
 //go:noinline
 func (impl *RepositoryMockImpl[T, Req]) funcFindByID() func(id string) (T, error) {
 	return impl.FindByID
 }
 
-// FindByID calls the registered mock for FindByID via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// FindByID calls the registered mock for FindByID via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *RepositoryMockImpl[T, Req]) FindByID(id string) (T, error) {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcFindByID(), id); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcFindByID(), gsmock.Box(id)); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox2[T, error](ret)
 	}
-	panic("no mock code matched for RepositoryMockImpl.FindByID")
+	panic(gsmock.Unmatched[Repository[T, Req]]("RepositoryMockImpl."+RepositoryMethodFindByID, "d3c0ccfb"))
+}
+
+// ExpectNoFindByID forbids any call to FindByID: if one occurs, the test
+// fails immediately. Mocks of FindByID registered earlier take precedence.
+func (impl *RepositoryMockImpl[T, Req]) ExpectNoFindByID() {
+	impl.MockFindByID().Never()
 }
 
 // MockFindByID returns a Mocker12
@@ -48,13 +84,20 @@ func (impl *RepositoryMockImpl[T, Req]) funcSave() func(item T) error {
 	return impl.Save
 }
 
-// Save calls the registered mock for Save via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Save calls the registered mock for Save via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *RepositoryMockImpl[T, Req]) Save(item T) error {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcSave(), item); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcSave(), gsmock.Box(item)); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox1[error](ret)
 	}
-	panic("no mock code matched for RepositoryMockImpl.Save")
+	panic(gsmock.Unmatched[Repository[T, Req]]("RepositoryMockImpl."+RepositoryMethodSave, "d3c0ccfb"))
+}
+
+// ExpectNoSave forbids any call to Save: if one occurs, the test
+// fails immediately. Mocks of Save registered earlier take precedence.
+func (impl *RepositoryMockImpl[T, Req]) ExpectNoSave() {
+	impl.MockSave().Never()
 }
 
 // MockSave returns a Mocker11
@@ -63,31 +106,331 @@ func (impl *RepositoryMockImpl[T, Req]) MockSave() *gsmock.Mocker11[T, error] {
 	return gsmock.Method11(impl, impl.funcSave(), impl.r)
 }
 
-// GenericServiceMockImpl is a generated mock implementation of the GenericService interface.
-type GenericServiceMockImpl[R any, S any] struct {
-	io.Writer
+// nowFuncVar substitutes a mock for the now function variable, see mockNow.
+type nowFuncVar struct {
+	r    *gsmock.Manager
+	orig func() time.Time
+}
+
+//go:noinline
+func (impl *nowFuncVar) funcCall() func() time.Time {
+	return impl.call
+}
+
+// call calls the registered mock for now via gsmock.InvokeBoxed.
+// If no matching mock is registered, it calls the original function,
+// and panics if there is none.
+func (impl *nowFuncVar) call() time.Time {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcCall(), nil); ok {
+		defer gsmock.Release(ret)
+		return gsmock.Unbox1[time.Time](ret)
+	}
+	if impl.orig == nil {
+		panic("no mock code matched for now, whose original function is nil")
+	}
+	return impl.orig()
+}
+
+// mockNow substitutes a mock driven by r for the now function
+// variable until the test t completes, and returns the Mocker01
+// registering its behavior. Unmatched calls go to the original function.
+func mockNow(t gsmock.TB, r *gsmock.Manager) *gsmock.Mocker01[time.Time] {
+	impl := &nowFuncVar{r: r, orig: now}
+	gsmock.Swap(t, &now, impl.call)
+	return gsmock.Method01(impl, impl.funcCall(), r)
+}
 
+// QueryMockImpl is a generated mock implementation of the Query interface.
+type QueryMockImpl struct {
 	r *gsmock.Manager
 }
 
+// Names of the mocked methods of Query, as reported by diagnostics and
+// transcripts, and as looked up by gsmock.Manager.CallsByName.
+const (
+	QueryMethodWhere   = "Where"
+	QueryMethodOrderBy = "OrderBy"
+	QueryMethodLimit   = "Limit"
+	QueryMethodAll     = "All"
+)
+
+// NewQueryMockImpl creates a new mock instance for Query with the given
+// gsmock.Manager. Returns an initialized struct ready for registering mock behavior.
+// It fails fast if the gsmock runtime is incompatible with the generated code.
+func NewQueryMockImpl(r *gsmock.Manager) *QueryMockImpl {
+	r.RequireVersion("v0.0.9")
+	gsmock.RegisterStamp[Query]("578fee72")
+	return &QueryMockImpl{r: r}
+}
+
+func init() {
+	gsmock.RegisterMock(func(r *gsmock.Manager) Query { return NewQueryMockImpl(r) })
+}
+
+// QueryStubs holds optional implementations of the methods of Query,
+// registered at once by ApplyStubs.
+type QueryStubs struct {
+	Where   func(cond string, args ...any) Query
+	OrderBy func(field string) Query
+	Limit   func(n int) Query
+	All     func() ([]string, error)
+}
+
+// ApplyStubs registers the non-nil functions of stubs as the Handle mocks
+// of their methods.
+func (impl *QueryMockImpl) ApplyStubs(stubs QueryStubs) {
+	if stubs.Where != nil {
+		impl.MockWhere().Handle(func(cond string, args []any) Query {
+			return stubs.Where(cond, args...)
+		})
+	}
+	if stubs.OrderBy != nil {
+		impl.MockOrderBy().Handle(stubs.OrderBy)
+	}
+	if stubs.Limit != nil {
+		impl.MockLimit().Handle(stubs.Limit)
+	}
+	if stubs.All != nil {
+		impl.MockAll().Handle(stubs.All)
+	}
+}
+
+//go:noinline
+func (impl *QueryMockImpl) funcWhere() func(cond string, args ...any) Query {
+	return impl.Where
+}
+
+// Where calls the registered mock for Where via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
+func (impl *QueryMockImpl) Where(cond string, args ...any) Query {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcWhere(), gsmock.Box(cond, args)); ok {
+		defer gsmock.Release(ret)
+		return gsmock.Unbox1[Query](ret)
+	}
+	panic(gsmock.Unmatched[Query]("QueryMockImpl."+QueryMethodWhere, "578fee72"))
+}
+
+// ExpectNoWhere forbids any call to Where: if one occurs, the test
+// fails immediately. Mocks of Where registered earlier take precedence.
+func (impl *QueryMockImpl) ExpectNoWhere() {
+	impl.MockWhere().Never()
+}
+
+// MockWhere returns a VarMocker21
+// for registering mock behavior of Where with specific parameter and return types.
+func (impl *QueryMockImpl) MockWhere() *gsmock.VarMocker21[string, any, Query] {
+	return gsmock.VarMethod21(impl, impl.funcWhere(), impl.r)
+}
+
+// MockWhereReturnSelf registers a mock of Where that returns the mock
+// itself, so that fluent call chains keep calling this mock.
+func (impl *QueryMockImpl) MockWhereReturnSelf() {
+	impl.MockWhere().ReturnValue(impl)
+}
+
+//go:noinline
+func (impl *QueryMockImpl) funcOrderBy() func(field string) Query {
+	return impl.OrderBy
+}
+
+// OrderBy calls the registered mock for OrderBy via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
+func (impl *QueryMockImpl) OrderBy(field string) Query {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcOrderBy(), gsmock.Box(field)); ok {
+		defer gsmock.Release(ret)
+		return gsmock.Unbox1[Query](ret)
+	}
+	panic(gsmock.Unmatched[Query]("QueryMockImpl."+QueryMethodOrderBy, "578fee72"))
+}
+
+// ExpectNoOrderBy forbids any call to OrderBy: if one occurs, the test
+// fails immediately. Mocks of OrderBy registered earlier take precedence.
+func (impl *QueryMockImpl) ExpectNoOrderBy() {
+	impl.MockOrderBy().Never()
+}
+
+// MockOrderBy returns a Mocker11
+// for registering mock behavior of OrderBy with specific parameter and return types.
+func (impl *QueryMockImpl) MockOrderBy() *gsmock.Mocker11[string, Query] {
+	return gsmock.Method11(impl, impl.funcOrderBy(), impl.r)
+}
+
+// MockOrderByReturnSelf registers a mock of OrderBy that returns the mock
+// itself, so that fluent call chains keep calling this mock.
+func (impl *QueryMockImpl) MockOrderByReturnSelf() {
+	impl.MockOrderBy().ReturnValue(impl)
+}
+
+//go:noinline
+func (impl *QueryMockImpl) funcLimit() func(n int) Query {
+	return impl.Limit
+}
+
+// Limit calls the registered mock for Limit via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
+func (impl *QueryMockImpl) Limit(n int) Query {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcLimit(), gsmock.Box(n)); ok {
+		defer gsmock.Release(ret)
+		return gsmock.Unbox1[Query](ret)
+	}
+	panic(gsmock.Unmatched[Query]("QueryMockImpl."+QueryMethodLimit, "578fee72"))
+}
+
+// ExpectNoLimit forbids any call to Limit: if one occurs, the test
+// fails immediately. Mocks of Limit registered earlier take precedence.
+func (impl *QueryMockImpl) ExpectNoLimit() {
+	impl.MockLimit().Never()
+}
+
+// MockLimit returns a Mocker11
+// for registering mock behavior of Limit with specific parameter and return types.
+func (impl *QueryMockImpl) MockLimit() *gsmock.Mocker11[int, Query] {
+	return gsmock.Method11(impl, impl.funcLimit(), impl.r)
+}
+
+// MockLimitReturnSelf registers a mock of Limit that returns the mock
+// itself, so that fluent call chains keep calling this mock.
+func (impl *QueryMockImpl) MockLimitReturnSelf() {
+	impl.MockLimit().ReturnValue(impl)
+}
+
+//go:noinline
+func (impl *QueryMockImpl) funcAll() func() ([]string, error) {
+	return impl.All
+}
+
+// All calls the registered mock for All via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
+func (impl *QueryMockImpl) All() ([]string, error) {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcAll(), nil); ok {
+		defer gsmock.Release(ret)
+		return gsmock.Unbox2[[]string, error](ret)
+	}
+	panic(gsmock.Unmatched[Query]("QueryMockImpl."+QueryMethodAll, "578fee72"))
+}
+
+// ExpectNoAll forbids any call to All: if one occurs, the test
+// fails immediately. Mocks of All registered earlier take precedence.
+func (impl *QueryMockImpl) ExpectNoAll() {
+	impl.MockAll().Never()
+}
+
+// MockAll returns a Mocker02
+// for registering mock behavior of All with specific parameter and return types.
+func (impl *QueryMockImpl) MockAll() *gsmock.Mocker02[[]string, error] {
+	return gsmock.Method02(impl, impl.funcAll(), impl.r)
+}
+
+// MockAllReturns registers a mock of All that returns
+// a slice of the given elements and a nil error.
+func (impl *QueryMockImpl) MockAllReturns(elems ...string) {
+	impl.MockAll().ReturnValue(gsmock.Slice(elems...), nil)
+}
+
+// GenericServiceMockImpl is a generated mock implementation of the GenericService interface.
+type GenericServiceMockImpl[R any, S any] struct {
+	Writer io.Writer // implementation of the embedded io.Writer, see SetWriter
+	r      *gsmock.Manager
+}
+
+// Names of the mocked methods of GenericService, as reported by diagnostics and
+// transcripts, and as looked up by gsmock.Manager.CallsByName.
+const (
+	GenericServiceMethodInit       = "Init"
+	GenericServiceMethodDefault    = "Default"
+	GenericServiceMethodTryDefault = "TryDefault"
+	GenericServiceMethodAccept     = "Accept"
+	GenericServiceMethodConvert    = "Convert"
+	GenericServiceMethodTryConvert = "TryConvert"
+	GenericServiceMethodProcess    = "Process"
+	GenericServiceMethodPrintf     = "Printf"
+)
+
 // NewGenericServiceMockImpl creates a new mock instance for GenericService with the given
 // gsmock.Manager. Returns an initialized struct ready for registering mock behavior.
+// It fails fast if the gsmock runtime is incompatible with the generated code.
 func NewGenericServiceMockImpl[R any, S any](r *gsmock.Manager) *GenericServiceMockImpl[R, S] {
+	r.RequireVersion("v0.0.9")
+	gsmock.RegisterStamp[GenericService[R, S]]("d9a96e0d")
 	return &GenericServiceMockImpl[R, S]{r: r}
 }
 
+// GenericServiceStubs holds optional implementations of the methods of GenericService,
+// registered at once by ApplyStubs.
+type GenericServiceStubs[R any, S any] struct {
+	Init       func()
+	Default    func() S
+	TryDefault func() (S, bool)
+	Accept     func(r0 R)
+	Convert    func(r0 R) S
+	TryConvert func(r0 R) (S, bool)
+	Process    func(r0 context.Context, r1 map[string]R) (S, error)
+	Printf     func(format string, args ...any)
+}
+
+// ApplyStubs registers the non-nil functions of stubs as the Handle mocks
+// of their methods.
+func (impl *GenericServiceMockImpl[R, S]) ApplyStubs(stubs GenericServiceStubs[R, S]) {
+	if stubs.Init != nil {
+		impl.MockInit().Handle(stubs.Init)
+	}
+	if stubs.Default != nil {
+		impl.MockDefault().Handle(stubs.Default)
+	}
+	if stubs.TryDefault != nil {
+		impl.MockTryDefault().Handle(stubs.TryDefault)
+	}
+	if stubs.Accept != nil {
+		impl.MockAccept().Handle(stubs.Accept)
+	}
+	if stubs.Convert != nil {
+		impl.MockConvert().Handle(stubs.Convert)
+	}
+	if stubs.TryConvert != nil {
+		impl.MockTryConvert().Handle(stubs.TryConvert)
+	}
+	if stubs.Process != nil {
+		impl.MockProcess().Handle(stubs.Process)
+	}
+	if stubs.Printf != nil {
+		impl.MockPrintf().Handle(func(format string, args []any) {
+			stubs.Printf(format, args...)
+		})
+	}
+}
+
+// SetWriter sets the implementation of the embedded io.Writer interface,
+// such as its mock, to which the methods of io.Writer are delegated.
+func (impl *GenericServiceMockImpl[R, S]) SetWriter(v io.Writer) {
+	impl.Writer = v
+}
+
+// Write delegates to the Writer field, set by SetWriter.
+func (impl *GenericServiceMockImpl[R, S]) Write(p []byte) (int, error) {
+	if impl.Writer == nil {
+		panic("GenericServiceMockImpl.Writer not set; call SetWriter or mock io.Writer")
+	}
+	return impl.Writer.Write(p)
+}
+
 //go:noinline
 func (impl *GenericServiceMockImpl[R, S]) funcInit() func() {
 	return impl.Init
 }
 
-// Init calls the registered mock for Init via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Init calls the registered mock for Init via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *GenericServiceMockImpl[R, S]) Init() {
-	if _, ok := gsmock.Invoke(impl.r, impl, impl.funcInit()); ok {
+	if _, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcInit(), nil); ok {
 		return
 	}
-	panic("no mock code matched for GenericServiceMockImpl.Init")
+	panic(gsmock.Unmatched[GenericService[R, S]]("GenericServiceMockImpl."+GenericServiceMethodInit, "d9a96e0d"))
+}
+
+// ExpectNoInit forbids any call to Init: if one occurs, the test
+// fails immediately. Mocks of Init registered earlier take precedence.
+func (impl *GenericServiceMockImpl[R, S]) ExpectNoInit() {
+	impl.MockInit().Never()
 }
 
 // MockInit returns a Mocker00
@@ -101,13 +444,20 @@ func (impl *GenericServiceMockImpl[R, S]) funcDefault() func() S {
 	return impl.Default
 }
 
-// Default calls the registered mock for Default via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Default calls the registered mock for Default via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *GenericServiceMockImpl[R, S]) Default() S {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcDefault()); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcDefault(), nil); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox1[S](ret)
 	}
-	panic("no mock code matched for GenericServiceMockImpl.Default")
+	panic(gsmock.Unmatched[GenericService[R, S]]("GenericServiceMockImpl."+GenericServiceMethodDefault, "d9a96e0d"))
+}
+
+// ExpectNoDefault forbids any call to Default: if one occurs, the test
+// fails immediately. Mocks of Default registered earlier take precedence.
+func (impl *GenericServiceMockImpl[R, S]) ExpectNoDefault() {
+	impl.MockDefault().Never()
 }
 
 // MockDefault returns a Mocker01
@@ -121,13 +471,20 @@ func (impl *GenericServiceMockImpl[R, S]) funcTryDefault() func() (S, bool) {
 	return impl.TryDefault
 }
 
-// TryDefault calls the registered mock for TryDefault via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// TryDefault calls the registered mock for TryDefault via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *GenericServiceMockImpl[R, S]) TryDefault() (S, bool) {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcTryDefault()); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcTryDefault(), nil); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox2[S, bool](ret)
 	}
-	panic("no mock code matched for GenericServiceMockImpl.TryDefault")
+	panic(gsmock.Unmatched[GenericService[R, S]]("GenericServiceMockImpl."+GenericServiceMethodTryDefault, "d9a96e0d"))
+}
+
+// ExpectNoTryDefault forbids any call to TryDefault: if one occurs, the test
+// fails immediately. Mocks of TryDefault registered earlier take precedence.
+func (impl *GenericServiceMockImpl[R, S]) ExpectNoTryDefault() {
+	impl.MockTryDefault().Never()
 }
 
 // MockTryDefault returns a Mocker02
@@ -141,13 +498,19 @@ func (impl *GenericServiceMockImpl[R, S]) funcAccept() func(r0 R) {
 	return impl.Accept
 }
 
-// Accept calls the registered mock for Accept via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Accept calls the registered mock for Accept via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *GenericServiceMockImpl[R, S]) Accept(r0 R) {
-	if _, ok := gsmock.Invoke(impl.r, impl, impl.funcAccept(), r0); ok {
+	if _, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcAccept(), gsmock.Box(r0)); ok {
 		return
 	}
-	panic("no mock code matched for GenericServiceMockImpl.Accept")
+	panic(gsmock.Unmatched[GenericService[R, S]]("GenericServiceMockImpl."+GenericServiceMethodAccept, "d9a96e0d"))
+}
+
+// ExpectNoAccept forbids any call to Accept: if one occurs, the test
+// fails immediately. Mocks of Accept registered earlier take precedence.
+func (impl *GenericServiceMockImpl[R, S]) ExpectNoAccept() {
+	impl.MockAccept().Never()
 }
 
 // MockAccept returns a Mocker10
@@ -161,13 +524,20 @@ func (impl *GenericServiceMockImpl[R, S]) funcConvert() func(r0 R) S {
 	return impl.Convert
 }
 
-// Convert calls the registered mock for Convert via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Convert calls the registered mock for Convert via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *GenericServiceMockImpl[R, S]) Convert(r0 R) S {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcConvert(), r0); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcConvert(), gsmock.Box(r0)); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox1[S](ret)
 	}
-	panic("no mock code matched for GenericServiceMockImpl.Convert")
+	panic(gsmock.Unmatched[GenericService[R, S]]("GenericServiceMockImpl."+GenericServiceMethodConvert, "d9a96e0d"))
+}
+
+// ExpectNoConvert forbids any call to Convert: if one occurs, the test
+// fails immediately. Mocks of Convert registered earlier take precedence.
+func (impl *GenericServiceMockImpl[R, S]) ExpectNoConvert() {
+	impl.MockConvert().Never()
 }
 
 // MockConvert returns a Mocker11
@@ -181,13 +551,20 @@ func (impl *GenericServiceMockImpl[R, S]) funcTryConvert() func(r0 R) (S, bool)
 	return impl.TryConvert
 }
 
-// TryConvert calls the registered mock for TryConvert via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// TryConvert calls the registered mock for TryConvert via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *GenericServiceMockImpl[R, S]) TryConvert(r0 R) (S, bool) {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcTryConvert(), r0); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcTryConvert(), gsmock.Box(r0)); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox2[S, bool](ret)
 	}
-	panic("no mock code matched for GenericServiceMockImpl.TryConvert")
+	panic(gsmock.Unmatched[GenericService[R, S]]("GenericServiceMockImpl."+GenericServiceMethodTryConvert, "d9a96e0d"))
+}
+
+// ExpectNoTryConvert forbids any call to TryConvert: if one occurs, the test
+// fails immediately. Mocks of TryConvert registered earlier take precedence.
+func (impl *GenericServiceMockImpl[R, S]) ExpectNoTryConvert() {
+	impl.MockTryConvert().Never()
 }
 
 // MockTryConvert returns a Mocker12
@@ -201,13 +578,20 @@ func (impl *GenericServiceMockImpl[R, S]) funcProcess() func(r0 context.Context,
 	return impl.Process
 }
 
-// Process calls the registered mock for Process via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Process calls the registered mock for Process via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *GenericServiceMockImpl[R, S]) Process(r0 context.Context, r1 map[string]R) (S, error) {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcProcess(), r0, r1); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcProcess(), gsmock.Box(r0, r1)); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox2[S, error](ret)
 	}
-	panic("no mock code matched for GenericServiceMockImpl.Process")
+	panic(gsmock.Unmatched[GenericService[R, S]]("GenericServiceMockImpl."+GenericServiceMethodProcess, "d9a96e0d"))
+}
+
+// ExpectNoProcess forbids any call to Process: if one occurs, the test
+// fails immediately. Mocks of Process registered earlier take precedence.
+func (impl *GenericServiceMockImpl[R, S]) ExpectNoProcess() {
+	impl.MockProcess().Never()
 }
 
 // MockProcess returns a Mocker22
@@ -216,18 +600,30 @@ func (impl *GenericServiceMockImpl[R, S]) MockProcess() *gsmock.Mocker22[context
 	return gsmock.Method22(impl, impl.funcProcess(), impl.r)
 }
 
+// StubProcess returns a stub of Process for the calls whose request
+// matches req, responding as set by its Respond or Fail method.
+func (impl *GenericServiceMockImpl[R, S]) StubProcess(req map[string]R) *gsmock.Stub[map[string]R, S] {
+	return gsmock.NewStub(impl.MockProcess(), req)
+}
+
 //go:noinline
 func (impl *GenericServiceMockImpl[R, S]) funcPrintf() func(format string, args ...any) {
 	return impl.Printf
 }
 
-// Printf calls the registered mock for Printf via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Printf calls the registered mock for Printf via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *GenericServiceMockImpl[R, S]) Printf(format string, args ...any) {
-	if _, ok := gsmock.Invoke(impl.r, impl, impl.funcPrintf(), format, args); ok {
+	if _, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcPrintf(), gsmock.Box(format, args)); ok {
 		return
 	}
-	panic("no mock code matched for GenericServiceMockImpl.Printf")
+	panic(gsmock.Unmatched[GenericService[R, S]]("GenericServiceMockImpl."+GenericServiceMethodPrintf, "d9a96e0d"))
+}
+
+// ExpectNoPrintf forbids any call to Printf: if one occurs, the test
+// fails immediately. Mocks of Printf registered earlier take precedence.
+func (impl *GenericServiceMockImpl[R, S]) ExpectNoPrintf() {
+	impl.MockPrintf().Never()
 }
 
 // MockPrintf returns a VarMocker20
@@ -238,29 +634,112 @@ func (impl *GenericServiceMockImpl[R, S]) MockPrintf() *gsmock.VarMocker20[strin
 
 // ServiceMockImpl is a generated mock implementation of the Service interface.
 type ServiceMockImpl struct {
-	io.Writer
-
-	r *gsmock.Manager
-}
+	Writer io.Writer // implementation of the embedded io.Writer, see SetWriter
+	r      *gsmock.Manager
+}
+
+// Names of the mocked methods of Service, as reported by diagnostics and
+// transcripts, and as looked up by gsmock.Manager.CallsByName.
+const (
+	ServiceMethodInit       = "Init"
+	ServiceMethodDefault    = "Default"
+	ServiceMethodTryDefault = "TryDefault"
+	ServiceMethodAccept     = "Accept"
+	ServiceMethodConvert    = "Convert"
+	ServiceMethodTryConvert = "TryConvert"
+	ServiceMethodProcess    = "Process"
+	ServiceMethodPrintf     = "Printf"
+)
 
 // NewServiceMockImpl creates a new mock instance for Service with the given
 // gsmock.Manager. Returns an initialized struct ready for registering mock behavior.
+// It fails fast if the gsmock runtime is incompatible with the generated code.
 func NewServiceMockImpl(r *gsmock.Manager) *ServiceMockImpl {
+	r.RequireVersion("v0.0.9")
+	gsmock.RegisterStamp[Service]("2346e195")
 	return &ServiceMockImpl{r: r}
 }
 
+func init() {
+	gsmock.RegisterMock(func(r *gsmock.Manager) Service { return NewServiceMockImpl(r) })
+}
+
+// ServiceStubs holds optional implementations of the methods of Service,
+// registered at once by ApplyStubs.
+type ServiceStubs struct {
+	Init       func()
+	Default    func() *Response
+	TryDefault func() (*Response, bool)
+	Accept     func(r0 *exp.Request)
+	Convert    func(r0 *exp.Request) *Response
+	TryConvert func(r0 *exp.Request) (*Response, bool)
+	Process    func(r0 context.Context, r1 map[string]*exp.Request) (*Response, error)
+	Printf     func(format string, args ...any)
+}
+
+// ApplyStubs registers the non-nil functions of stubs as the Handle mocks
+// of their methods.
+func (impl *ServiceMockImpl) ApplyStubs(stubs ServiceStubs) {
+	if stubs.Init != nil {
+		impl.MockInit().Handle(stubs.Init)
+	}
+	if stubs.Default != nil {
+		impl.MockDefault().Handle(stubs.Default)
+	}
+	if stubs.TryDefault != nil {
+		impl.MockTryDefault().Handle(stubs.TryDefault)
+	}
+	if stubs.Accept != nil {
+		impl.MockAccept().Handle(stubs.Accept)
+	}
+	if stubs.Convert != nil {
+		impl.MockConvert().Handle(stubs.Convert)
+	}
+	if stubs.TryConvert != nil {
+		impl.MockTryConvert().Handle(stubs.TryConvert)
+	}
+	if stubs.Process != nil {
+		impl.MockProcess().Handle(stubs.Process)
+	}
+	if stubs.Printf != nil {
+		impl.MockPrintf().Handle(func(format string, args []any) {
+			stubs.Printf(format, args...)
+		})
+	}
+}
+
+// SetWriter sets the implementation of the embedded io.Writer interface,
+// such as its mock, to which the methods of io.Writer are delegated.
+func (impl *ServiceMockImpl) SetWriter(v io.Writer) {
+	impl.Writer = v
+}
+
+// Write delegates to the Writer field, set by SetWriter.
+func (impl *ServiceMockImpl) Write(p []byte) (int, error) {
+	if impl.Writer == nil {
+		panic("ServiceMockImpl.Writer not set; call SetWriter or mock io.Writer")
+	}
+	return impl.Writer.Write(p)
+}
+
 //go:noinline
 func (impl *ServiceMockImpl) funcInit() func() {
 	return impl.Init
 }
 
-// Init calls the registered mock for Init via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Init calls the registered mock for Init via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *ServiceMockImpl) Init() {
-	if _, ok := gsmock.Invoke(impl.r, impl, impl.funcInit()); ok {
+	if _, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcInit(), nil); ok {
 		return
 	}
-	panic("no mock code matched for ServiceMockImpl.Init")
+	panic(gsmock.Unmatched[Service]("ServiceMockImpl."+ServiceMethodInit, "2346e195"))
+}
+
+// ExpectNoInit forbids any call to Init: if one occurs, the test
+// fails immediately. Mocks of Init registered earlier take precedence.
+func (impl *ServiceMockImpl) ExpectNoInit() {
+	impl.MockInit().Never()
 }
 
 // MockInit returns a Mocker00
@@ -274,13 +753,20 @@ func (impl *ServiceMockImpl) funcDefault() func() *Response {
 	return impl.Default
 }
 
-// Default calls the registered mock for Default via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Default calls the registered mock for Default via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *ServiceMockImpl) Default() *Response {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcDefault()); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcDefault(), nil); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox1[*Response](ret)
 	}
-	panic("no mock code matched for ServiceMockImpl.Default")
+	panic(gsmock.Unmatched[Service]("ServiceMockImpl."+ServiceMethodDefault, "2346e195"))
+}
+
+// ExpectNoDefault forbids any call to Default: if one occurs, the test
+// fails immediately. Mocks of Default registered earlier take precedence.
+func (impl *ServiceMockImpl) ExpectNoDefault() {
+	impl.MockDefault().Never()
 }
 
 // MockDefault returns a Mocker01
@@ -294,13 +780,20 @@ func (impl *ServiceMockImpl) funcTryDefault() func() (*Response, bool) {
 	return impl.TryDefault
 }
 
-// TryDefault calls the registered mock for TryDefault via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// TryDefault calls the registered mock for TryDefault via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *ServiceMockImpl) TryDefault() (*Response, bool) {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcTryDefault()); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcTryDefault(), nil); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox2[*Response, bool](ret)
 	}
-	panic("no mock code matched for ServiceMockImpl.TryDefault")
+	panic(gsmock.Unmatched[Service]("ServiceMockImpl."+ServiceMethodTryDefault, "2346e195"))
+}
+
+// ExpectNoTryDefault forbids any call to TryDefault: if one occurs, the test
+// fails immediately. Mocks of TryDefault registered earlier take precedence.
+func (impl *ServiceMockImpl) ExpectNoTryDefault() {
+	impl.MockTryDefault().Never()
 }
 
 // MockTryDefault returns a Mocker02
@@ -314,13 +807,19 @@ func (impl *ServiceMockImpl) funcAccept() func(r0 *exp.Request) {
 	return impl.Accept
 }
 
-// Accept calls the registered mock for Accept via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Accept calls the registered mock for Accept via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *ServiceMockImpl) Accept(r0 *exp.Request) {
-	if _, ok := gsmock.Invoke(impl.r, impl, impl.funcAccept(), r0); ok {
+	if _, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcAccept(), gsmock.Box(r0)); ok {
 		return
 	}
-	panic("no mock code matched for ServiceMockImpl.Accept")
+	panic(gsmock.Unmatched[Service]("ServiceMockImpl."+ServiceMethodAccept, "2346e195"))
+}
+
+// ExpectNoAccept forbids any call to Accept: if one occurs, the test
+// fails immediately. Mocks of Accept registered earlier take precedence.
+func (impl *ServiceMockImpl) ExpectNoAccept() {
+	impl.MockAccept().Never()
 }
 
 // MockAccept returns a Mocker10
@@ -334,13 +833,20 @@ func (impl *ServiceMockImpl) funcConvert() func(r0 *exp.Request) *Response {
 	return impl.Convert
 }
 
-// Convert calls the registered mock for Convert via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Convert calls the registered mock for Convert via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *ServiceMockImpl) Convert(r0 *exp.Request) *Response {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcConvert(), r0); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcConvert(), gsmock.Box(r0)); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox1[*Response](ret)
 	}
-	panic("no mock code matched for ServiceMockImpl.Convert")
+	panic(gsmock.Unmatched[Service]("ServiceMockImpl."+ServiceMethodConvert, "2346e195"))
+}
+
+// ExpectNoConvert forbids any call to Convert: if one occurs, the test
+// fails immediately. Mocks of Convert registered earlier take precedence.
+func (impl *ServiceMockImpl) ExpectNoConvert() {
+	impl.MockConvert().Never()
 }
 
 // MockConvert returns a Mocker11
@@ -354,13 +860,20 @@ func (impl *ServiceMockImpl) funcTryConvert() func(r0 *exp.Request) (*Response,
 	return impl.TryConvert
 }
 
-// TryConvert calls the registered mock for TryConvert via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// TryConvert calls the registered mock for TryConvert via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *ServiceMockImpl) TryConvert(r0 *exp.Request) (*Response, bool) {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcTryConvert(), r0); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcTryConvert(), gsmock.Box(r0)); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox2[*Response, bool](ret)
 	}
-	panic("no mock code matched for ServiceMockImpl.TryConvert")
+	panic(gsmock.Unmatched[Service]("ServiceMockImpl."+ServiceMethodTryConvert, "2346e195"))
+}
+
+// ExpectNoTryConvert forbids any call to TryConvert: if one occurs, the test
+// fails immediately. Mocks of TryConvert registered earlier take precedence.
+func (impl *ServiceMockImpl) ExpectNoTryConvert() {
+	impl.MockTryConvert().Never()
 }
 
 // MockTryConvert returns a Mocker12
@@ -374,13 +887,20 @@ func (impl *ServiceMockImpl) funcProcess() func(r0 context.Context, r1 map[strin
 	return impl.Process
 }
 
-// Process calls the registered mock for Process via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Process calls the registered mock for Process via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *ServiceMockImpl) Process(r0 context.Context, r1 map[string]*exp.Request) (*Response, error) {
-	if ret, ok := gsmock.Invoke(impl.r, impl, impl.funcProcess(), r0, r1); ok {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcProcess(), gsmock.Box(r0, r1)); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox2[*Response, error](ret)
 	}
-	panic("no mock code matched for ServiceMockImpl.Process")
+	panic(gsmock.Unmatched[Service]("ServiceMockImpl."+ServiceMethodProcess, "2346e195"))
+}
+
+// ExpectNoProcess forbids any call to Process: if one occurs, the test
+// fails immediately. Mocks of Process registered earlier take precedence.
+func (impl *ServiceMockImpl) ExpectNoProcess() {
+	impl.MockProcess().Never()
 }
 
 // MockProcess returns a Mocker22
@@ -389,18 +909,30 @@ func (impl *ServiceMockImpl) MockProcess() *gsmock.Mocker22[context.Context, map
 	return gsmock.Method22(impl, impl.funcProcess(), impl.r)
 }
 
+// StubProcess returns a stub of Process for the calls whose request
+// matches req, responding as set by its Respond or Fail method.
+func (impl *ServiceMockImpl) StubProcess(req map[string]*exp.Request) *gsmock.Stub[map[string]*exp.Request, *Response] {
+	return gsmock.NewStub(impl.MockProcess(), req)
+}
+
 //go:noinline
 func (impl *ServiceMockImpl) funcPrintf() func(format string, args ...any) {
 	return impl.Printf
 }
 
-// Printf calls the registered mock for Printf via gsmock.Invoke.
-// If no matching mock is registered, it panics.
+// Printf calls the registered mock for Printf via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
 func (impl *ServiceMockImpl) Printf(format string, args ...any) {
-	if _, ok := gsmock.Invoke(impl.r, impl, impl.funcPrintf(), format, args); ok {
+	if _, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcPrintf(), gsmock.Box(format, args)); ok {
 		return
 	}
-	panic("no mock code matched for ServiceMockImpl.Printf")
+	panic(gsmock.Unmatched[Service]("ServiceMockImpl."+ServiceMethodPrintf, "2346e195"))
+}
+
+// ExpectNoPrintf forbids any call to Printf: if one occurs, the test
+// fails immediately. Mocks of Printf registered earlier take precedence.
+func (impl *ServiceMockImpl) ExpectNoPrintf() {
+	impl.MockPrintf().Never()
 }
 
 // MockPrintf returns a VarMocker20
@@ -22,10 +22,11 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	exp "github.com/go-spring/gs-mock/example/inner"
 	"github.com/go-spring/gs-mock/gsmock"
-	"github.com/go-spring/gs-mock/internal/assert"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
 )
 
 type ItemType int
@@ -34,7 +35,7 @@ func TestRepositoryMockImpl_FindByID(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewRepositoryMockImpl[ItemType](r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		_, _ = s.FindByID("1")
 	}, "no mock code matched for RepositoryMockImpl.FindByID")
 
@@ -47,16 +48,16 @@ func TestRepositoryMockImpl_FindByID(t *testing.T) {
 	})
 
 	v, err := s.FindByID("1")
-	assert.Nil(t, err)
-	assert.Equal(t, v, ItemType(666))
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, v, ItemType(666))
 
 	v, err = s.FindByID("2")
-	assert.Nil(t, err)
-	assert.Equal(t, v, ItemType(777))
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, v, ItemType(777))
 
 	v, err = s.FindByID("2")
-	assert.Nil(t, err)
-	assert.Equal(t, v, ItemType(777))
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, v, ItemType(777))
 
 	// This mock is not effective because there is already a mock
 	s.MockFindByID().Handle(func(s string) (ItemType, error) {
@@ -64,8 +65,8 @@ func TestRepositoryMockImpl_FindByID(t *testing.T) {
 	})
 
 	v, err = s.FindByID("2")
-	assert.Nil(t, err)
-	assert.Equal(t, v, ItemType(777))
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, v, ItemType(777))
 }
 
 func TestRepositoryMockImpl_Save(t *testing.T) {
@@ -73,7 +74,7 @@ func TestRepositoryMockImpl_Save(t *testing.T) {
 	s1 := NewRepositoryMockImpl[ItemType](r)
 	s2 := NewRepositoryMockImpl[ItemType](r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		_ = s2.Save(ItemType(666))
 	}, "no mock code matched for RepositoryMockImpl.Save")
 
@@ -82,7 +83,7 @@ func TestRepositoryMockImpl_Save(t *testing.T) {
 	})
 
 	err := s1.Save(ItemType(666))
-	assert.Equal(t, err.Error(), "error")
+	gsmockassert.Equal(t, err.Error(), "error")
 
 	// Test that different interface instances return their own
 	// results when mocking the same method
@@ -91,14 +92,46 @@ func TestRepositoryMockImpl_Save(t *testing.T) {
 	})
 
 	err = s2.Save(ItemType(666))
-	assert.Nil(t, err)
+	gsmockassert.Nil(t, err)
+}
+
+func TestRepositoryMockImpl_CaptureArg(t *testing.T) {
+	r := gsmock.NewManager()
+	s := NewRepositoryMockImpl[ItemType](r)
+
+	m := s.MockSave()
+	items := m.CaptureArg1() // *gsmock.Captor[ItemType]
+	m.When(func(item ItemType) bool {
+		return item > 0
+	}).ReturnValue(nil)
+
+	gsmockassert.Nil(t, s.Save(ItemType(1)))
+	gsmockassert.Nil(t, s.Save(ItemType(2)))
+	gsmockassert.Panic(t, func() {
+		_ = s.Save(ItemType(-1))
+	}, "no mock code matched for RepositoryMockImpl.Save")
+
+	var values []ItemType = items.Values()
+	gsmockassert.Equal(t, values, []ItemType{1, 2})
+	gsmockassert.Equal(t, items.Last(), ItemType(2))
+	gsmockassert.Equal(t, items.Len(), 2)
+}
+
+func TestRepositoryMockImpl_ExpectNoSave(t *testing.T) {
+	r := gsmock.NewManager()
+	s := NewRepositoryMockImpl[ItemType](r)
+
+	s.ExpectNoSave()
+	gsmockassert.Panic(t, func() {
+		_ = s.Save(ItemType(1))
+	}, `forbidden call to .*RepositoryMockImpl.*\.Save with params \(1\)`)
 }
 
 func TestGenericServiceMockImpl_Init(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewGenericServiceMockImpl[string, int](r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.Init()
 	}, "no mock code matched for GenericServiceMockImpl.Init")
 
@@ -111,7 +144,7 @@ func TestGenericServiceMockImpl_Default(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewGenericServiceMockImpl[string, int](r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.Default()
 	}, "no mock code matched for GenericServiceMockImpl.Default")
 
@@ -119,14 +152,14 @@ func TestGenericServiceMockImpl_Default(t *testing.T) {
 	s.MockDefault().ReturnValue(5)
 
 	resp := s.Default()
-	assert.Equal(t, resp, 5)
+	gsmockassert.Equal(t, resp, 5)
 }
 
 func TestGenericServiceMockImpl_TryDefault(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewGenericServiceMockImpl[string, int](r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.TryDefault()
 	}, "no mock code matched for GenericServiceMockImpl.TryDefault")
 
@@ -135,15 +168,15 @@ func TestGenericServiceMockImpl_TryDefault(t *testing.T) {
 	})
 
 	resp, ok := s.TryDefault()
-	assert.Equal(t, ok, true)
-	assert.Equal(t, resp, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, resp, 5)
 }
 
 func TestGenericServiceMockImpl_Accept(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewGenericServiceMockImpl[string, int](r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.Accept("")
 	}, "no mock code matched for GenericServiceMockImpl.Accept")
 
@@ -156,7 +189,7 @@ func TestGenericServiceMockImpl_Convert(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewGenericServiceMockImpl[string, int](r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.Convert("")
 	}, "no mock code matched for GenericServiceMockImpl.Convert")
 
@@ -173,14 +206,14 @@ func TestGenericServiceMockImpl_Convert(t *testing.T) {
 	})
 
 	resp := s.Convert("abc")
-	assert.Equal(t, resp, 5)
+	gsmockassert.Equal(t, resp, 5)
 
 	resp = s.Convert("123")
-	assert.Equal(t, resp, 10)
+	gsmockassert.Equal(t, resp, 10)
 
 	// Test when/then combination, if the first match succeeds,
 	// return the result, otherwise return default result
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.Convert("")
 	}, "no mock code matched for GenericServiceMockImpl.Convert")
 }
@@ -189,7 +222,7 @@ func TestGenericServiceMockImpl_TryConvert(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewGenericServiceMockImpl[string, int](r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.TryConvert("")
 	}, "no mock code matched for GenericServiceMockImpl.TryConvert")
 
@@ -198,15 +231,15 @@ func TestGenericServiceMockImpl_TryConvert(t *testing.T) {
 	})
 
 	resp, ok := s.TryConvert("abc")
-	assert.Equal(t, ok, false)
-	assert.Equal(t, resp, 5)
+	gsmockassert.Equal(t, ok, false)
+	gsmockassert.Equal(t, resp, 5)
 }
 
 func TestGenericServiceMockImpl_Process(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewGenericServiceMockImpl[string, int](r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		_, _ = s.Process(context.Background(), map[string]string{})
 	}, "no mock code matched for GenericServiceMockImpl.Process")
 
@@ -216,15 +249,15 @@ func TestGenericServiceMockImpl_Process(t *testing.T) {
 
 	// Test interface implementation method mock that does not depend on ctx
 	resp, err := s.Process(context.Background(), map[string]string{})
-	assert.Nil(t, err)
-	assert.Equal(t, resp, 5)
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp, 5)
 }
 
 func TestGenericServiceMockImpl_Printf(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewGenericServiceMockImpl[string, int](r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.Printf("%s\n", "123")
 	}, "no mock code matched for GenericServiceMockImpl.Printf")
 
@@ -235,14 +268,14 @@ func TestGenericServiceMockImpl_Printf(t *testing.T) {
 
 	// Test variadic parameter method mock
 	s.Printf("%s:%s\n", "123", "456")
-	assert.Equal(t, buf.String(), "123:456\n")
+	gsmockassert.Equal(t, buf.String(), "123:456\n")
 }
 
 func TestServiceMockImpl_Init(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewServiceMockImpl(r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.Init()
 	}, "no mock code matched for ServiceMockImpl.Init")
 
@@ -254,7 +287,7 @@ func TestServiceMockImpl_Default(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewServiceMockImpl(r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.Default()
 	}, "no mock code matched for ServiceMockImpl.Default")
 
@@ -263,14 +296,14 @@ func TestServiceMockImpl_Default(t *testing.T) {
 	})
 
 	resp := s.Default()
-	assert.Equal(t, resp.Value, 5)
+	gsmockassert.Equal(t, resp.Value, 5)
 }
 
 func TestServiceMockImpl_TryDefault(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewServiceMockImpl(r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.TryDefault()
 	}, "no mock code matched for ServiceMockImpl.TryDefault")
 
@@ -279,15 +312,15 @@ func TestServiceMockImpl_TryDefault(t *testing.T) {
 	})
 
 	resp, ok := s.TryDefault()
-	assert.Equal(t, ok, false)
-	assert.Equal(t, resp.Value, 5)
+	gsmockassert.Equal(t, ok, false)
+	gsmockassert.Equal(t, resp.Value, 5)
 }
 
 func TestServiceMockImpl_Accept(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewServiceMockImpl(r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.Accept(&exp.Request{})
 	}, "no mock code matched for ServiceMockImpl.Accept")
 
@@ -299,7 +332,7 @@ func TestServiceMockImpl_Convert(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewServiceMockImpl(r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.Convert(&exp.Request{})
 	}, "no mock code matched for ServiceMockImpl.Convert")
 
@@ -308,14 +341,14 @@ func TestServiceMockImpl_Convert(t *testing.T) {
 	})
 
 	resp := s.Convert(&exp.Request{})
-	assert.Equal(t, resp.Value, 5)
+	gsmockassert.Equal(t, resp.Value, 5)
 }
 
 func TestServiceMockImpl_TryConvert(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewServiceMockImpl(r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s.TryConvert(&exp.Request{})
 	}, "no mock code matched for ServiceMockImpl.TryConvert")
 
@@ -324,15 +357,15 @@ func TestServiceMockImpl_TryConvert(t *testing.T) {
 	})
 
 	resp, ok := s.TryConvert(&exp.Request{})
-	assert.Equal(t, ok, false)
-	assert.Equal(t, resp.Value, 5)
+	gsmockassert.Equal(t, ok, false)
+	gsmockassert.Equal(t, resp.Value, 5)
 }
 
 func TestServiceMockImpl_Process(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewServiceMockImpl(r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		_, _ = s.Process(context.Background(), map[string]*exp.Request{})
 	}, "no mock code matched for ServiceMockImpl.Process")
 
@@ -341,8 +374,66 @@ func TestServiceMockImpl_Process(t *testing.T) {
 	})
 
 	resp, err := s.Process(context.Background(), map[string]*exp.Request{})
-	assert.Nil(t, err)
-	assert.Equal(t, resp.Value, 5)
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp.Value, 5)
+}
+
+func TestServiceMockImpl_ProcessWhenReq(t *testing.T) {
+	r := gsmock.NewManager()
+	s := NewServiceMockImpl(r)
+
+	// The predicate omits the context.Context parameter
+	s.MockProcess().WhenReq(func(m map[string]*exp.Request) bool {
+		return len(m) > 0
+	}).ReturnValue(&Response{Value: 1}, nil)
+
+	s.MockProcess().WhenReq(func(m map[string]*exp.Request) bool {
+		return len(m) == 0
+	}).ReturnValue(&Response{Value: 0}, nil)
+
+	resp, err := s.Process(context.Background(), map[string]*exp.Request{"a": {}})
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp.Value, 1)
+
+	resp, err = s.Process(context.Background(), nil)
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp.Value, 0)
+}
+
+func TestServiceMockImpl_StubProcess(t *testing.T) {
+	r := gsmock.NewManager()
+	s := NewServiceMockImpl(r)
+
+	// The stubs match the requests equal to theirs
+	s.StubProcess(map[string]*exp.Request{"a": {}}).Respond(&Response{Value: 1})
+	errEmpty := errors.New("empty request")
+	s.StubProcess(map[string]*exp.Request{}).Fail(errEmpty)
+
+	resp, err := s.Process(context.Background(), map[string]*exp.Request{"a": {}})
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp.Value, 1)
+
+	resp, err = s.Process(context.Background(), map[string]*exp.Request{})
+	gsmockassert.ErrorIs(t, err, errEmpty)
+	gsmockassert.Nil(t, resp)
+
+	gsmockassert.Panic(t, func() {
+		_, _ = s.Process(context.Background(), map[string]*exp.Request{"b": {}})
+	}, "no mock code matched for ServiceMockImpl.Process")
+}
+
+func TestServiceMockImpl_CallsByName(t *testing.T) {
+	r := gsmock.NewManager()
+	r.EnableRecording(gsmock.RetentionPolicy{})
+	s := NewServiceMockImpl(r)
+
+	s.MockConvert().ReturnValue(&Response{Value: 1})
+	s.Convert(&exp.Request{})
+
+	calls := r.CallsByName(s, ServiceMethodConvert)
+	gsmockassert.Equal(t, len(calls), 1)
+	gsmockassert.Equal(t, calls[0].Results, []any{&Response{Value: 1}})
+	gsmockassert.Nil(t, r.CallsByName(s, ServiceMethodProcess))
 }
 
 func TestServiceMockImpl_Printf(t *testing.T) {
@@ -350,7 +441,7 @@ func TestServiceMockImpl_Printf(t *testing.T) {
 	s1 := NewServiceMockImpl(r)
 	s2 := NewServiceMockImpl(r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		s1.Printf("%s\n", "123")
 	}, "no mock code matched for ServiceMockImpl.Printf")
 
@@ -364,25 +455,98 @@ func TestServiceMockImpl_Printf(t *testing.T) {
 
 	s1.Printf("%s:%s\n", "123", "456")
 	s2.Printf("%s\n", "123")
-	assert.Equal(t, buf.String(), "123:456\nabc")
+	gsmockassert.Equal(t, buf.String(), "123:456\nabc")
 }
 
 func TestServiceMockImpl_Writer(t *testing.T) {
 	r := gsmock.NewManager()
 	s := NewServiceMockImpl(r)
 
-	assert.Panic(t, func() {
+	gsmockassert.Panic(t, func() {
 		_, _ = s.Write([]byte("123"))
-	}, "runtime error: invalid memory address or nil pointer dereference")
+	}, `ServiceMockImpl.Writer not set; call SetWriter or mock io.Writer`)
 
 	buf := bytes.NewBuffer(nil)
-	s.Writer = buf
+	s.SetWriter(buf)
 
 	buf.Reset()
 	_, _ = s.Write([]byte("abc"))
-	assert.Equal(t, buf.String(), "abc")
+	gsmockassert.Equal(t, buf.String(), "abc")
 
 	buf.Reset()
 	_, _ = s.Write([]byte("123"))
-	assert.Equal(t, buf.String(), "123")
+	gsmockassert.Equal(t, buf.String(), "123")
+}
+
+func TestServiceMockImpl_ApplyStubs(t *testing.T) {
+	r := gsmock.NewManager()
+	s := NewServiceMockImpl(r)
+
+	var buf bytes.Buffer
+	s.ApplyStubs(ServiceStubs{
+		Convert: func(req *exp.Request) *Response {
+			return &Response{Value: 1}
+		},
+		Printf: func(format string, args ...any) {
+			_, _ = fmt.Fprintf(&buf, format, args...)
+		},
+	})
+
+	gsmockassert.Equal(t, s.Convert(&exp.Request{}).Value, 1)
+	s.Printf("%s:%s\n", "123", "456")
+	gsmockassert.Equal(t, buf.String(), "123:456\n")
+
+	// Nil stubs are not registered
+	gsmockassert.Panic(t, func() {
+		s.Init()
+	}, "no mock code matched for ServiceMockImpl.Init")
+}
+
+func TestQueryMockImpl_ReturnSelf(t *testing.T) {
+	r := gsmock.NewManager()
+	q := NewQueryMockImpl(r)
+
+	q.MockWhereReturnSelf()
+	q.MockOrderByReturnSelf()
+	q.MockLimitReturnSelf()
+	q.MockAllReturns("a", "b")
+
+	var query Query = q
+	rows, err := query.Where("id > ?", 10).OrderBy("id").Limit(2).All()
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, rows, []string{"a", "b"})
+}
+
+func TestInjectMocks(t *testing.T) {
+	var s struct {
+		service Service
+		query   Query
+	}
+	r := gsmock.NewManager()
+	gsmock.InjectMocks(&s, r)
+
+	s.query.(*QueryMockImpl).MockAllReturns("a")
+	rows, err := s.query.All()
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, rows, []string{"a"})
+
+	s.service.(*ServiceMockImpl).MockDefault().ReturnValue(&Response{Value: 1})
+	gsmockassert.Equal(t, s.service.Default().Value, 1)
+}
+
+func TestMockNow(t *testing.T) {
+	r := gsmock.NewManager()
+
+	t.Run("morning", func(t *testing.T) {
+		mockNow(t, r).ReturnValue(time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC))
+		gsmockassert.Equal(t, Greeting(), "Good morning")
+	})
+
+	t.Run("unmatched", func(t *testing.T) {
+		mockNow(t, r).When(func() bool { return false }).ReturnValue(time.Time{})
+		gsmockassert.Equal(t, time.Since(now()) < time.Minute, true)
+	})
+
+	// restored once the subtests complete
+	gsmockassert.Equal(t, time.Since(now()) < time.Minute, true)
 }
@@ -0,0 +1,292 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// findConfig holds configuration parameters for the find subcommand.
+type findConfig struct {
+	SourceDir string   // Root directory of the packages to search.
+	Methods   []string // Method signatures, or a partial interface, to search for.
+	Exact     bool     // Only report interfaces with exactly the searched methods.
+}
+
+// findMain runs the find subcommand with its command-line arguments.
+func findMain(args []string) {
+	var param findConfig
+	fs := flag.NewFlagSet("find", flag.ExitOnError)
+	fs.BoolVar(&param.Exact, "exact", false, "Only report the interfaces declaring exactly the given methods, not those declaring more.")
+	_ = fs.Parse(args)
+	param.SourceDir = "."
+	param.Methods = fs.Args()
+	runFind(param)
+}
+
+// findMatch is an interface declaring the searched methods.
+type findMatch struct {
+	Dir   string   // Directory of the package, relative to the searched root
+	Name  string   // Interface name
+	Pos   string   // Position of the declaration, as "file:line"
+	Extra []string // Methods declared besides the searched ones, sorted
+	Embed bool     // Whether it embeds interfaces whose methods are unknown
+}
+
+// runFind searches the packages under param.SourceDir for the interfaces
+// declaring the methods of param.Methods, each given as a signature, e.g.
+// "Get(ctx context.Context, id string) (*User, error)", or as a partial
+// interface, e.g. "interface{ Get(string) error; Delete(string) error }".
+// It helps to find an existing abstraction before declaring yet another.
+//
+// Signatures are compared by their parameter and result types, whatever
+// the parameter names, and types of the package being searched may be
+// qualified or not. The interfaces embedded from the same package count
+// as their methods, and the others, mostly of other packages, as unknown
+// methods: such interfaces are only reported as declaring more methods
+// than searched.
+// Interfaces with exactly the searched methods come first, followed by
+// the ones declaring the fewest other methods. Each is reported along
+// with the command generating its mock.
+func runFind(param findConfig) {
+	want := parseMethodSet(strings.Join(param.Methods, ";"))
+
+	var matches []findMatch
+	err := filepath.WalkDir(param.SourceDir, func(dir string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if name := d.Name(); dir != param.SourceDir && (name == "testdata" || name == "vendor" ||
+			strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+			return filepath.SkipDir
+		}
+		for _, m := range findInDir(dir, want) {
+			if param.Exact && (len(m.Extra) > 0 || m.Embed) {
+				continue
+			}
+			m.Dir, _ = filepath.Rel(param.SourceDir, dir)
+			matches = append(matches, m)
+		}
+		return nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("error searching directory(%s): %w", param.SourceDir, err))
+	}
+
+	slices.SortStableFunc(matches, func(a, b findMatch) int {
+		return cmp.Or(
+			cmp.Compare(len(a.Extra), len(b.Extra)),
+			cmp.Compare(boolInt(a.Embed), boolInt(b.Embed)),
+			cmp.Compare(a.Dir, b.Dir),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	if len(matches) == 0 {
+		_, _ = fmt.Fprintf(stdOut, "no interface declares the %d given methods\n", len(want))
+		return
+	}
+	for _, m := range matches {
+		dir := "./" + filepath.ToSlash(m.Dir)
+		if m.Dir == "." {
+			dir = "."
+		}
+		var desc string
+		switch {
+		case len(m.Extra) == 0 && !m.Embed:
+			desc = "declares exactly these methods"
+		case m.Embed && len(m.Extra) == 0:
+			desc = "also embeds interfaces of other packages"
+		case m.Embed:
+			desc = fmt.Sprintf("also declares %s and embeds interfaces of other packages", strings.Join(m.Extra, ", "))
+		default:
+			desc = "also declares " + strings.Join(m.Extra, ", ")
+		}
+		_, _ = fmt.Fprintf(stdOut, "%s/%s: %s %s — mock it with: cd %s && gs-mock -i %s\n",
+			dir, m.Pos, m.Name, desc, dir, m.Name)
+	}
+}
+
+// boolInt returns 1 if b is true, 0 otherwise.
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// parseMethodSet parses the searched methods, separated by semicolons or
+// newlines, possibly enclosed in "interface{...}", into their signatures
+// by name.
+func parseMethodSet(s string) map[string]string {
+	s = strings.TrimSpace(s)
+	if rest, ok := strings.CutPrefix(s, "interface"); ok {
+		s = strings.TrimSpace(rest)
+		s = strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}")
+	}
+	expr, err := parser.ParseExpr("interface{" + s + "\n}")
+	if err != nil {
+		panic(fmt.Errorf("error parsing methods(%s): %w", s, err))
+	}
+	ret := make(map[string]string)
+	for _, f := range expr.(*ast.InterfaceType).Methods.List {
+		if len(f.Names) == 0 {
+			panic(fmt.Sprintf("methods(%s) can't embed interfaces", s))
+		}
+		ret[f.Names[0].Name] = signatureText(f.Type.(*ast.FuncType))
+	}
+	if len(ret) == 0 {
+		panic("no methods given")
+	}
+	return ret
+}
+
+// signatureText returns the signature of a method without the parameter
+// and result names, e.g. "(context.Context, string) (*User, error)".
+func signatureText(ft *ast.FuncType) string {
+	types := func(fl *ast.FieldList) string {
+		var ss []string
+		if fl != nil {
+			for _, f := range fl.List {
+				typeText, _ := getTypeText(f.Type)
+				for range max(len(f.Names), 1) {
+					ss = append(ss, typeText)
+				}
+			}
+		}
+		return "(" + strings.Join(ss, ", ") + ")"
+	}
+	return types(ft.Params) + " " + types(ft.Results)
+}
+
+// findInDir returns the interfaces of the package in dir that declare the
+// methods of want.
+func findInDir(dir string, want map[string]string) []findMatch {
+	type decl struct {
+		t   *ast.InterfaceType
+		pos string
+	}
+	var (
+		pkgName string
+		decls   = make(map[string]decl)
+		names   []string
+	)
+	for _, file := range goFiles(dir, "") {
+		fset := token.NewFileSet()
+		node, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			_, _ = fmt.Fprintf(stdErr, "gs-mock: warning: skipping broken file %s: %v\n", file, err)
+			continue
+		}
+		if strings.HasSuffix(node.Name.Name, "_test") {
+			continue
+		}
+		pkgName = node.Name.Name
+		ast.Inspect(node, func(n ast.Node) bool {
+			s, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			if t, ok := s.Type.(*ast.InterfaceType); ok {
+				p := fset.Position(s.Pos())
+				decls[s.Name.Name] = decl{t: t, pos: fmt.Sprintf("%s:%d", filepath.Base(p.Filename), p.Line)}
+				names = append(names, s.Name.Name)
+			}
+			return false
+		})
+	}
+	if len(decls) == 0 {
+		return nil
+	}
+
+	// The searched types may be qualified by the name of the package
+	local := regexp.MustCompile(`(^|[^\w.])` + pkgName + `\.`)
+
+	var methodSet func(name string, seen map[string]bool, m map[string]string) (embed bool, ok bool)
+	methodSet = func(name string, seen map[string]bool, m map[string]string) (embed bool, ok bool) {
+		if seen[name] {
+			return false, true
+		}
+		seen[name] = true
+		for _, f := range decls[name].t.Methods.List {
+			if len(f.Names) > 0 {
+				m[f.Names[0].Name] = signatureText(f.Type.(*ast.FuncType))
+				continue
+			}
+			switch t := f.Type.(type) {
+			case *ast.Ident:
+				if t.Name == "error" {
+					m["Error"] = "() (string)"
+					continue
+				}
+				if _, found := decls[t.Name]; !found {
+					return false, false // e.g. a constraint type, not mockable
+				}
+				e, ok := methodSet(t.Name, seen, m)
+				if !ok {
+					return false, false
+				}
+				embed = embed || e
+			case *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr:
+				embed = true
+			default:
+				return false, false // type unions and approximations
+			}
+		}
+		return embed, true
+	}
+
+	var ret []findMatch
+	for _, name := range names {
+		m := make(map[string]string)
+		embed, ok := methodSet(name, make(map[string]bool), m)
+		if !ok {
+			continue
+		}
+		matched := true
+		for n, sig := range want {
+			if m[n] != local.ReplaceAllString(sig, "$1") && m[n] != sig {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		var extra []string
+		for n := range m {
+			if _, ok := want[n]; !ok {
+				extra = append(extra, n)
+			}
+		}
+		slices.Sort(extra)
+		ret = append(ret, findMatch{Name: name, Pos: decls[name].pos, Extra: extra, Embed: embed})
+	}
+	return ret
+}
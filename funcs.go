@@ -0,0 +1,115 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// parseFuncs converts the comma-separated list of function names given
+// by the --funcs flag into a set, nil if there are none.
+func parseFuncs(s string) map[string]struct{} {
+	var names []string
+	for name := range strings.SplitSeq(strings.Trim(s, `'"`), ",") {
+		if name = strings.TrimSpace(name); len(name) > 0 {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return toSet(names)
+}
+
+// funcSpecs returns, for each function of the file selected by funcs, an
+// interface declaring the function as its single method, so that it is
+// scanned like interfaces. Only the non-generic functions whose first
+// parameter is a context.Context are mocked, since the mocks are found
+// through the Manager bound to that context.
+func funcSpecs(node *ast.File, funcs map[string]struct{}, imports map[string]string) []*ast.TypeSpec {
+	var ret []*ast.TypeSpec
+	for _, decl := range node.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv != nil {
+			continue
+		}
+		name := fd.Name.Name
+		if _, ok = funcs[name]; !ok {
+			continue
+		}
+		if reason := funcSkipReason(fd.Type, imports); reason != "" {
+			_, _ = fmt.Fprintf(stdErr, "gs-mock: warning: function %s is not mocked: %s\n", name, reason)
+			continue
+		}
+		ret = append(ret, &ast.TypeSpec{
+			Name: ast.NewIdent(name),
+			Type: &ast.InterfaceType{Methods: &ast.FieldList{List: []*ast.Field{
+				{Names: []*ast.Ident{ast.NewIdent(name)}, Type: fd.Type},
+			}}},
+		})
+	}
+	return ret
+}
+
+// funcSkipReason returns why a function of type ft can't be mocked
+// through its context, or "" if it can.
+func funcSkipReason(ft *ast.FuncType, imports map[string]string) string {
+	if ft.TypeParams != nil {
+		return "generic functions are not supported"
+	}
+	if ft.Params == nil || len(ft.Params.List) == 0 || !isContextType(ft.Params.List[0].Type, imports) {
+		return "its first parameter is not a context.Context"
+	}
+	return ""
+}
+
+// isContextType reports whether expr denotes context.Context.
+func isContextType(expr ast.Expr, imports map[string]string) bool {
+	x, ok := expr.(*ast.SelectorExpr)
+	if !ok || x.Sel.Name != "Context" {
+		return false
+	}
+	pkg, ok := x.X.(*ast.Ident)
+	return ok && imports[pkg.Name] == "context"
+}
+
+// warnUndeclaredFuncs reports the functions selected by funcs that are not
+// declared in the Go files of dir.
+func warnUndeclaredFuncs(dir string, outputFile string, funcs map[string]struct{}) {
+	declared := make(map[string]bool)
+	for _, f := range goFiles(dir, outputFile) {
+		node, err := parser.ParseFile(token.NewFileSet(), f, nil, parser.SkipObjectResolution)
+		if err != nil {
+			continue // reported when the file is scanned
+		}
+		for _, decl := range node.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv == nil {
+				declared[fd.Name.Name] = true
+			}
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(funcs)) {
+		if !declared[name] {
+			_, _ = fmt.Fprintf(stdErr, "gs-mock: warning: function %s is not declared in %s\n", name, dir)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// funcTypeSpecs returns, for each function type of the file named by the
+// -interfaces flag, an interface declaring the function as its single
+// method named after the type, so that it is scanned like interfaces.
+// Function types are only mocked when named, since most of them, such as
+// functional options, are not worth mocking.
+func funcTypeSpecs(node *ast.File, include map[string]struct{}) []*ast.TypeSpec {
+	var ret []*ast.TypeSpec
+	for _, decl := range node.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range d.Specs {
+			s := spec.(*ast.TypeSpec)
+			ft, ok := s.Type.(*ast.FuncType)
+			if !ok {
+				continue
+			}
+			if _, ok = include[s.Name.Name]; !ok {
+				continue
+			}
+			ret = append(ret, &ast.TypeSpec{
+				Name:       ast.NewIdent(s.Name.Name),
+				TypeParams: s.TypeParams,
+				Type: &ast.InterfaceType{Methods: &ast.FieldList{List: []*ast.Field{
+					{Names: []*ast.Ident{ast.NewIdent(s.Name.Name)}, Type: ft},
+				}}},
+			})
+		}
+	}
+	return ret
+}
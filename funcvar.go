@@ -0,0 +1,176 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// funcVarDirective is the comment that opts a package-level function
+// variable into mocking, e.g. "var now = time.Now //gsmock:var".
+const funcVarDirective = "//gsmock:var"
+
+// funcVarSpecs returns, for each function variable of the file annotated
+// with funcVarDirective, an interface declaring the function as its single
+// method named after the variable, so that it is scanned like interfaces.
+// The function type is the declared one, or that of the function literal
+// or function assigned to the variable, in which case the packages the
+// type refers to are added to imports.
+func funcVarSpecs(node *ast.File, file string, outputFile string, imports map[string]string) []*ast.TypeSpec {
+	var ret []*ast.TypeSpec
+	for _, decl := range node.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range d.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if !hasDirective(funcVarDirective, d.Doc, vs.Doc, vs.Comment) {
+				continue
+			}
+			for k, n := range vs.Names {
+				if n.Name == "_" {
+					continue
+				}
+				var value ast.Expr
+				if k < len(vs.Values) {
+					value = vs.Values[k]
+				}
+				ft, reason := funcVarType(vs.Type, value, file, outputFile, imports)
+				if ft == nil {
+					_, _ = fmt.Fprintf(stdErr, "gs-mock: warning: %s is not mocked: %s\n", n.Name, reason)
+					continue
+				}
+				ret = append(ret, &ast.TypeSpec{
+					Name: ast.NewIdent(n.Name),
+					Type: &ast.InterfaceType{Methods: &ast.FieldList{List: []*ast.Field{
+						{Names: []*ast.Ident{ast.NewIdent(n.Name)}, Type: ft},
+					}}},
+				})
+			}
+		}
+	}
+	return ret
+}
+
+// hasDirective reports whether one of the comment groups holds directive.
+func hasDirective(directive string, groups ...*ast.CommentGroup) bool {
+	for _, g := range groups {
+		if g == nil {
+			continue
+		}
+		for _, c := range g.List {
+			if strings.HasPrefix(c.Text, directive) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// funcVarType returns the function type of a variable declared with type
+// typ and initialized with value, or why it can't be determined.
+func funcVarType(typ ast.Expr, value ast.Expr, file string, outputFile string, imports map[string]string) (*ast.FuncType, string) {
+	if typ != nil {
+		if ft, ok := typ.(*ast.FuncType); ok {
+			return ft, ""
+		}
+		typeText, _ := getTypeText(typ)
+		return nil, typeText + " is not a function type"
+	}
+	switch x := value.(type) {
+	case *ast.FuncLit:
+		return x.Type, ""
+	case *ast.Ident:
+		dir := filepath.Dir(file)
+		for _, f := range goFiles(dir, outputFile) {
+			node, err := parser.ParseFile(token.NewFileSet(), f, nil, parser.SkipObjectResolution)
+			if err != nil {
+				continue // reported when the file is scanned
+			}
+			if fd := funcDecl(node, x.Name); fd != nil {
+				return fd.Type, ""
+			}
+		}
+	case *ast.SelectorExpr:
+		if pkg, ok := x.X.(*ast.Ident); ok {
+			if pkgPath, ok := imports[pkg.Name]; ok {
+				return importedFuncType(pkgPath, pkg.Name, x.Sel.Name, filepath.Dir(file), imports)
+			}
+		}
+	}
+	return nil, "can't infer its function type; declare it, e.g. var f func() = g"
+}
+
+// funcDecl returns the non-generic function declared in node with the
+// given name, or nil.
+func funcDecl(node *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range node.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv == nil && fd.Name.Name == name && fd.Type.TypeParams == nil {
+			return fd
+		}
+	}
+	return nil
+}
+
+// importedFuncType returns the type of function name of package pkgPath,
+// imported as pkgName, with the types of that package qualified by pkgName.
+// The packages the type refers to are added to imports.
+func importedFuncType(pkgPath, pkgName, name, dir string, imports map[string]string) (ft *ast.FuncType, reason string) {
+	defer func() {
+		if r := recover(); r != nil { // unexported types, or no module
+			ft, reason = nil, fmt.Sprint(r)
+		}
+	}()
+	pkgDir, ok := packageDir(pkgPath, dir)
+	if !ok {
+		return nil, "can't locate package " + pkgPath + "; declare its function type"
+	}
+	bp, err := build.ImportDir(pkgDir, 0)
+	if err != nil {
+		return nil, err.Error()
+	}
+	for _, f := range bp.GoFiles {
+		node, err := parser.ParseFile(token.NewFileSet(), filepath.Join(bp.Dir, f), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err.Error()
+		}
+		fd := funcDecl(node, name)
+		if fd == nil {
+			continue
+		}
+		qualifier{iface: name, pkg: pkgName}.expr(fd.Type)
+		fileImports := importNames(node)
+		_, pkgNames := getTypeText(fd.Type)
+		for _, n := range pkgNames {
+			if n == pkgName {
+				continue
+			}
+			if p, ok := imports[n]; ok && p != fileImports[n] {
+				return nil, fmt.Sprintf("package name %s of %s is imported for %s", n, fileImports[n], p)
+			}
+			imports[n] = fileImports[n]
+		}
+		return fd.Type, ""
+	}
+	return nil, fmt.Sprintf("no function %s in package %s", name, pkgPath)
+}
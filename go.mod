@@ -2,7 +2,11 @@ module github.com/go-spring/gs-mock
 
 go 1.26
 
-require github.com/bytedance/mockey v1.4.5
+require (
+	github.com/bytedance/mockey v1.4.5
+	golang.org/x/tools v0.44.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
 	github.com/gopherjs/gopherjs v1.12.80 // indirect
@@ -10,5 +14,7 @@ require (
 	github.com/smartystreets/assertions v1.2.0 // indirect
 	github.com/smartystreets/goconvey v1.7.2 // indirect
 	golang.org/x/arch v0.11.0 // indirect
-	golang.org/x/sys v0.26.0 // indirect
+	golang.org/x/mod v0.35.0 // indirect
+	golang.org/x/sync v0.20.0 // indirect
+	golang.org/x/sys v0.43.0 // indirect
 )
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// minGoVersion is the oldest Go version the generated code can target.
+// The gsmock runtime requires Go 1.26, which the modules using it then
+// require too: the generated code only targets an older language version
+// in files downgrading it with a //go:build constraint, which can't select
+// versions older than Go 1.21.
+const minGoVersion = 21
+
+// goVersion is the minor version of the Go 1 language targeted by the
+// generated code, which only uses the features it supports. The zero
+// value targets the toolchain of the generator, with all features.
+type goVersion int
+
+// parseGoVersion parses a Go version such as "1.21", "1.21.3" or "go1.21".
+// It panics if the version is invalid or predates generics.
+func parseGoVersion(s string) goVersion {
+	if s == "" {
+		return 0
+	}
+	v := strings.TrimPrefix(s, "go")
+	minor, ok := strings.CutPrefix(v, "1.")
+	if ok {
+		minor, _, _ = strings.Cut(minor, ".")
+	}
+	n, err := strconv.Atoi(minor)
+	if !ok || err != nil || n < 0 {
+		panic(fmt.Sprintf("invalid Go version %q", s))
+	}
+	if n < minGoVersion {
+		panic(fmt.Sprintf("Go version %s is not supported: files can't target Go versions older than 1.%d", s, minGoVersion))
+	}
+	return goVersion(n)
+}
+
+// genericAliases reports whether the targeted Go version supports generic
+// type aliases, added in Go 1.24, which alias the mocks of generic
+// interfaces generated in other packages.
+func (v goVersion) genericAliases() bool {
+	return v == 0 || v >= 24
+}
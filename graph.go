@@ -0,0 +1,161 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// graphConfig holds configuration parameters for the graph subcommand.
+type graphConfig struct {
+	SourceDir      string // Directory containing source Go files to scan.
+	OutputFile     string // Path to the output file, stdout if empty.
+	MockInterfaces string // Comma-separated interface filter string.
+	Format         string // Output format, "dot" or "json".
+}
+
+// graphMain runs the graph subcommand with its command-line arguments.
+func graphMain(args []string) {
+	var param graphConfig
+	fs := flag.NewFlagSet("graph", flag.ExitOnError)
+	fs.StringVar(&param.OutputFile, "o", "", "Path to the output file. Defaults to stdout if not specified.")
+	fs.StringVar(&param.MockInterfaces, "i", "", "Comma-separated list of interface names to include, or to exclude with a '!' prefix. Defaults to all interfaces.")
+	fs.StringVar(&param.Format, "format", "dot", "Output format: 'dot' for Graphviz, or 'json'.")
+	_ = fs.Parse(args)
+	param.SourceDir = "."
+	runGraph(param)
+}
+
+// graphNode is an interface of the graph.
+type graphNode struct {
+	Name       string      `json:"name"`
+	File       string      `json:"file"`
+	Methods    int         `json:"methods"`
+	Embeds     []string    `json:"embeds,omitempty"`
+	References []graphEdge `json:"references,omitempty"`
+}
+
+// graphEdge is a reference to an interface in a method signature.
+type graphEdge struct {
+	Method    string `json:"method"`
+	Interface string `json:"interface"`
+}
+
+// localIdent matches the identifiers not qualified by a package name.
+var localIdent = regexp.MustCompile(`(?:^|[^\w.])([A-Za-z_]\w*)`)
+
+// runGraph writes the graph of the interfaces of param.SourceDir: their
+// number of methods, the interfaces they embed, and the interfaces of the
+// package their method signatures refer to. It helps to find out which
+// interfaces are worth mocking and which ones are bloated.
+func runGraph(param graphConfig) {
+	ctx := scanContext{
+		IncludeInterfaces: make(map[string]struct{}),
+		ExcludeInterfaces: make(map[string]struct{}),
+	}
+	ctx.parse(strings.Trim(param.MockInterfaces, `'"`))
+	interfaces := scanDir(param.SourceDir, ctx)
+
+	names := make(map[string]struct{})
+	for _, i := range interfaces {
+		names[i.Name] = struct{}{}
+	}
+
+	var nodes []graphNode
+	for _, i := range interfaces {
+		n := graphNode{
+			Name:    i.Name,
+			File:    filepath.Base(i.File),
+			Methods: len(i.Methods),
+		}
+		for s := range strings.SplitSeq(i.EmbedInterfaces, "\n") {
+			if s = strings.TrimSpace(s); s != "" && s != i.SelfType {
+				n.Embeds = append(n.Embeds, s)
+			}
+		}
+		for _, m := range i.Methods {
+			var seen []string
+			for _, sm := range localIdent.FindAllStringSubmatch(m.Params+" "+m.ResultTypes, -1) {
+				if _, ok := names[sm[1]]; !ok || slices.Contains(seen, sm[1]) {
+					continue
+				}
+				seen = append(seen, sm[1])
+				n.References = append(n.References, graphEdge{Method: m.Name, Interface: sm[1]})
+			}
+		}
+		nodes = append(nodes, n)
+	}
+	slices.SortFunc(nodes, func(a, b graphNode) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	s := bytes.NewBuffer(nil)
+	switch param.Format {
+	case "dot", "":
+		writeGraphDOT(s, nodes)
+	case "json":
+		b, err := json.MarshalIndent(nodes, "", "  ")
+		if err != nil {
+			panic(fmt.Errorf("error encoding graph: %w", err))
+		}
+		s.Write(append(b, '\n'))
+	default:
+		panic(fmt.Sprintf("unknown graph format %q", param.Format))
+	}
+	writeFile(param.SourceDir, param.OutputFile, s.Bytes())
+}
+
+// writeGraphDOT writes the graph in the Graphviz DOT language. The
+// interfaces of other packages are drawn dashed, and the edges of
+// references are labeled with the methods referring to the interfaces.
+func writeGraphDOT(s *bytes.Buffer, nodes []graphNode) {
+	s.WriteString("digraph interfaces {\n")
+	s.WriteString("\tnode [shape=box];\n")
+	local := make(map[string]struct{})
+	for _, n := range nodes {
+		local[n.Name] = struct{}{}
+		_, _ = fmt.Fprintf(s, "\t%q [label=\"%s\\n%d methods\"];\n", n.Name, n.Name, n.Methods)
+	}
+	var external []string
+	for _, n := range nodes {
+		for _, e := range n.Embeds {
+			if _, ok := local[e]; !ok && !slices.Contains(external, e) {
+				external = append(external, e)
+			}
+		}
+	}
+	slices.Sort(external)
+	for _, e := range external {
+		_, _ = fmt.Fprintf(s, "\t%q [style=dashed];\n", e)
+	}
+	for _, n := range nodes {
+		for _, e := range n.Embeds {
+			_, _ = fmt.Fprintf(s, "\t%q -> %q [label=\"embeds\", style=bold];\n", n.Name, e)
+		}
+		for _, r := range n.References {
+			_, _ = fmt.Fprintf(s, "\t%q -> %q [label=%q];\n", n.Name, r.Interface, r.Method)
+		}
+	}
+	s.WriteString("}\n")
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"go/ast"
+	"strings"
+)
+
+// Import paths of the gRPC packages used by generated gRPC service code.
+const (
+	grpcPath   = "google.golang.org/grpc"
+	codesPath  = "google.golang.org/grpc/codes"
+	statusPath = "google.golang.org/grpc/status"
+)
+
+// grpcKind classifies the interfaces generated by protoc-gen-go-grpc.
+type grpcKind int
+
+const (
+	grpcNone   grpcKind = iota // not a gRPC interface
+	grpcClient                 // service client, e.g. FooClient
+	grpcServer                 // service server, e.g. FooServer
+	grpcStream                 // stream of a streaming method, e.g. Foo_ChatClient
+)
+
+// grpcKindOf reports which kind of protoc-gen-go-grpc interface t is.
+// imports maps the local names of the file's imports to their paths.
+//
+// Clients are recognized by the trailing "...grpc.CallOption" parameter of
+// all their methods, servers by the "mustEmbedUnimplementedXxx" method,
+// and streams by an embedded grpc.ClientStream or grpc.ServerStream.
+func grpcKindOf(name string, t *ast.InterfaceType, imports map[string]string) grpcKind {
+	grpcName := ""
+	for n, pkgPath := range imports {
+		if pkgPath == grpcPath {
+			grpcName = n
+		}
+	}
+
+	var (
+		methods    int
+		callOption = true
+	)
+	for _, m := range t.Methods.List {
+		if len(m.Names) == 0 {
+			typeText, _ := getTypeText(m.Type)
+			if strings.Contains(name, "_") && grpcName != "" &&
+				(typeText == grpcName+".ClientStream" || typeText == grpcName+".ServerStream") {
+				return grpcStream
+			}
+			continue
+		}
+		if m.Names[0].Name == "mustEmbedUnimplemented"+name {
+			return grpcServer
+		}
+		methods++
+		params := m.Type.(*ast.FuncType).Params.List
+		if len(params) == 0 {
+			callOption = false
+			continue
+		}
+		typeText, _ := getTypeText(params[len(params)-1].Type)
+		if typeText != "..."+grpcName+".CallOption" {
+			callOption = false
+		}
+	}
+	if grpcName != "" && methods > 0 && callOption && strings.HasSuffix(name, "Client") {
+		return grpcClient
+	}
+	return grpcNone
+}
+
+// grpcImports returns the imports used by the fallbacks of a gRPC interface.
+func grpcImports(kind grpcKind) map[string]string {
+	switch kind {
+	case grpcClient:
+		return map[string]string{"codes": codesPath, "status": statusPath}
+	case grpcStream:
+		return map[string]string{"io": "io"}
+	}
+	return nil
+}
+
+// grpcFallback returns the statement a mocked method of a gRPC interface
+// executes when no mock matches, or "" to panic as other mocks do.
+// Clients return a codes.Unimplemented error and servers delegate to the
+// embedded UnimplementedXxx struct, just like an unimplemented service.
+// Streams accept every message sent and end at the first receive.
+func grpcFallback(kind grpcKind, iface string, m Method) string {
+	switch kind {
+	case grpcStream:
+		if m.Name == "Send" && m.ResultCount == 1 {
+			return "return nil"
+		}
+		if m.Name == "Recv" && m.ResultCount == 2 {
+			return "return nil, io.EOF"
+		}
+	case grpcClient:
+		zeros := strings.Repeat("nil, ", m.ResultCount-1)
+		return "return " + zeros + `status.Error(codes.Unimplemented, "gsmock: ` + iface + "." + m.Name + ` is not mocked")`
+	case grpcServer:
+		call := "impl.Unimplemented" + iface + "." + m.Name + "(" + m.ParamNames + ")"
+		if m.ResultCount > 0 {
+			return "return " + call
+		}
+		return call + "\n\treturn"
+	}
+	return ""
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// argField locates the value returned by a ReturnFieldOfArg mock: the
+// field at a path of names in one of the arguments of the matched calls.
+type argField struct {
+	arg    int          // 0-based argument position
+	path   []string     // field names, empty for the argument itself
+	result reflect.Type // type of the result the field is returned as
+}
+
+// newArgField checks that the field at path of argument arg, 1-based, of
+// a function taking params can be returned as its result into, 1-based,
+// among results. path is a dot-separated list of exported field names,
+// followed through pointers. Interfaces on the path defer the check of
+// the remaining names to the calls.
+func newArgField(params []reflect.Type, arg int, path string, results []reflect.Type, into int) (*argField, error) {
+	if arg < 1 || arg > len(params) {
+		return nil, fmt.Errorf("argument %d out of range [1, %d]", arg, len(params))
+	}
+	if into < 1 || into > len(results) {
+		return nil, fmt.Errorf("result %d out of range [1, %d]", into, len(results))
+	}
+	f := &argField{arg: arg - 1, result: results[into-1]}
+	if path != "" {
+		f.path = strings.Split(path, ".")
+	}
+	for _, name := range f.path {
+		r, _ := utf8.DecodeRuneInString(name)
+		if !unicode.IsUpper(r) {
+			return nil, fmt.Errorf("invalid path %q: %q is not an exported field name", path, name)
+		}
+	}
+	t := params[arg-1]
+	for _, name := range f.path {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() == reflect.Interface {
+			return f, nil
+		}
+		if t.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("invalid path %q: %s is not a struct", path, t)
+		}
+		sf, ok := t.FieldByName(name)
+		if !ok || !sf.IsExported() {
+			return nil, fmt.Errorf("invalid path %q: %s has no field %s", path, t, name)
+		}
+		t = sf.Type
+	}
+	if !t.AssignableTo(f.result) {
+		return nil, fmt.Errorf("cannot return %s as result %d of type %s", t, into, f.result)
+	}
+	return f, nil
+}
+
+// get returns the field among the arguments of a call, converted to the
+// type of its result, or nil for a nil interface. It panics if a pointer
+// on the path is nil, or if an interface holds no matching field.
+func (f *argField) get(params []any) any {
+	v := reflect.ValueOf(params[f.arg])
+	for _, name := range f.path {
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				panic(fmt.Sprintf("gsmock: ReturnFieldOfArg: nil %s before field %s of argument %d", v.Type(), name, f.arg+1))
+			}
+			v = v.Elem()
+		}
+		if !v.IsValid() {
+			panic(fmt.Sprintf("gsmock: ReturnFieldOfArg: nil argument %d before field %s", f.arg+1, name))
+		}
+		field := reflect.Value{}
+		if v.Kind() == reflect.Struct {
+			field = v.FieldByName(name)
+		}
+		if !field.IsValid() || !field.CanInterface() {
+			panic(fmt.Sprintf("gsmock: ReturnFieldOfArg: %s has no field %s in argument %d", v.Type(), name, f.arg+1))
+		}
+		v = field
+	}
+	if v.Kind() == reflect.Interface && !v.IsNil() && !v.Type().AssignableTo(f.result) {
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.Kind() == reflect.Interface && v.IsNil() {
+		return nil
+	}
+	if !v.Type().AssignableTo(f.result) {
+		panic(fmt.Sprintf("gsmock: ReturnFieldOfArg: cannot return %s as %s", v.Type(), f.result))
+	}
+	out := reflect.New(f.result).Elem()
+	out.Set(v)
+	return out.Interface()
+}
+
+// fieldOfArg returns the argField of a ReturnFieldOfArg call on the mocker,
+// panicking with the mocked function if the arguments are invalid.
+func (m *mockerBase) fieldOfArg(params []reflect.Type, arg int, path string, results []reflect.Type, into int) *argField {
+	f, err := newArgField(params, arg, path, results, into)
+	if err != nil {
+		panic(fmt.Sprintf("gsmock: ReturnFieldOfArg of the mocker of %s: %s", funcName(m.k), err))
+	}
+	return f
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+type Customer struct {
+	Name string
+}
+
+type Order struct {
+	ID       string
+	Customer *Customer
+	Meta     any
+	Err      error
+}
+
+// SaveOrder is a sample function returning the ID of the saved order.
+func SaveOrder(ctx context.Context, o *Order) (string, error) {
+	return "", nil
+}
+
+func TestReturnFieldOfArg(t *testing.T) {
+	r := gsmock.NewManager()
+	save := func(ctx context.Context, o *Order) (string, error) {
+		if ret, ok := gsmock.Invoke(r, nil, SaveOrder, ctx, o); ok {
+			return gsmock.Unbox2[string, error](ret)
+		}
+		return SaveOrder(ctx, o)
+	}
+
+	// field of the argument, the other results being defaults
+	r.Reset()
+	gsmock.Method22(nil, SaveOrder, r).ReturnFieldOfArg(2, "ID", 1)
+	id, err := save(context.Background(), &Order{ID: "o-1"})
+	gsmockassert.Equal(t, id, "o-1")
+	gsmockassert.Nil(t, err)
+
+	// nested fields through pointers
+	r.Reset()
+	gsmock.Method22(nil, SaveOrder, r).ReturnFieldOfArg(2, "Customer.Name", 1)
+	id, _ = save(context.Background(), &Order{Customer: &Customer{Name: "alice"}})
+	gsmockassert.Equal(t, id, "alice")
+	gsmockassert.Panic(t, func() {
+		_, _ = save(context.Background(), &Order{})
+	}, `gsmock: ReturnFieldOfArg: nil \*gsmock_test.Customer before field Name of argument 2`)
+
+	// fields of interfaces are resolved at call time
+	r.Reset()
+	gsmock.Method22(nil, SaveOrder, r).ReturnFieldOfArg(2, "Meta.Name", 1)
+	id, _ = save(context.Background(), &Order{Meta: Customer{Name: "bob"}})
+	gsmockassert.Equal(t, id, "bob")
+	gsmockassert.Panic(t, func() {
+		_, _ = save(context.Background(), &Order{Meta: 1})
+	}, `gsmock: ReturnFieldOfArg: int has no field Name in argument 2`)
+
+	// interface fields, nil if nil
+	r.Reset()
+	gsmock.Method22(nil, SaveOrder, r).ReturnFieldOfArg(2, "Err", 2)
+	_, err = save(context.Background(), &Order{Err: context.Canceled})
+	gsmockassert.ErrorIs(t, err, context.Canceled)
+	_, err = save(context.Background(), &Order{})
+	gsmockassert.Nil(t, err)
+
+	for _, c := range []struct {
+		arg, into int
+		path      string
+		err       string
+	}{
+		{3, 1, "", `argument 3 out of range \[1, 2\]`},
+		{2, 0, "ID", `result 0 out of range \[1, 2\]`},
+		{2, 1, "id", `invalid path "id": "id" is not an exported field name`},
+		{2, 1, "ID.Len", `invalid path "ID.Len": string is not a struct`},
+		{2, 1, "Name", `invalid path "Name": gsmock_test.Order has no field Name`},
+		{2, 2, "ID", `cannot return string as result 2 of type error`},
+		{1, 2, "", `cannot return context.Context as result 2 of type error`},
+	} {
+		gsmockassert.Panic(t, func() {
+			gsmock.Method22(nil, SaveOrder, r).ReturnFieldOfArg(c.arg, c.path, c.into)
+		}, `gsmock: ReturnFieldOfArg of the mocker of .*SaveOrder: `+c.err)
+	}
+}
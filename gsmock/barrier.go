@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"sync"
+)
+
+// barrier blocks goroutines until n of them are waiting, then releases
+// them together and starts over with the next n.
+type barrier struct {
+	mu         sync.Mutex
+	cond       *sync.Cond
+	n          int // size of the groups of released goroutines
+	count      int // number of goroutines waiting
+	generation int // number of groups released
+}
+
+// newBarrier returns a barrier releasing goroutines in groups of n.
+func newBarrier(n int) *barrier {
+	if n <= 0 {
+		panic(fmt.Sprintf("gsmock: barrier of %d calls", n))
+	}
+	b := &barrier{n: n}
+	b.cond = sync.NewCond(&b.mu)
+	return b
+}
+
+// wait blocks until n goroutines are waiting, including the caller.
+func (b *barrier) wait() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	gen := b.generation
+	if b.count++; b.count == b.n {
+		b.count = 0
+		b.generation++
+		b.cond.Broadcast()
+		return
+	}
+	for gen == b.generation {
+		b.cond.Wait()
+	}
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestBarrier(t *testing.T) {
+
+	t.Run("groups", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+
+		// Every call sees the arrival of its whole group
+		var arrived atomic.Int32
+		c.MockQuery().
+			When(func(req *Request) bool {
+				arrived.Add(1)
+				return true
+			}).
+			Barrier(3).
+			Handle(func(req *Request) (*Response, error) {
+				return &Response{Message: strconv.Itoa(int(arrived.Load()))}, nil
+			})
+
+		var wg sync.WaitGroup
+		messages := make([]string, 6)
+		for i := range 3 {
+			wg.Go(func() {
+				resp, _ := c.Query(&Request{Value: i})
+				messages[i] = resp.Message
+			})
+		}
+		wg.Wait()
+		gsmockassert.Equal(t, messages[:3], []string{"3", "3", "3"})
+
+		for i := 3; i < 6; i++ {
+			wg.Go(func() {
+				resp, _ := c.Query(&Request{Value: i})
+				messages[i] = resp.Message
+			})
+		}
+		wg.Wait()
+		gsmockassert.Equal(t, messages[3:], []string{"6", "6", "6"})
+	})
+
+	t.Run("error_size", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		gsmockassert.Panic(t, func() {
+			c.MockQuery().Barrier(0)
+		}, "gsmock: barrier of 0 calls")
+	})
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"time"
+)
+
+// mockerBase holds the state shared by all generated Mocker types
+// that does not depend on their type parameters.
+type mockerBase struct {
+	r        *Manager             // the Manager the mocker is registered with
+	k        funcKey              // the function the mocker applies to
+	fn       any                  // the function as registered, nil if bound
+	never    bool                 // whether matched calls are forbidden
+	freeze   freezeMode           // how returned values are checked
+	captures []func(params []any) // argument captors fed on every matched call
+	setArgs  []func(params []any) // out arguments written on every matched call
+	pcs      []uintptr            // call stack of the mocker's creation
+	barrier  *barrier             // groups the matched calls, nil if none
+	timeout  time.Duration        // longest a matched call may block, 0 if unlimited
+	calls    int64                // number of calls returned, accessed atomically
+
+	resultCaptures []func(ret []any) // result captors fed on every matched call
+
+	onCall int // 1-based index of the only call of the function matched, 0 if any
+
+	defaults []reflect.Type // types of the results defaulted by the call, nil for the others
+	bind     *mockerBase    // mocker returned by Bind, whose functions handle the calls
+}
+
+// register binds the mocker to r and registers its Invoker for fn.
+func (m *mockerBase) register(r *Manager, receiver any, fn any, i Invoker) {
+	m.r = r
+	m.k = newFuncKey(receiver, fn)
+	m.fn = fn
+	var pcs [16]uintptr
+	m.pcs = pcs[:runtime.Callers(3, pcs[:])]
+	r.addInvoker(receiver, fn, i)
+}
+
+// invoker is the Invoker registered by every mocker. It holds the control
+// flow shared by all of them, so that the generic code of each mocker is
+// reduced to converting the parameters and results of its functions, and
+// instantiating a mocker doesn't instantiate a distinct Invoker type.
+type invoker struct {
+	*mockerBase
+	match func(params []any) bool  // whether the mocker applies to a call
+	call  func(params []any) []any // runs the mocker's functions for a matched call
+}
+
+// invoker returns the Invoker of the mocker, made of its match and call
+// functions.
+func (m *mockerBase) invoker(match func(params []any) bool, call func(params []any) []any) *invoker {
+	return &invoker{mockerBase: m, match: match, call: call}
+}
+
+// Invoke dispatches the call to the configured handler or return function.
+// A panic in the mocker's functions is re-raised as a *PanicError.
+func (i *invoker) Invoke(params []any) ([]any, bool) {
+	return i.invoke(i.r, params)
+}
+
+// invoke is like Invoke, for a call dispatched by r, which is not the
+// Manager of the mocker when r inherits the mocker from its snapshot: the
+// call is then reported to r, e.g. a forbidden call fails the test of r,
+// and its results are defaulted as r registered, so that the child
+// Managers of a snapshot don't interfere.
+func (i *invoker) invoke(r *Manager, params []any) ([]any, bool) {
+	defer i.recoverPanic(params)
+	if !i.match(params) {
+		return nil, false
+	}
+	m := i.mockerBase
+	for p := params; ; p = p[1:] {
+		m.matched(r, p)
+		if m.bind == nil {
+			break
+		}
+		m = m.bind
+	}
+	ret := i.callTimeout(r, params)
+	for k, t := range m.defaults {
+		if t == nil {
+			continue
+		}
+		if d := r.defaultFor(t); d != nil && reflect.TypeOf(d).AssignableTo(t) {
+			ret[k] = d
+		}
+	}
+	for m := i.mockerBase; m != nil; m = m.bind {
+		m.returned(r, ret)
+	}
+	return ret, true
+}
+
+// invokeFor dispatches a call of r to the Invoker i, on behalf of r if i
+// is the Invoker of a mocker, see invoker.invoke.
+func invokeFor(r *Manager, i Invoker, params []any) ([]any, bool) {
+	if i, ok := i.(*invoker); ok {
+		return i.invoke(r, params)
+	}
+	return i.Invoke(params)
+}
+
+// Mocker is implemented by all the mockers, such as *Mocker21, and allows
+// functions like ReturnRows to configure any of them.
+type Mocker interface {
+	base() *mockerBase
+}
+
+// base returns the state of the mocker shared by all mocker types.
+func (m *mockerBase) base() *mockerBase {
+	return m
+}
+
+// bound returns the state of a mocker returned by Bind, which applies to
+// the same function as m but is only invoked through m.
+func (m *mockerBase) bound() mockerBase {
+	return mockerBase{r: m.r, k: m.k, pcs: m.pcs}
+}
+
+// rejectNil panics when a nil function is passed to the method of the
+// mocker, which would otherwise register a mocker silently never matched.
+// The panic names the call site, outside of this package.
+func (m *mockerBase) rejectNil(method string) {
+	var pcs [16]uintptr
+	site := callerSite(pcs[:runtime.Callers(3, pcs[:])])
+	panic(fmt.Sprintf("gsmock: nil function passed to %s of the mocker of %s at %s",
+		method, funcName(m.k), site))
+}
+
+// matched is called by the Invokers of the mockers once a call of r has
+// been matched, before its handler or return function runs.
+func (m *mockerBase) matched(r *Manager, params []any) {
+	if m.never {
+		r.forbidden(m.k, params)
+	}
+	for _, fn := range m.captures {
+		fn(params)
+	}
+	for _, fn := range m.setArgs {
+		fn(params)
+	}
+	if m.barrier != nil {
+		m.barrier.wait()
+	}
+}
@@ -0,0 +1,351 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package benchmarks
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+)
+
+type Request struct {
+	ID int
+}
+
+type Response struct {
+	Value string
+}
+
+func Add(a, b int) (int, error) {
+	return a + b, nil
+}
+
+func Sum(nums ...int) int {
+	return 0
+}
+
+// Client is a hand-written interface mock, equivalent to generated code.
+type Client struct {
+	r *gsmock.Manager
+}
+
+func (c *Client) Ping() {
+	if _, ok := gsmock.InvokeBoxed(c.r, c, c.Ping, nil); ok {
+		return
+	}
+	panic("no mock code matched for Client.Ping")
+}
+
+func (c *Client) MockPing() *gsmock.Mocker00 {
+	return gsmock.Method00(c, c.Ping, c.r)
+}
+
+func (c *Client) Query(req *Request) (*Response, error) {
+	if ret, ok := gsmock.InvokeBoxed(c.r, c, c.Query, gsmock.Box(req)); ok {
+		defer gsmock.Release(ret)
+		return gsmock.Unbox2[*Response, error](ret)
+	}
+	panic("no mock code matched for Client.Query")
+}
+
+func (c *Client) MockQuery() *gsmock.Mocker12[*Request, *Response, error] {
+	return gsmock.Method12(c, c.Query, c.r)
+}
+
+// QueryArgs and QueryResults are the tuples of Query for gsmock.MockerT,
+// which the generated mocks use beyond the numbered mockers.
+type QueryArgs struct {
+	Req *Request
+}
+
+type QueryResults struct {
+	Resp *Response
+	Err  error
+}
+
+func (c *Client) MockQueryT() *gsmock.MockerT[QueryArgs, QueryResults] {
+	return gsmock.MethodT[QueryArgs, QueryResults](c, c.Query, c.r)
+}
+
+// allocBudgets is the maximum number of allocations per call on the
+// dispatch paths. Raise a budget only for a deliberate trade-off.
+var allocBudgets = []struct {
+	name   string
+	budget float64
+	setup  func(r *gsmock.Manager) func()
+}{
+	{
+		name:   "Method00/Return",
+		budget: 1,
+		setup: func(r *gsmock.Manager) func() {
+			c := &Client{r: r}
+			c.MockPing().ReturnDefault()
+			return c.Ping
+		},
+	},
+	{
+		name:   "Method12/Return",
+		budget: 1,
+		setup: func(r *gsmock.Manager) func() {
+			c := &Client{r: r}
+			c.MockQuery().ReturnValue(&Response{Value: "ok"}, nil)
+			req := &Request{ID: 1}
+			return func() { _, _ = c.Query(req) }
+		},
+	},
+	{
+		name:   "Method12/Unboxed",
+		budget: 3,
+		setup: func(r *gsmock.Manager) func() {
+			c := &Client{r: r}
+			c.MockQuery().ReturnValue(&Response{Value: "ok"}, nil)
+			req := &Request{ID: 1}
+			return func() { _, _ = gsmock.Invoke(r, c, c.Query, req) }
+		},
+	},
+	{
+		name:   "Method12/WhenArgs",
+		budget: 1,
+		setup: func(r *gsmock.Manager) func() {
+			c := &Client{r: r}
+			req := &Request{ID: 1}
+			c.MockQuery().WhenArgs(req).ReturnValue(&Response{Value: "ok"}, nil)
+			return func() { _, _ = c.Query(req) }
+		},
+	},
+	{
+		name:   "MethodT/Return",
+		budget: 3,
+		setup: func(r *gsmock.Manager) func() {
+			c := &Client{r: r}
+			c.MockQueryT().ReturnValue(QueryResults{Resp: &Response{Value: "ok"}})
+			req := &Request{ID: 1}
+			return func() { _, _ = c.Query(req) }
+		},
+	},
+	{
+		name:   "MethodT/WhenArgs",
+		budget: 4,
+		setup: func(r *gsmock.Manager) func() {
+			c := &Client{r: r}
+			req := &Request{ID: 1}
+			c.MockQueryT().WhenArgs(QueryArgs{Req: req}).ReturnValue(QueryResults{Resp: &Response{Value: "ok"}})
+			return func() { _, _ = c.Query(req) }
+		},
+	},
+	{
+		name:   "Func22/Handle",
+		budget: 2,
+		setup: func(r *gsmock.Manager) func() {
+			gsmock.Func22(Add, r).Handle(func(a, b int) (int, error) {
+				return a * b, nil
+			})
+			return func() { _, _ = gsmock.Invoke(r, nil, Add, 2, 3) }
+		},
+	},
+	{
+		name:   "Func22/Boxed",
+		budget: 0,
+		setup: func(r *gsmock.Manager) func() {
+			gsmock.Func22(Add, r).Handle(func(a, b int) (int, error) {
+				return a * b, nil
+			})
+			return func() {
+				ret, _ := gsmock.InvokeBoxed(r, nil, Add, gsmock.Box(2, 3))
+				gsmock.Release(ret)
+			}
+		},
+	},
+	{
+		name:   "VarFunc11/Return",
+		budget: 3,
+		setup: func(r *gsmock.Manager) func() {
+			gsmock.VarFunc11(Sum, r).ReturnValue(6)
+			nums := []int{1, 2, 3}
+			return func() { _, _ = gsmock.Invoke(r, nil, Sum, nums) }
+		},
+	},
+	{
+		name:   "VarFunc11/Boxed",
+		budget: 1,
+		setup: func(r *gsmock.Manager) func() {
+			gsmock.VarFunc11(Sum, r).ReturnValue(6)
+			nums := []int{1, 2, 3}
+			return func() {
+				ret, _ := gsmock.InvokeBoxed(r, nil, Sum, gsmock.Box(nums))
+				gsmock.Release(ret)
+			}
+		},
+	},
+}
+
+func TestAllocBudget(t *testing.T) {
+	for _, c := range allocBudgets {
+		t.Run(c.name, func(t *testing.T) {
+			fn := c.setup(gsmock.NewManager())
+			if n := testing.AllocsPerRun(1000, fn); n > c.budget {
+				t.Errorf("%s: %v allocs per call, budget is %v", c.name, n, c.budget)
+			}
+		})
+	}
+}
+
+func BenchmarkDispatch(b *testing.B) {
+	for _, c := range allocBudgets {
+		b.Run(c.name, func(b *testing.B) {
+			fn := c.setup(gsmock.NewManager())
+			b.ReportAllocs()
+			for b.Loop() {
+				fn()
+			}
+		})
+	}
+}
+
+// BenchmarkDispatchMiss measures the cost of walking the registered
+// mockers when none of them matches, the worst case of dispatch.
+func BenchmarkDispatchMiss(b *testing.B) {
+	r := gsmock.NewManager()
+	c := &Client{r: r}
+	for i := range 10 {
+		c.MockQuery().WhenArgs(&Request{ID: i}).ReturnDefault()
+	}
+	req := &Request{ID: -1}
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = gsmock.Invoke(r, c, c.Query, req)
+	}
+}
+
+// BenchmarkDispatchRecording measures dispatch with call recording enabled.
+func BenchmarkDispatchRecording(b *testing.B) {
+	r := gsmock.NewManager()
+	r.EnableRecording(gsmock.RetentionPolicy{KeepLast: 100})
+	c := &Client{r: r}
+	c.MockQuery().ReturnValue(&Response{Value: "ok"}, nil)
+	req := &Request{ID: 1}
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = c.Query(req)
+	}
+}
+
+// BenchmarkDispatchParallel measures dispatch contention
+// when many goroutines call the same mock.
+func BenchmarkDispatchParallel(b *testing.B) {
+	r := gsmock.NewManager()
+	c := &Client{r: r}
+	c.MockQuery().ReturnValue(&Response{Value: "ok"}, nil)
+	req := &Request{ID: 1}
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = c.Query(req)
+		}
+	})
+}
+
+// BenchmarkDispatchTable measures dispatch among n entries of table-style
+// data, registered as n WhenArgs mocks scanned in turn, or as one keyed
+// mock looking them up in a hash map in constant time. The calls match
+// the last entry, the worst case of the scan.
+func BenchmarkDispatchTable(b *testing.B) {
+	for _, n := range []int{10, 100, 1000} {
+		req := &Request{ID: n - 1}
+		b.Run(fmt.Sprintf("WhenArgs/%d", n), func(b *testing.B) {
+			r := gsmock.NewManager()
+			c := &Client{r: r}
+			for i := range n {
+				c.MockQuery().WhenArgs(&Request{ID: i}).ReturnValue(&Response{Value: strconv.Itoa(i)}, nil)
+			}
+			b.ReportAllocs()
+			for b.Loop() {
+				_, _ = c.Query(req)
+			}
+		})
+		b.Run(fmt.Sprintf("WhenKey/%d", n), func(b *testing.B) {
+			r := gsmock.NewManager()
+			c := &Client{r: r}
+			returns := make(map[any]func() (*Response, error), n)
+			for i := range n {
+				resp := &Response{Value: strconv.Itoa(i)}
+				returns[i] = func() (*Response, error) { return resp, nil }
+			}
+			c.MockQuery().WhenKey(func(req *Request) any { return req.ID }).ReturnForKey(returns)
+			b.ReportAllocs()
+			for b.Loop() {
+				_, _ = c.Query(req)
+			}
+		})
+	}
+}
+
+// BenchmarkEqual measures the equality of WhenArgs on common argument
+// types, with the default options and with nil equal to empty, against
+// reflect.DeepEqual.
+func BenchmarkEqual(b *testing.B) {
+	body, otherBody := []byte("hello, world"), []byte("hello, world")
+	header := map[string][]string{"Accept": {"text/plain"}, "X-Trace": {"1", "2"}}
+	otherHeader := map[string][]string{"Accept": {"text/plain"}, "X-Trace": {"1", "2"}}
+	req, otherReq := Request{ID: 1}, Request{ID: 1}
+
+	eqBody, eqBodyPtr := gsmock.Eq(body), gsmock.Eq(&body)
+	eqHeader, eqReq := gsmock.Eq(header), gsmock.Eq(req)
+	cases := []struct {
+		name string
+		eq   func() bool
+	}{
+		{"Bytes", func() bool { return eqBody(otherBody) }},
+		{"BytesPointer", func() bool { return eqBodyPtr(&otherBody) }},
+		{"Header", func() bool { return eqHeader(otherHeader) }},
+		{"Struct", func() bool { return eqReq(otherReq) }},
+	}
+	for _, opts := range []gsmock.EqualOptions{{}, {NilEqualsEmpty: true}} {
+		old := gsmock.SetEqualOptions(opts)
+		for _, c := range cases {
+			name := c.name
+			if opts.NilEqualsEmpty {
+				name += "/NilEqualsEmpty"
+			}
+			b.Run(name, func(b *testing.B) {
+				b.ReportAllocs()
+				for b.Loop() {
+					if !c.eq() {
+						b.Fatal("not equal")
+					}
+				}
+			})
+		}
+		gsmock.SetEqualOptions(old)
+	}
+
+	b.Run("Bytes/DeepEqual", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = reflect.DeepEqual(body, otherBody)
+		}
+	})
+	b.Run("Header/DeepEqual", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = reflect.DeepEqual(header, otherHeader)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"sync"
+)
+
+// Captor collects the values of one argument across the calls matched
+// by a mocker. It is returned by the CaptureArgN methods of the Mocker
+// types and keeps the argument's static type, including instantiated
+// type parameters of generic interfaces.
+//
+// Captor is safe for concurrent use.
+type Captor[T any] struct {
+	mux    sync.Mutex
+	values []T
+}
+
+// capture appends a value to the captor.
+func (c *Captor[T]) capture(v T) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.values = append(c.values, v)
+}
+
+// Values returns all captured values in call order.
+func (c *Captor[T]) Values() []T {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return append([]T(nil), c.values...)
+}
+
+// Last returns the most recently captured value,
+// or the zero value if nothing has been captured.
+func (c *Captor[T]) Last() (v T) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if n := len(c.values); n > 0 {
+		v = c.values[n-1]
+	}
+	return
+}
+
+// Len returns the number of captured values.
+func (c *Captor[T]) Len() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return len(c.values)
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestCaptor(t *testing.T) {
+	r := gsmock.NewManager()
+	c := NewMockClient(r)
+
+	m := c.MockQuery()
+	reqs := m.CaptureArg1()
+	gsmockassert.Equal(t, reqs.Len(), 0)
+	gsmockassert.Nil(t, reqs.Last())
+
+	m.Handle(func(req *Request) (*Response, error) {
+		return &Response{}, nil
+	})
+
+	_, _ = c.Query(&Request{Value: 1})
+	_, _ = c.Query(&Request{Value: 2})
+
+	gsmockassert.Equal(t, reqs.Len(), 2)
+	gsmockassert.Equal(t, reqs.Last(), &Request{Value: 2})
+	gsmockassert.Equal(t, reqs.Values(), []*Request{{Value: 1}, {Value: 2}})
+
+	// Calls not matched by the mocker are not captured
+	{
+		r.Reset()
+		m = c.MockQuery()
+		reqs = m.CaptureArg1()
+		m.WhenArgs(&Request{Value: 1}).ReturnValue(&Response{}, nil)
+		c.MockQuery().ReturnValue(nil, nil)
+
+		_, _ = c.Query(&Request{Value: 1})
+		_, _ = c.Query(&Request{Value: 2})
+		gsmockassert.Equal(t, reqs.Values(), []*Request{{Value: 1}})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/go-spring/gs-mock/gsmock/chaos"
+)
+
+var errorType = reflect.TypeFor[error]()
+
+// ApplyChaos injects latency and errors into every call handled by the
+// mocks of r, according to the given profile. It is meant to smoke-test
+// the resilience of orchestrating code against all of its mocked
+// dependencies at once.
+//
+// A failing call returns zero values and the profile's error, and is only
+// possible for functions whose last result is an error. ApplyChaos affects
+// mocks registered both before and after it is called, and replaces the
+// profile applied before, if any. A zero Seed in the profile is replaced
+// by one derived from the seed of r.
+func ApplyChaos(r *Manager, p chaos.Profile) {
+	p.Seed = r.derivedSeed(p.Seed)
+	r.chaos = chaos.NewInjector(p)
+}
+
+// injectChaos applies the chaos profile of r to the results of fn.
+func (r *Manager) injectChaos(fn any, ret []any) []any {
+	if d := r.chaos.Latency(); d > 0 {
+		time.Sleep(d)
+	}
+	t := reflect.TypeOf(fn)
+	if n := t.NumOut(); n == 0 || t.Out(n-1) != errorType {
+		return ret
+	}
+	err := r.chaos.Fail()
+	if err == nil {
+		return ret
+	}
+	out := make([]any, len(ret))
+	out[len(out)-1] = err
+	return out
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package chaos describes failure injection profiles that can be applied
+// to all mocks of a gsmock.Manager at once via gsmock.ApplyChaos.
+package chaos
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// ErrInjected is the default error returned by calls that fail by chance.
+var ErrInjected = errors.New("chaos: injected error")
+
+// Profile describes the faults injected into mocked calls.
+type Profile struct {
+	// ErrorRate is the probability in [0, 1] that a call returns an error.
+	// Only functions whose last result is an error can fail.
+	ErrorRate float64
+
+	// Err is the error returned by failing calls. Defaults to ErrInjected.
+	Err error
+
+	// LatencyP99 is the 99th percentile of the latency added to each call.
+	// Latencies follow an exponential distribution. Zero adds no latency.
+	LatencyP99 time.Duration
+
+	// Seed makes the injected faults reproducible. gsmock.ApplyChaos
+	// replaces zero by a seed derived from that of the Manager.
+	Seed uint64
+}
+
+// Injector draws faults according to a Profile.
+// It is safe for concurrent use.
+type Injector struct {
+	p    Profile
+	mux  sync.Mutex
+	rand *rand.Rand
+}
+
+// NewInjector creates an Injector for the given profile.
+func NewInjector(p Profile) *Injector {
+	if p.Err == nil {
+		p.Err = ErrInjected
+	}
+	return &Injector{
+		p:    p,
+		rand: rand.New(rand.NewPCG(p.Seed, p.Seed)),
+	}
+}
+
+// Latency returns the latency to add to the next call.
+func (i *Injector) Latency() time.Duration {
+	if i.p.LatencyP99 <= 0 {
+		return 0
+	}
+	i.mux.Lock()
+	x := i.rand.ExpFloat64()
+	i.mux.Unlock()
+	// For an exponential distribution, P99 = mean * ln(100).
+	mean := float64(i.p.LatencyP99) / math.Log(100)
+	return time.Duration(x * mean)
+}
+
+// Fail returns the error the next call should fail with, or nil.
+func (i *Injector) Fail() error {
+	if i.p.ErrorRate <= 0 {
+		return nil
+	}
+	i.mux.Lock()
+	x := i.rand.Float64()
+	i.mux.Unlock()
+	if x < i.p.ErrorRate {
+		return i.p.Err
+	}
+	return nil
+}
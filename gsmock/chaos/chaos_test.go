@@ -0,0 +1,85 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chaos_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-spring/gs-mock/gsmock/chaos"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestInjector(t *testing.T) {
+
+	// Test case: zero profile injects nothing
+	{
+		i := chaos.NewInjector(chaos.Profile{})
+		for range 100 {
+			gsmockassert.Equal(t, i.Latency(), time.Duration(0))
+			gsmockassert.Nil(t, i.Fail())
+		}
+	}
+
+	// Test case: error rate
+	{
+		i := chaos.NewInjector(chaos.Profile{ErrorRate: 0.1, Seed: 42})
+		n := 0
+		for range 10000 {
+			if err := i.Fail(); err != nil {
+				gsmockassert.Equal(t, err, chaos.ErrInjected)
+				n++
+			}
+		}
+		if n < 900 || n > 1100 {
+			t.Errorf("got %d failures, expect about 1000", n)
+		}
+	}
+
+	// Test case: custom error
+	{
+		errBoom := errors.New("boom")
+		i := chaos.NewInjector(chaos.Profile{ErrorRate: 1, Err: errBoom})
+		gsmockassert.Equal(t, i.Fail(), errBoom)
+	}
+
+	// Test case: latency percentile
+	{
+		i := chaos.NewInjector(chaos.Profile{LatencyP99: 200 * time.Millisecond, Seed: 42})
+		var arr []time.Duration
+		for range 10000 {
+			arr = append(arr, i.Latency())
+		}
+		slices.Sort(arr)
+		p99 := arr[len(arr)*99/100]
+		if p99 < 160*time.Millisecond || p99 > 240*time.Millisecond {
+			t.Errorf("got p99 %v, expect about 200ms", p99)
+		}
+	}
+
+	// Test case: same seed, same faults
+	{
+		i1 := chaos.NewInjector(chaos.Profile{ErrorRate: 0.5, LatencyP99: time.Second, Seed: 7})
+		i2 := chaos.NewInjector(chaos.Profile{ErrorRate: 0.5, LatencyP99: time.Second, Seed: 7})
+		for range 100 {
+			gsmockassert.Equal(t, i1.Fail(), i2.Fail())
+			gsmockassert.Equal(t, i1.Latency(), i2.Latency())
+		}
+	}
+}
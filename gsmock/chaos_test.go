@@ -0,0 +1,80 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/chaos"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestApplyChaos(t *testing.T) {
+
+	// Test case: failing calls return zero values and the injected error
+	{
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		gsmock.ApplyChaos(r, chaos.Profile{ErrorRate: 0.5, Seed: 42})
+		c.MockQuery().ReturnValue(&Response{Message: "ok"}, nil)
+
+		failed := 0
+		for range 1000 {
+			resp, err := c.Query(&Request{})
+			if err != nil {
+				gsmockassert.Equal(t, err, chaos.ErrInjected)
+				gsmockassert.Nil(t, resp)
+				failed++
+			} else {
+				gsmockassert.Equal(t, resp.Message, "ok")
+			}
+		}
+		if failed < 400 || failed > 600 {
+			t.Errorf("got %d failures, expect about 500", failed)
+		}
+	}
+
+	// Test case: unmatched calls are not affected
+	{
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		gsmock.ApplyChaos(r, chaos.Profile{ErrorRate: 1})
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Query(&Request{})
+		}, "no mock code matched for MockClient.Query")
+	}
+
+	// Test case: latency is added to every matched call
+	{
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		gsmock.ApplyChaos(r, chaos.Profile{LatencyP99: 5 * time.Millisecond, Seed: 42})
+		c.MockQuery().ReturnValue(&Response{Message: "ok"}, nil)
+
+		start := time.Now()
+		for range 50 {
+			resp, err := c.Query(&Request{})
+			gsmockassert.Nil(t, err)
+			gsmockassert.Equal(t, resp.Message, "ok")
+		}
+		if d := time.Since(start); d < 10*time.Millisecond {
+			t.Errorf("got total latency %v, expect about 50ms", d)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"go/token"
+	"reflect"
+	"strings"
+)
+
+// Compare enables the differential mode for the generated mock: every
+// call matched by one of its mockers is replayed against the same method
+// of realImpl, with the same arguments, and the results of the mock and of
+// the real implementation are compared with reflect.DeepEqual. Close
+// returns an error for every call whose results diverge, which keeps
+// canned mocks honest as the contract of the real implementation evolves.
+//
+// The real implementation runs in a sandbox: a panic is recovered and
+// reported as a divergence instead of crashing the test. It still sees
+// the arguments of the calls, so it must not mutate them nor have side
+// effects the test depends on. Unmatched calls are not replayed.
+//
+// The method of realImpl is the one with the name and the signature of
+// the mocked method. The calls of a method realImpl lacks, or of an
+// unexported method, which reflection can't call, are reported as
+// divergences rather than skipped. Compare panics if mock or realImpl is
+// nil.
+func (r *Manager) Compare(mock any, realImpl any) {
+	if mock == nil || realImpl == nil {
+		panic("gsmock: Compare called with a nil mock or real implementation")
+	}
+	if r.compares == nil {
+		r.compares = make(map[any]reflect.Value)
+	}
+	r.compares[mock] = reflect.ValueOf(realImpl)
+}
+
+// compare replays a call of k matched by a mock against the real
+// implementation registered for its receiver, if any, and records the
+// differences between their results.
+func (r *Manager) compare(k funcKey, params []any, ret []any) {
+	impl, ok := r.compares[k.receiver]
+	if !ok {
+		return
+	}
+	name := funcName(k)
+	m, err := realMethod(k.receiver, impl, name[strings.LastIndex(name, ".")+1:])
+	if err != nil {
+		r.diverged(name, params, err.Error())
+		return
+	}
+	realRet, err := callSandboxed(m, params)
+	if err != nil {
+		r.diverged(name, params, err.Error())
+		return
+	}
+	if len(realRet) != len(ret) {
+		r.diverged(name, params, fmt.Sprintf("%d results, real %d", len(ret), len(realRet)))
+		return
+	}
+	var diffs []string
+	for i := range ret {
+		if !reflect.DeepEqual(ret[i], realRet[i]) {
+			diffs = append(diffs, fmt.Sprintf("result %d is %s, real %s",
+				i+1, formatValue(ret[i]), formatValue(realRet[i])))
+		}
+	}
+	if len(diffs) > 0 {
+		r.diverged(name, params, strings.Join(diffs, ", "))
+	}
+}
+
+// realMethod returns the method of impl the calls of the method of mock
+// named method are replayed against. It returns an error if the method is
+// unexported, which reflection can't call, or if impl has no method of
+// that name with the same signature as the mocked one.
+func realMethod(mock any, impl reflect.Value, method string) (reflect.Value, error) {
+	if !token.IsExported(method) {
+		return reflect.Value{}, fmt.Errorf("unexported method %s can't be replayed", method)
+	}
+	m := impl.MethodByName(method)
+	if !m.IsValid() {
+		return reflect.Value{}, fmt.Errorf("%s has no method %s", impl.Type(), method)
+	}
+	mocked, ok := reflect.TypeOf(mock).MethodByName(method)
+	if !ok {
+		return m, nil
+	}
+	if want := methodType(mocked.Type); m.Type() != want {
+		return reflect.Value{}, fmt.Errorf("real method %s is %s, mocked one %s", method, m.Type(), want)
+	}
+	return m, nil
+}
+
+// methodType returns the type of the method values of a method of type
+// t, i.e. without its receiver.
+func methodType(t reflect.Type) reflect.Type {
+	in := make([]reflect.Type, t.NumIn()-1)
+	for i := range in {
+		in[i] = t.In(i + 1)
+	}
+	out := make([]reflect.Type, t.NumOut())
+	for i := range out {
+		out[i] = t.Out(i)
+	}
+	return reflect.FuncOf(in, out, t.IsVariadic())
+}
+
+// diverged records a call whose mocked behavior diverges from the real one.
+func (r *Manager) diverged(name string, params []any, diff string) {
+	r.compareMux.Lock()
+	defer r.compareMux.Unlock()
+	r.divergences = append(r.divergences, fmt.Errorf(
+		"gsmock: %s%s diverges from the real implementation: %s", name, formatParams(params), diff))
+}
+
+// callSandboxed calls the method m with params and returns its results.
+// It returns an error if the call panics or params don't fit m.
+func callSandboxed(m reflect.Value, params []any) (ret []any, err error) {
+	t := m.Type()
+	if len(params) != t.NumIn() {
+		return nil, fmt.Errorf("real method has %d parameters, got %d", t.NumIn(), len(params))
+	}
+	args := make([]reflect.Value, len(params))
+	for i, p := range params {
+		if p == nil {
+			args[i] = reflect.Zero(t.In(i))
+		} else {
+			args[i] = reflect.ValueOf(p)
+		}
+		if !args[i].Type().AssignableTo(t.In(i)) {
+			return nil, fmt.Errorf("real method takes %s as parameter %d, got %s", t.In(i), i+1, args[i].Type())
+		}
+	}
+	defer func() {
+		if x := recover(); x != nil {
+			ret, err = nil, fmt.Errorf("real implementation panicked: %v", x)
+		}
+	}()
+	var out []reflect.Value
+	if t.IsVariadic() {
+		out = m.CallSlice(args)
+	} else {
+		out = m.Call(args)
+	}
+	ret = make([]any, len(out))
+	for i, v := range out {
+		ret[i] = v.Interface()
+	}
+	return ret, nil
+}
+
+// checkCompared returns the divergences recorded by the differential mode.
+func (r *Manager) checkCompared() []error {
+	r.compareMux.Lock()
+	defer r.compareMux.Unlock()
+	return r.divergences
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// realClient is the real implementation the mocks of ClientInterface are compared with.
+type realClient struct{}
+
+func (realClient) Query(req *Request) (*Response, error) {
+	switch req.Value {
+	case 0:
+		panic("invalid request")
+	case 1:
+		return &Response{Message: "ok"}, nil
+	}
+	return nil, errors.New("not found")
+}
+
+// otherClient has a Query method unlike the one of ClientInterface.
+type otherClient struct{}
+
+func (otherClient) Query(req string) string { return req }
+
+// lowerMock is a mock whose method is unexported.
+type lowerMock struct{}
+
+func (*lowerMock) next() (string, error) { return "", nil }
+
+func TestCompare(t *testing.T) {
+
+	t.Run("consistent", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		r.Compare(c, realClient{})
+		c.MockQuery().WhenArgs(&Request{Value: 1}).ReturnValue(&Response{Message: "ok"}, nil)
+		c.MockQuery().WhenArgs(&Request{Value: 2}).ReturnValue(nil, errors.New("not found"))
+
+		_, _ = c.Query(&Request{Value: 1})
+		_, _ = c.Query(&Request{Value: 2})
+		gsmockassert.Nil(t, r.Close())
+	})
+
+	t.Run("diverging", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		r.Compare(c, realClient{})
+		c.MockQuery().WhenArgs(&Request{Value: 1}).ReturnValue(&Response{Message: "stale"}, nil)
+		c.MockQuery().WhenArgs(&Request{Value: 2}).ReturnValue(&Response{Message: "found"}, nil)
+
+		resp, _ := c.Query(&Request{Value: 1})
+		gsmockassert.Equal(t, resp.Message, "stale") // the mock still decides
+		_, _ = c.Query(&Request{Value: 2})
+		gsmockassert.Match(t, r.Close().Error(), `^gsmock: .*\(\*MockClient\)\.Query\(&\{Value:1\}\) diverges from the real implementation: `+
+			`result 1 is &\{Message:stale\}, real &\{Message:ok\}\n`+
+			`gsmock: .*\(\*MockClient\)\.Query\(&\{Value:2\}\) diverges from the real implementation: `+
+			`result 1 is &\{Message:found\}, real <nil>, result 2 is <nil>, real not found$`)
+	})
+
+	t.Run("sandbox", func(t *testing.T) {
+		tt := &fakeT{}
+		r := gsmock.NewManagerT(tt)
+		c := NewMockClient(r)
+		r.Compare(c, realClient{})
+		c.MockQuery().ReturnDefault()
+
+		_, _ = c.Query(&Request{Value: 0})
+		tt.finish()
+		gsmockassert.Equal(t, len(tt.errors), 1)
+		gsmockassert.Match(t, tt.errors[0], `diverges from the real implementation: real implementation panicked: invalid request$`)
+	})
+
+	t.Run("unmatched", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		r.Compare(c, realClient{})
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Query(&Request{Value: 1})
+		}, "no mock code matched")
+		gsmockassert.Nil(t, r.Close())
+	})
+
+	t.Run("unresolved", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		r.Compare(c, otherClient{})
+		c.MockQuery().ReturnValue(&Response{Message: "ok"}, nil)
+		_, _ = c.Query(&Request{Value: 1})
+
+		m := &lowerMock{}
+		r.Compare(m, m)
+		gsmock.Method02(m, m.next, r).ReturnValue("id", nil)
+		_, _ = gsmock.Invoke(r, m, m.next)
+
+		gsmockassert.Match(t, r.Close().Error(), `^gsmock: .*\(\*MockClient\)\.Query\(&\{Value:1\}\) diverges from the real implementation: `+
+			`real method Query is func\(string\) string, mocked one func\(\*gsmock_test.Request\) \(\*gsmock_test.Response, error\)\n`+
+			`gsmock: .*\(\*lowerMock\)\.next\(\) diverges from the real implementation: unexported method next can't be replayed$`)
+	})
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"reflect"
+	"sync"
+)
+
+var (
+	defaultMux sync.RWMutex
+	defaults   = make(map[reflect.Type]func() any)
+)
+
+// RegisterDefault registers the value ReturnDefault returns for results of
+// type T instead of its zero value, e.g. an empty *Response rather than a
+// nil pointer, or context.Background() for context.Context results. It
+// keeps code under test that assumes non-nil results from panicking.
+//
+// fn is called on every call returning a default, so that each call gets
+// its own value. Registering a default for the same type again replaces
+// the previous one.
+func RegisterDefault[T any](fn func() T) {
+	defaultMux.Lock()
+	defer defaultMux.Unlock()
+	defaults[reflect.TypeFor[T]()] = func() any { return fn() }
+}
+
+// RegisterDefaultFor is like RegisterDefault, but only applies to the mocks
+// of the Manager r, taking precedence over the defaults registered with
+// RegisterDefault. Like with RegisterDefault, registering a default for
+// a type again replaces the previous one.
+func RegisterDefaultFor[T any](r *Manager, fn func() T) {
+	if r.defaults == nil {
+		r.defaults = make(map[reflect.Type]func() any)
+	}
+	r.defaults[reflect.TypeFor[T]()] = func() any { return fn() }
+}
+
+// defaultFor returns the default value of type t for the calls dispatched
+// by r: the one registered for r, else the one registered globally, or nil
+// if no default is registered for t.
+func (r *Manager) defaultFor(t reflect.Type) any {
+	fn := r.defaults[t]
+	if fn == nil {
+		defaultMux.RLock()
+		fn = defaults[t]
+		defaultMux.RUnlock()
+	}
+	if fn == nil {
+		return nil
+	}
+	return fn()
+}
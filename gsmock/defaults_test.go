@@ -0,0 +1,64 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// newContext is a function returning an interface type.
+func newContext() context.Context { return nil }
+
+func TestRegisterDefault(t *testing.T) {
+	gsmock.RegisterDefault(func() *Response { return &Response{Message: "default"} })
+	defer gsmock.RegisterDefault(func() *Response { return nil })
+
+	r := gsmock.NewManager()
+	c := NewMockClient(r)
+	c.MockQuery().ReturnDefault()
+
+	resp, err := c.Query(&Request{})
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp.Message, "default")
+
+	// every call gets its own value
+	resp2, _ := c.Query(&Request{})
+	gsmockassert.Equal(t, resp2 != resp, true)
+
+	// defaults of the Manager take precedence
+	gsmock.RegisterDefaultFor(r, func() *Response { return &Response{Message: "manager"} })
+	resp, _ = c.Query(&Request{})
+	gsmockassert.Equal(t, resp.Message, "manager")
+
+	// other Managers keep the global defaults
+	c = NewMockClient(gsmock.NewManager())
+	c.MockQuery().ReturnDefault()
+	resp, _ = c.Query(&Request{})
+	gsmockassert.Equal(t, resp.Message, "default")
+
+	// interface types
+	r = gsmock.NewManager()
+	gsmock.RegisterDefaultFor(r, context.Background)
+	gsmock.Func01(newContext, r).ReturnDefault()
+	ret, ok := gsmock.Invoke(r, nil, newContext)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, gsmock.Unbox1[context.Context](ret), context.Background())
+}
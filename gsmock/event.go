@@ -0,0 +1,107 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"sync"
+)
+
+// EventBufferSize is the capacity of the channel returned by Events.
+const EventBufferSize = 1024
+
+// EventKind is the kind of Event.
+type EventKind int
+
+const (
+	EventRegister EventKind = iota // a mocker was registered
+	EventDispatch                  // a mocked call started
+	EventMatch                     // a mocked call was handled by a mocker
+	EventMiss                      // a mocked call matched no mocker
+	EventVerify                    // Close found a verification failure
+)
+
+// String returns the name of the kind.
+func (k EventKind) String() string {
+	switch k {
+	case EventRegister:
+		return "register"
+	case EventDispatch:
+		return "dispatch"
+	case EventMatch:
+		return "match"
+	case EventMiss:
+		return "miss"
+	case EventVerify:
+		return "verify"
+	default:
+		return "unknown"
+	}
+}
+
+// Event describes an activity of a Manager.
+type Event struct {
+	Kind    EventKind
+	Func    string // the mocked function, empty for EventVerify
+	Params  []any  // the call parameters, for EventDispatch, EventMatch and EventMiss
+	Results []any  // the returned values, for EventMatch
+	Err     error  // the verification failure, for EventVerify
+}
+
+// eventStream is the channel of events of a Manager.
+type eventStream struct {
+	mu     sync.RWMutex
+	ch     chan Event
+	closed bool
+}
+
+// Events returns a channel receiving the activity of the Manager as it
+// happens, for harnesses such as fuzzing drivers to consume. The channel
+// is closed by Close, after the verification failures were sent.
+//
+// Events are never dropped: once the channel buffer of EventBufferSize is
+// full, mocked calls block until the consumer catches up, so the channel
+// must be drained until it is closed. Every call returns the same channel.
+func (r *Manager) Events() <-chan Event {
+	if r.events == nil {
+		r.events = &eventStream{ch: make(chan Event, EventBufferSize)}
+	}
+	return r.events.ch
+}
+
+// emit sends e to the event channel, if it's still open.
+func (r *Manager) emit(e Event) {
+	s := r.events
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.closed {
+		s.ch <- e
+	}
+}
+
+// closeEvents sends the verification failures and closes the event channel.
+func (r *Manager) closeEvents(errs []error) {
+	for _, err := range errs {
+		r.emit(Event{Kind: EventVerify, Err: err})
+	}
+	s := r.events
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.ch)
+	}
+}
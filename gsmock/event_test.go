@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestEvents(t *testing.T) {
+	r := gsmock.NewManager()
+	events := r.Events()
+
+	c := NewMockClient(r)
+	c.MockQuery().WhenArgs(&Request{Value: 1}).Freeze().ReturnValue(&Response{Message: "ok"}, nil)
+
+	resp, _ := c.Query(&Request{Value: 1})
+	resp.Message = "changed"
+	gsmockassert.Panic(t, func() {
+		_, _ = c.Query(&Request{Value: 2})
+	}, "no mock code matched")
+	gsmockassert.Match(t, r.Close().Error(), "was mutated")
+
+	var got []gsmock.Event
+	for e := range events {
+		got = append(got, e)
+	}
+	gsmockassert.Equal(t, len(got), 6)
+
+	kinds := make([]string, len(got))
+	for i, e := range got {
+		kinds[i] = e.Kind.String()
+	}
+	gsmockassert.Equal(t, kinds, []string{"register", "dispatch", "match", "dispatch", "miss", "verify"})
+
+	gsmockassert.Match(t, got[0].Func, `\(\*MockClient\)\.Query$`)
+	gsmockassert.Equal(t, got[2].Params, []any{&Request{Value: 1}})
+	gsmockassert.Equal(t, got[2].Results, []any{&Response{Message: "changed"}, nil})
+	gsmockassert.Equal(t, got[4].Params, []any{&Request{Value: 2}})
+	gsmockassert.Nil(t, got[4].Results)
+	gsmockassert.Match(t, got[5].Err.Error(), "was mutated")
+}
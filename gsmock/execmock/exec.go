@@ -0,0 +1,70 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package execmock provides a mockable abstraction over os/exec,
+// so that code shelling out to external commands can be tested
+// hermetically with a gsmock.Manager.
+package execmock
+
+import (
+	"context"
+	"os/exec"
+)
+
+//go:generate gs-mock -o exec_mock.go -i 'Commander,Process'
+
+// Commander runs external commands. Production code should depend on
+// Commander instead of calling os/exec directly, using OS as the real
+// implementation and CommanderMockImpl in tests.
+type Commander interface {
+	Run(ctx context.Context, name string, args ...string) error
+	Output(ctx context.Context, name string, args ...string) ([]byte, error)
+	CombinedOutput(ctx context.Context, name string, args ...string) ([]byte, error)
+	Start(ctx context.Context, name string, args ...string) (Process, error)
+}
+
+// Process is a started command that can be waited for.
+type Process interface {
+	Wait() error
+}
+
+// OS is the Commander backed by os/exec.
+type OS struct{}
+
+// Run starts the named command and waits for it to complete.
+func (OS) Run(ctx context.Context, name string, args ...string) error {
+	return exec.CommandContext(ctx, name, args...).Run()
+}
+
+// Output runs the named command and returns its standard output.
+func (OS) Output(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return exec.CommandContext(ctx, name, args...).Output()
+}
+
+// CombinedOutput runs the named command and returns its combined
+// standard output and standard error.
+func (OS) CombinedOutput(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return exec.CommandContext(ctx, name, args...).CombinedOutput()
+}
+
+// Start starts the named command but does not wait for it to complete.
+func (OS) Start(ctx context.Context, name string, args ...string) (Process, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
@@ -0,0 +1,244 @@
+// Code generated by gs-mock v0.0.9. DO NOT EDIT.
+// Tool: https://github.com/go-spring/gs-mock
+// gs mock -o exec_mock.go -i 'Commander,Process'
+
+package execmock
+
+import (
+	"context"
+	"github.com/go-spring/gs-mock/gsmock"
+)
+
+// CommanderMockImpl is a generated mock implementation of the Commander interface.
+type CommanderMockImpl struct {
+	r *gsmock.Manager
+}
+
+// Names of the mocked methods of Commander, as reported by diagnostics and
+// transcripts, and as looked up by gsmock.Manager.CallsByName.
+const (
+	CommanderMethodRun            = "Run"
+	CommanderMethodOutput         = "Output"
+	CommanderMethodCombinedOutput = "CombinedOutput"
+	CommanderMethodStart          = "Start"
+)
+
+// NewCommanderMockImpl creates a new mock instance for Commander with the given
+// gsmock.Manager. Returns an initialized struct ready for registering mock behavior.
+// It fails fast if the gsmock runtime is incompatible with the generated code.
+func NewCommanderMockImpl(r *gsmock.Manager) *CommanderMockImpl {
+	r.RequireVersion("v0.0.9")
+	gsmock.RegisterStamp[Commander]("c4612801")
+	return &CommanderMockImpl{r: r}
+}
+
+func init() {
+	gsmock.RegisterMock(func(r *gsmock.Manager) Commander { return NewCommanderMockImpl(r) })
+}
+
+// CommanderStubs holds optional implementations of the methods of Commander,
+// registered at once by ApplyStubs.
+type CommanderStubs struct {
+	Run            func(ctx context.Context, name string, args ...string) error
+	Output         func(ctx context.Context, name string, args ...string) ([]byte, error)
+	CombinedOutput func(ctx context.Context, name string, args ...string) ([]byte, error)
+	Start          func(ctx context.Context, name string, args ...string) (Process, error)
+}
+
+// ApplyStubs registers the non-nil functions of stubs as the Handle mocks
+// of their methods.
+func (impl *CommanderMockImpl) ApplyStubs(stubs CommanderStubs) {
+	if stubs.Run != nil {
+		impl.MockRun().Handle(func(ctx context.Context, name string, args []string) error {
+			return stubs.Run(ctx, name, args...)
+		})
+	}
+	if stubs.Output != nil {
+		impl.MockOutput().Handle(func(ctx context.Context, name string, args []string) ([]byte, error) {
+			return stubs.Output(ctx, name, args...)
+		})
+	}
+	if stubs.CombinedOutput != nil {
+		impl.MockCombinedOutput().Handle(func(ctx context.Context, name string, args []string) ([]byte, error) {
+			return stubs.CombinedOutput(ctx, name, args...)
+		})
+	}
+	if stubs.Start != nil {
+		impl.MockStart().Handle(func(ctx context.Context, name string, args []string) (Process, error) {
+			return stubs.Start(ctx, name, args...)
+		})
+	}
+}
+
+//go:noinline
+func (impl *CommanderMockImpl) funcRun() func(ctx context.Context, name string, args ...string) error {
+	return impl.Run
+}
+
+// Run calls the registered mock for Run via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
+func (impl *CommanderMockImpl) Run(ctx context.Context, name string, args ...string) error {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcRun(), gsmock.Box(ctx, name, args)); ok {
+		defer gsmock.Release(ret)
+		return gsmock.Unbox1[error](ret)
+	}
+	panic(gsmock.Unmatched[Commander]("CommanderMockImpl."+CommanderMethodRun, "c4612801"))
+}
+
+// ExpectNoRun forbids any call to Run: if one occurs, the test
+// fails immediately. Mocks of Run registered earlier take precedence.
+func (impl *CommanderMockImpl) ExpectNoRun() {
+	impl.MockRun().Never()
+}
+
+// MockRun returns a VarMocker31
+// for registering mock behavior of Run with specific parameter and return types.
+func (impl *CommanderMockImpl) MockRun() *gsmock.VarMocker31[context.Context, string, string, error] {
+	return gsmock.VarMethod31(impl, impl.funcRun(), impl.r)
+}
+
+//go:noinline
+func (impl *CommanderMockImpl) funcOutput() func(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return impl.Output
+}
+
+// Output calls the registered mock for Output via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
+func (impl *CommanderMockImpl) Output(ctx context.Context, name string, args ...string) ([]byte, error) {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcOutput(), gsmock.Box(ctx, name, args)); ok {
+		defer gsmock.Release(ret)
+		return gsmock.Unbox2[[]byte, error](ret)
+	}
+	panic(gsmock.Unmatched[Commander]("CommanderMockImpl."+CommanderMethodOutput, "c4612801"))
+}
+
+// ExpectNoOutput forbids any call to Output: if one occurs, the test
+// fails immediately. Mocks of Output registered earlier take precedence.
+func (impl *CommanderMockImpl) ExpectNoOutput() {
+	impl.MockOutput().Never()
+}
+
+// MockOutput returns a VarMocker32
+// for registering mock behavior of Output with specific parameter and return types.
+func (impl *CommanderMockImpl) MockOutput() *gsmock.VarMocker32[context.Context, string, string, []byte, error] {
+	return gsmock.VarMethod32(impl, impl.funcOutput(), impl.r)
+}
+
+//go:noinline
+func (impl *CommanderMockImpl) funcCombinedOutput() func(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return impl.CombinedOutput
+}
+
+// CombinedOutput calls the registered mock for CombinedOutput via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
+func (impl *CommanderMockImpl) CombinedOutput(ctx context.Context, name string, args ...string) ([]byte, error) {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcCombinedOutput(), gsmock.Box(ctx, name, args)); ok {
+		defer gsmock.Release(ret)
+		return gsmock.Unbox2[[]byte, error](ret)
+	}
+	panic(gsmock.Unmatched[Commander]("CommanderMockImpl."+CommanderMethodCombinedOutput, "c4612801"))
+}
+
+// ExpectNoCombinedOutput forbids any call to CombinedOutput: if one occurs, the test
+// fails immediately. Mocks of CombinedOutput registered earlier take precedence.
+func (impl *CommanderMockImpl) ExpectNoCombinedOutput() {
+	impl.MockCombinedOutput().Never()
+}
+
+// MockCombinedOutput returns a VarMocker32
+// for registering mock behavior of CombinedOutput with specific parameter and return types.
+func (impl *CommanderMockImpl) MockCombinedOutput() *gsmock.VarMocker32[context.Context, string, string, []byte, error] {
+	return gsmock.VarMethod32(impl, impl.funcCombinedOutput(), impl.r)
+}
+
+//go:noinline
+func (impl *CommanderMockImpl) funcStart() func(ctx context.Context, name string, args ...string) (Process, error) {
+	return impl.Start
+}
+
+// Start calls the registered mock for Start via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
+func (impl *CommanderMockImpl) Start(ctx context.Context, name string, args ...string) (Process, error) {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcStart(), gsmock.Box(ctx, name, args)); ok {
+		defer gsmock.Release(ret)
+		return gsmock.Unbox2[Process, error](ret)
+	}
+	panic(gsmock.Unmatched[Commander]("CommanderMockImpl."+CommanderMethodStart, "c4612801"))
+}
+
+// ExpectNoStart forbids any call to Start: if one occurs, the test
+// fails immediately. Mocks of Start registered earlier take precedence.
+func (impl *CommanderMockImpl) ExpectNoStart() {
+	impl.MockStart().Never()
+}
+
+// MockStart returns a VarMocker32
+// for registering mock behavior of Start with specific parameter and return types.
+func (impl *CommanderMockImpl) MockStart() *gsmock.VarMocker32[context.Context, string, string, Process, error] {
+	return gsmock.VarMethod32(impl, impl.funcStart(), impl.r)
+}
+
+// ProcessMockImpl is a generated mock implementation of the Process interface.
+type ProcessMockImpl struct {
+	r *gsmock.Manager
+}
+
+// Names of the mocked methods of Process, as reported by diagnostics and
+// transcripts, and as looked up by gsmock.Manager.CallsByName.
+const (
+	ProcessMethodWait = "Wait"
+)
+
+// NewProcessMockImpl creates a new mock instance for Process with the given
+// gsmock.Manager. Returns an initialized struct ready for registering mock behavior.
+// It fails fast if the gsmock runtime is incompatible with the generated code.
+func NewProcessMockImpl(r *gsmock.Manager) *ProcessMockImpl {
+	r.RequireVersion("v0.0.9")
+	gsmock.RegisterStamp[Process]("0bf7d734")
+	return &ProcessMockImpl{r: r}
+}
+
+func init() {
+	gsmock.RegisterMock(func(r *gsmock.Manager) Process { return NewProcessMockImpl(r) })
+}
+
+// ProcessStubs holds optional implementations of the methods of Process,
+// registered at once by ApplyStubs.
+type ProcessStubs struct {
+	Wait func() error
+}
+
+// ApplyStubs registers the non-nil functions of stubs as the Handle mocks
+// of their methods.
+func (impl *ProcessMockImpl) ApplyStubs(stubs ProcessStubs) {
+	if stubs.Wait != nil {
+		impl.MockWait().Handle(stubs.Wait)
+	}
+}
+
+//go:noinline
+func (impl *ProcessMockImpl) funcWait() func() error {
+	return impl.Wait
+}
+
+// Wait calls the registered mock for Wait via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics with gsmock.Unmatched.
+func (impl *ProcessMockImpl) Wait() error {
+	if ret, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcWait(), nil); ok {
+		defer gsmock.Release(ret)
+		return gsmock.Unbox1[error](ret)
+	}
+	panic(gsmock.Unmatched[Process]("ProcessMockImpl."+ProcessMethodWait, "0bf7d734"))
+}
+
+// ExpectNoWait forbids any call to Wait: if one occurs, the test
+// fails immediately. Mocks of Wait registered earlier take precedence.
+func (impl *ProcessMockImpl) ExpectNoWait() {
+	impl.MockWait().Never()
+}
+
+// MockWait returns a Mocker01
+// for registering mock behavior of Wait with specific parameter and return types.
+func (impl *ProcessMockImpl) MockWait() *gsmock.Mocker01[error] {
+	return gsmock.Method01(impl, impl.funcWait(), impl.r)
+}
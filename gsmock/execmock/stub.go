@@ -0,0 +1,180 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package execmock
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// Cmd selects the commands a stub applies to.
+type Cmd struct {
+	Name string   // command name, matched exactly
+	Args []string // arguments, matched exactly; nil matches any arguments
+}
+
+// match reports whether the command name and args are selected by c.
+func (c Cmd) match(name string, args []string) bool {
+	if name != c.Name {
+		return false
+	}
+	return c.Args == nil || slices.Equal(c.Args, args)
+}
+
+// Result describes the simulated outcome of a command.
+type Result struct {
+	Stdout   []byte // data written to standard output
+	Stderr   []byte // data written to standard error
+	ExitCode int    // non-zero exit codes produce an *ExitError
+	Hang     bool   // block until the context is done, like a hung command
+	Err      error  // error starting the command, e.g. exec.ErrNotFound
+}
+
+// ExitError is returned by stubbed commands that exit with a non-zero code.
+// Like *exec.ExitError, it exposes the exit code via ExitCode and the
+// captured standard error via Stderr. It wraps an *exec.ExitError of the
+// same code and standard error, so that code checking for one with
+// errors.As handles it as that of a real command.
+type ExitError struct {
+	Code   int
+	Stderr []byte
+	err    *exec.ExitError
+}
+
+// newExitError returns the ExitError of a command exiting with code.
+func newExitError(code int, stderr []byte) *ExitError {
+	e := &ExitError{Code: code, Stderr: stderr}
+	if state := exitState(code); state != nil {
+		e.err = &exec.ExitError{ProcessState: state, Stderr: stderr}
+	}
+	return e
+}
+
+// exitStates holds the *os.ProcessState returned by exitState, by code.
+var exitStates sync.Map
+
+// exitState returns the state of a process that exited with code, or nil
+// if there is none. As os.ProcessState can't be created otherwise, it is
+// that of a shell exiting with code, started once per code.
+func exitState(code int) *os.ProcessState {
+	if state, ok := exitStates.Load(code); ok {
+		return state.(*os.ProcessState)
+	}
+	cmd := exec.Command("sh", "-c", "exit "+strconv.Itoa(code))
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/c", "exit", strconv.Itoa(code))
+	}
+	var (
+		state   *os.ProcessState
+		exitErr *exec.ExitError
+	)
+	if errors.As(cmd.Run(), &exitErr) && exitErr.ExitCode() == code {
+		state = exitErr.ProcessState
+	}
+	actual, _ := exitStates.LoadOrStore(code, state)
+	return actual.(*os.ProcessState)
+}
+
+// Error implements the error interface.
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
+
+// ExitCode returns the exit code of the stubbed command.
+func (e *ExitError) ExitCode() int {
+	return e.Code
+}
+
+// Unwrap returns the *exec.ExitError of the stubbed command, or nil if
+// the code is out of the range of the exit codes of the platform.
+func (e *ExitError) Unwrap() error {
+	if e.err == nil {
+		return nil
+	}
+	return e.err
+}
+
+// wait simulates running the command to completion.
+func (res Result) wait(ctx context.Context) error {
+	if res.Err != nil {
+		return res.Err
+	}
+	if res.Hang {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	if res.ExitCode != 0 {
+		return newExitError(res.ExitCode, res.Stderr)
+	}
+	return nil
+}
+
+// process is the Process returned by stubbed Start calls.
+type process struct {
+	ctx context.Context
+	res Result
+}
+
+// Wait waits for the stubbed command to complete.
+func (p *process) Wait() error {
+	return p.res.wait(p.ctx)
+}
+
+// Stub registers mocks on m so that every method of Commander invoked
+// with a command selected by cmd behaves as described by res.
+// Stubs are matched in registration order, like any other mock.
+func Stub(m *CommanderMockImpl, cmd Cmd, res Result) {
+	m.MockRun().
+		When(func(ctx context.Context, name string, args []string) bool {
+			return cmd.match(name, args)
+		}).
+		Handle(func(ctx context.Context, name string, args []string) error {
+			return res.wait(ctx)
+		})
+	m.MockOutput().
+		When(func(ctx context.Context, name string, args []string) bool {
+			return cmd.match(name, args)
+		}).
+		Handle(func(ctx context.Context, name string, args []string) ([]byte, error) {
+			return res.Stdout, res.wait(ctx)
+		})
+	m.MockCombinedOutput().
+		When(func(ctx context.Context, name string, args []string) bool {
+			return cmd.match(name, args)
+		}).
+		Handle(func(ctx context.Context, name string, args []string) ([]byte, error) {
+			return bytes.Join([][]byte{res.Stdout, res.Stderr}, nil), res.wait(ctx)
+		})
+	m.MockStart().
+		When(func(ctx context.Context, name string, args []string) bool {
+			return cmd.match(name, args)
+		}).
+		Handle(func(ctx context.Context, name string, args []string) (Process, error) {
+			if res.Err != nil {
+				return nil, res.Err
+			}
+			return &process{ctx: ctx, res: res}, nil
+		})
+}
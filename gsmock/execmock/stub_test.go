@@ -0,0 +1,115 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package execmock_test
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/execmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// gitBranch is a sample function under test that shells out to git.
+func gitBranch(ctx context.Context, c execmock.Commander) (string, error) {
+	b, err := c.Output(ctx, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+func TestStub(t *testing.T) {
+	r := gsmock.NewManager()
+	c := execmock.NewCommanderMockImpl(r)
+
+	execmock.Stub(c, execmock.Cmd{Name: "git", Args: []string{"rev-parse", "--abbrev-ref", "HEAD"}},
+		execmock.Result{Stdout: []byte("main\n")})
+	execmock.Stub(c, execmock.Cmd{Name: "false"},
+		execmock.Result{Stderr: []byte("boom"), ExitCode: 2})
+	execmock.Stub(c, execmock.Cmd{Name: "missing"},
+		execmock.Result{Err: exec.ErrNotFound})
+	execmock.Stub(c, execmock.Cmd{Name: "sleep"},
+		execmock.Result{Stdout: []byte("out"), Hang: true})
+
+	// Test case: stdout of a successful command
+	{
+		branch, err := gitBranch(t.Context(), c)
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, branch, "main")
+	}
+
+	// Test case: non-zero exit code with stderr
+	{
+		err := c.Run(t.Context(), "false", "any", "args")
+		var exitErr *execmock.ExitError
+		gsmockassert.Equal(t, errors.As(err, &exitErr), true)
+		gsmockassert.Equal(t, exitErr.ExitCode(), 2)
+		gsmockassert.Equal(t, string(exitErr.Stderr), "boom")
+
+		// It is also handled like the error of a real command
+		var osErr *exec.ExitError
+		gsmockassert.Equal(t, errors.As(err, &osErr), true)
+		gsmockassert.Equal(t, osErr.ExitCode(), 2)
+		gsmockassert.Equal(t, string(osErr.Stderr), "boom")
+
+		b, err := c.CombinedOutput(t.Context(), "false")
+		gsmockassert.Equal(t, string(b), "boom")
+		gsmockassert.Equal(t, err.Error(), "exit status 2")
+	}
+
+	// Test case: command that cannot be started
+	{
+		p, err := c.Start(t.Context(), "missing")
+		gsmockassert.Nil(t, p)
+		gsmockassert.Equal(t, err, exec.ErrNotFound)
+	}
+
+	// Test case: hung command is released by the context
+	{
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+		p, err := c.Start(ctx, "sleep", "3600")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, p.Wait(), context.DeadlineExceeded)
+
+		b, err := c.Output(ctx, "sleep", "3600")
+		gsmockassert.Equal(t, string(b), "out")
+		gsmockassert.Equal(t, err, context.DeadlineExceeded)
+	}
+
+	// Test case: commands without stubs are not matched
+	gsmockassert.Panic(t, func() {
+		_ = c.Run(t.Context(), "git", "status")
+	}, "no mock code matched for CommanderMockImpl.Run")
+}
+
+func TestOS(t *testing.T) {
+	var c execmock.Commander = execmock.OS{}
+	b, err := c.Output(t.Context(), "go", "env", "GOOS")
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, len(b) > 0, true)
+
+	p, err := c.Start(t.Context(), "go", "version")
+	gsmockassert.Nil(t, err)
+	gsmockassert.Nil(t, p.Wait())
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"time"
+)
+
+// SetClock replaces the clock timing the mocked calls until the end of
+// the test t.
+func SetClock(t TB, fn func() time.Time) {
+	Swap(t, &now, fn)
+}
+
+// RetainedCap returns the capacity of the buffer retaining the calls of
+// the given function.
+func RetainedCap(r *Manager, receiver any, fn any) int {
+	r.recordMux.Lock()
+	defer r.recordMux.Unlock()
+	if c := r.records[newFuncKey(receiver, fn)]; c != nil {
+		return cap(c.calls)
+	}
+	return 0
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	fixtureMux sync.RWMutex
+	fixtures   = make(map[reflect.Type]any)
+)
+
+// RegisterFixture registers value as the fixture of type T, returned by
+// ReturnFixture for results of type T, so that canned objects shared by
+// the mocks of a suite, e.g. a default user or config, are defined once.
+//
+// Unlike the defaults of RegisterDefault, the same value is returned by
+// every call: use Freeze to detect code under test mutating it.
+// Registering a fixture for the same type again replaces the previous one.
+func RegisterFixture[T any](value T) {
+	fixtureMux.Lock()
+	defer fixtureMux.Unlock()
+	fixtures[reflect.TypeFor[T]()] = value
+}
+
+// fixtureOf returns the fixture registered for type T. Results of type
+// error are nil unless a fixture is registered for error, and other
+// types without fixture make the call panic.
+func fixtureOf[T any]() T {
+	t := reflect.TypeFor[T]()
+	fixtureMux.RLock()
+	v, ok := fixtures[t]
+	fixtureMux.RUnlock()
+	if !ok {
+		if t == reflect.TypeFor[error]() {
+			var zero T
+			return zero
+		}
+		panic(fmt.Sprintf("gsmock: no fixture registered for %s", t))
+	}
+	ret, _ := v.(T)
+	return ret
+}
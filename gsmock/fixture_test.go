@@ -0,0 +1,60 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+type fixtureUser struct {
+	Name string
+}
+
+type fixtureConfig struct {
+	Debug bool
+}
+
+func TestReturnFixture(t *testing.T) {
+	defaultUser := &fixtureUser{Name: "alice"}
+	gsmock.RegisterFixture(defaultUser)
+
+	t.Run("fixtures", func(t *testing.T) {
+		r := gsmock.NewManager()
+		getUser := func(id int) (*fixtureUser, error) { return nil, nil }
+		gsmock.Method12(nil, getUser, r).ReturnFixture()
+
+		for id := range 2 {
+			ret, ok := gsmock.Invoke(r, nil, getUser, id)
+			gsmockassert.Equal(t, ok, true)
+			user, err := gsmock.Unbox2[*fixtureUser, error](ret)
+			gsmockassert.Nil(t, err)
+			gsmockassert.Equal(t, user == defaultUser, true)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		r := gsmock.NewManager()
+		getConfig := func() *fixtureConfig { return nil }
+		gsmock.Method01(nil, getConfig, r).ReturnFixture()
+		gsmockassert.Panic(t, func() {
+			gsmock.Invoke(r, nil, getConfig)
+		}, `no fixture registered for \*gsmock_test.fixtureConfig`)
+	})
+}
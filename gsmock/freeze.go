@@ -0,0 +1,209 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"maps"
+	"math"
+	"reflect"
+)
+
+// freezeMode tells what a mocker configured with Freeze or
+// ExpectMutation expects from the values it returned.
+type freezeMode int
+
+const (
+	freezeNone      freezeMode = iota // returned values are not checked
+	freezeUnchanged                   // returned values must not be mutated
+	freezeMutated                     // returned values must be mutated
+)
+
+// frozenKey identifies a value returned by a mocker, so that a value
+// returned by several calls is only checksummed the first time.
+type frozenKey struct {
+	m     *mockerBase
+	index int
+	typ   reflect.Type
+	ptr   uintptr
+}
+
+// frozenValue is a value returned by a mocker, with its checksum taken
+// at return time.
+type frozenValue struct {
+	k     funcKey
+	index int
+	mode  freezeMode
+	v     reflect.Value
+	sum   uint64
+}
+
+// returned is called by the Invokers of the mockers with the values returned
+// by a matched call of r, which feed the result captors and are tagged with
+// their provenance if enabled. If the mocker is frozen, the pointers, slices
+// and maps among them are checksummed, to be verified when r closes.
+func (m *mockerBase) returned(r *Manager, ret []any) {
+	for _, fn := range m.resultCaptures {
+		fn(ret)
+	}
+	m.tag(r, ret)
+	if m.freeze == freezeNone {
+		return
+	}
+	for i, x := range ret {
+		v := reflect.ValueOf(x)
+		switch v.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map:
+			if v.IsNil() {
+				continue
+			}
+		default:
+			continue
+		}
+		r.freeze(frozenKey{m: m, index: i, typ: v.Type(), ptr: v.Pointer()}, frozenValue{
+			k:     m.k,
+			index: i,
+			mode:  m.freeze,
+			v:     v,
+			sum:   checksum(v),
+		})
+	}
+}
+
+// freeze records a returned value, unless it was already recorded.
+func (r *Manager) freeze(key frozenKey, v frozenValue) {
+	r.frozenMux.Lock()
+	defer r.frozenMux.Unlock()
+	if _, ok := r.frozen[key]; ok {
+		return
+	}
+	if r.frozen == nil {
+		r.frozen = make(map[frozenKey]*frozenValue)
+	}
+	r.frozen[key] = &v
+	r.frozenKeys = append(r.frozenKeys, key)
+}
+
+// checkFrozen verifies the checksums of the recorded values and returns
+// an error for each value whose state contradicts its mocker's mode.
+func (r *Manager) checkFrozen() []error {
+	r.frozenMux.Lock()
+	defer r.frozenMux.Unlock()
+	var errs []error
+	for _, key := range r.frozenKeys {
+		f := r.frozen[key]
+		mutated := checksum(f.v) != f.sum
+		switch {
+		case f.mode == freezeUnchanged && mutated:
+			errs = append(errs, fmt.Errorf("gsmock: result %d (%s) of %s was mutated after being returned",
+				f.index+1, f.v.Type(), funcName(f.k)))
+		case f.mode == freezeMutated && !mutated:
+			errs = append(errs, fmt.Errorf("gsmock: result %d (%s) of %s was not mutated after being returned",
+				f.index+1, f.v.Type(), funcName(f.k)))
+		}
+	}
+	return errs
+}
+
+// checksum returns a hash of the value v and of everything reachable from it.
+func checksum(v reflect.Value) uint64 {
+	h := fnv.New64a()
+	hashValue(h, v, make(map[uintptr]struct{}))
+	return h.Sum64()
+}
+
+// hashValue writes the state of v to h. Pointers already in visited are
+// only hashed by address, which cuts reference cycles.
+func hashValue(h hash.Hash64, v reflect.Value, visited map[uintptr]struct{}) {
+	var b [8]byte
+	writeUint := func(u uint64) {
+		binary.LittleEndian.PutUint64(b[:], u)
+		_, _ = h.Write(b[:])
+	}
+	if !v.IsValid() {
+		writeUint(0)
+		return
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint(1)
+		} else {
+			writeUint(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint(math.Float64bits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		writeUint(math.Float64bits(real(v.Complex())))
+		writeUint(math.Float64bits(imag(v.Complex())))
+	case reflect.String:
+		writeUint(uint64(v.Len()))
+		_, _ = h.Write([]byte(v.String()))
+	case reflect.Array:
+		for i := range v.Len() {
+			hashValue(h, v.Index(i), visited)
+		}
+	case reflect.Slice:
+		writeUint(uint64(v.Len()))
+		for i := range v.Len() {
+			hashValue(h, v.Index(i), visited)
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			hashValue(h, v.Field(i), visited)
+		}
+	case reflect.Map:
+		// Map iteration order is random, so the entries are
+		// hashed separately and combined by a commutative sum.
+		writeUint(uint64(v.Len()))
+		var sum uint64
+		for it := v.MapRange(); it.Next(); {
+			e := fnv.New64a()
+			seen := maps.Clone(visited)
+			hashValue(e, it.Key(), seen)
+			hashValue(e, it.Value(), seen)
+			sum += e.Sum64()
+		}
+		writeUint(sum)
+	case reflect.Pointer:
+		writeUint(uint64(v.Pointer()))
+		if v.IsNil() {
+			return
+		}
+		if _, ok := visited[v.Pointer()]; ok {
+			return
+		}
+		visited[v.Pointer()] = struct{}{}
+		hashValue(h, v.Elem(), visited)
+	case reflect.Interface:
+		if v.IsNil() {
+			writeUint(0)
+			return
+		}
+		_, _ = h.Write([]byte(v.Elem().Type().String()))
+		hashValue(h, v.Elem(), visited)
+	default: // Chan, Func, UnsafePointer
+		writeUint(uint64(v.Pointer()))
+	}
+}
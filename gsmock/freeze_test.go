@@ -0,0 +1,95 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestFreeze(t *testing.T) {
+
+	t.Run("unchanged", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		c.MockQuery().Freeze().ReturnValue(&Response{Message: "ok"}, nil)
+
+		resp, _ := c.Query(&Request{Value: 1})
+		_, _ = c.Query(&Request{Value: 2})
+		gsmockassert.Equal(t, resp.Message, "ok")
+		gsmockassert.Nil(t, r.Close())
+	})
+
+	t.Run("mutated", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		c.MockQuery().Freeze().ReturnValue(&Response{Message: "ok"}, nil)
+
+		resp, _ := c.Query(&Request{Value: 1})
+		resp.Message = "changed"
+		_, _ = c.Query(&Request{Value: 2})
+		err := r.Close()
+		gsmockassert.Match(t, err.Error(),
+			`^gsmock: result 1 \(\*gsmock_test.Response\) of .*\(\*MockClient\)\.Query was mutated after being returned$`)
+	})
+
+	t.Run("expect mutation", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		c.MockQuery().WhenArgs(&Request{Value: 1}).ExpectMutation().ReturnValue(&Response{Message: "a"}, nil)
+		c.MockQuery().WhenArgs(&Request{Value: 2}).ExpectMutation().ReturnValue(&Response{Message: "b"}, nil)
+
+		resp, _ := c.Query(&Request{Value: 1})
+		resp.Message = "changed"
+		_, _ = c.Query(&Request{Value: 2})
+		err := r.Close()
+		gsmockassert.Match(t, err.Error(),
+			`^gsmock: result 1 \(\*gsmock_test.Response\) of .*\(\*MockClient\)\.Query was not mutated after being returned$`)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		n := &node{Tags: map[string][]int{"a": {1, 2}, "b": {3}}}
+		n.Next = n
+
+		r := gsmock.NewManager()
+		s := &nodeStore{}
+		gsmock.Method01(s, s.Root, r).Freeze().ReturnValue(n)
+
+		ret, ok := gsmock.Invoke(r, s, s.Root)
+		gsmockassert.Equal(t, ok, true)
+		gsmockassert.Equal(t, ret[0].(*node), n)
+		gsmockassert.Nil(t, r.Close())
+
+		r = gsmock.NewManager()
+		gsmock.Method01(s, s.Root, r).Freeze().ReturnValue(n)
+		_, _ = gsmock.Invoke(r, s, s.Root)
+		n.Tags["b"][0] = 4
+		gsmockassert.Match(t, r.Close().Error(), `result 1 \(\*gsmock_test.node\) of .* was mutated`)
+	})
+}
+
+// node is a self-referencing value returned by nodeStore.
+type node struct {
+	Tags map[string][]int
+	Next *node
+}
+
+type nodeStore struct{}
+
+func (s *nodeStore) Root() *node { return nil }
@@ -0,0 +1,70 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+// Gen generates values of type V, returned by the mockers configured
+// with ReturnGen. Property-based tests use it to explore how the code
+// under test handles arbitrary responses of its dependencies.
+type Gen[V any] interface {
+	Generate() V
+}
+
+// GenFunc adapts a function to Gen, e.g. one drawing from Manager.NewRand
+// for pseudo-random values replayed with the seed of the Manager.
+type GenFunc[V any] func() V
+
+// Generate calls f.
+func (f GenFunc[V]) Generate() V {
+	return f()
+}
+
+// Drawer is a generator drawing values of type V with the state t of a
+// property-based test, like the generators of pgregory.net/rapid, which
+// implement Drawer[*rapid.T, V].
+type Drawer[T any, V any] interface {
+	Draw(t T, label string) V
+}
+
+// FromDrawer returns a Gen drawing values from d with t, labeled with
+// label. The values are drawn within the property under test, so that
+// the framework records them, replays them deterministically from its
+// seed, and shrinks them along with the other drawn values:
+//
+//	rapid.Check(t, func(rt *rapid.T) {
+//		c.MockQuery().ReturnGen(
+//			gsmock.FromDrawer(respGen, rt, "resp"),
+//			gsmock.FromDrawer(errGen, rt, "err"),
+//		)
+//		...
+//	})
+func FromDrawer[T any, V any](d Drawer[T, V], t T, label string) Gen[V] {
+	return GenFunc[V](func() V { return d.Draw(t, label) })
+}
+
+// FromSampler returns a Gen retrieving values from the results of gen,
+// called with params, like the generators of github.com/leanovate/gopter,
+// whose results implement Retrieve: FromSampler[V](gen, params).
+// gopter only shrinks the arguments of properties: to shrink the values
+// returned by a mock, generate them as arguments and use ReturnValue.
+// Values that can't be retrieved, or whose type isn't V, are zero.
+func FromSampler[V any, P any, R interface{ Retrieve() (any, bool) }](gen func(P) R, params P) Gen[V] {
+	return GenFunc[V](func() V {
+		x, _ := gen(params).Retrieve()
+		v, _ := x.(V)
+		return v
+	})
+}
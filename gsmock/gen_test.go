@@ -0,0 +1,109 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// propT mimics the state of a property-based test, such as *rapid.T.
+type propT struct {
+	draws []string
+}
+
+// messageGen mimics a generator of rapid, drawing with a *propT.
+type messageGen struct{ rnd *rand.Rand }
+
+func (g messageGen) Draw(t *propT, label string) *Response {
+	t.draws = append(t.draws, label)
+	return &Response{Message: string(rune('a' + g.rnd.IntN(26)))}
+}
+
+// genParams and genResult mimic the parameters and results of gopter generators.
+type genParams struct{ n int }
+
+type genResult struct {
+	v  any
+	ok bool
+}
+
+func (r *genResult) Retrieve() (any, bool) { return r.v, r.ok }
+
+// sampleGen mimics gopter.Gen, a named function type.
+type sampleGen func(*genParams) *genResult
+
+func TestReturnGen(t *testing.T) {
+
+	t.Run("drawer", func(t *testing.T) {
+		draw := func(seed uint64) (*propT, []string) {
+			r := gsmock.NewManager()
+			c := NewMockClient(r)
+			pt := &propT{}
+			gen := messageGen{rnd: rand.New(rand.NewPCG(seed, seed))}
+			c.MockQuery().ReturnGen(
+				gsmock.FromDrawer(gen, pt, "resp"),
+				gsmock.GenFunc[error](func() error { return nil }),
+			)
+			var messages []string
+			for range 5 {
+				resp, err := c.Query(&Request{})
+				gsmockassert.Nil(t, err)
+				messages = append(messages, resp.Message)
+			}
+			return pt, messages
+		}
+		pt, a := draw(1)
+		_, b := draw(1)
+		gsmockassert.Equal(t, a, b) // deterministic for a seed
+		gsmockassert.Equal(t, pt.draws, []string{"resp", "resp", "resp", "resp", "resp"})
+	})
+
+	t.Run("sampler", func(t *testing.T) {
+		params := &genParams{}
+		var gen sampleGen = func(p *genParams) *genResult {
+			p.n++
+			if p.n%2 == 0 {
+				return &genResult{v: errors.New("even"), ok: true}
+			}
+			return &genResult{}
+		}
+
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		c.MockQuery().ReturnGen(
+			gsmock.GenFunc[*Response](func() *Response { return nil }),
+			gsmock.FromSampler[error](gen, params),
+		)
+		_, err := c.Query(&Request{})
+		gsmockassert.Nil(t, err)
+		_, err = c.Query(&Request{})
+		gsmockassert.Equal(t, err.Error(), "even")
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		gsmockassert.Panic(t, func() {
+			c.MockQuery().ReturnGen(nil, nil)
+		}, "gsmock: nil function passed to ReturnGen")
+	})
+}
@@ -14,12 +14,18 @@
  * limitations under the License.
  */
 
-package assert
+// Package gsmockassert provides the few assertions tests of mocked code
+// typically need, so that they don't require another assertion library.
+// Failures are reported through the same test as the gsmock.Manager ones.
+package gsmockassert
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
+
+	"github.com/go-spring/gs-mock/gsmock"
 )
 
 // T is the minimal interface that *testing.T satisfies.
@@ -84,6 +90,14 @@ func recovery(fn func()) (str string, recovered bool) {
 	return "", false
 }
 
+// ErrorIs asserts that errors.Is(err, target) is true.
+func ErrorIs(t T, err error, target error) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Errorf("got error %v which is not %v", err, target)
+	}
+}
+
 // Panic asserts that fn panics.
 // If fn does not panic, it fails.
 // If expr is non-empty, it must be a valid regexp that matches the panic message.
@@ -92,14 +106,14 @@ func Panic(t T, fn func(), expr string) {
 	if str, ok := recovery(fn); !ok {
 		t.Errorf("did not panic")
 	} else {
-		matches(t, str, expr)
+		Match(t, str, expr)
 	}
 }
 
-// matches asserts that got matches the given regular expression expr.
+// Match asserts that got matches the given regular expression expr.
 // If expr is empty, it fails.
 // If expr is invalid or does not match, it fails.
-func matches(t T, got string, expr string) {
+func Match(t T, got string, expr string) {
 	t.Helper()
 	if expr == "" {
 		t.Errorf("empty pattern")
@@ -111,3 +125,13 @@ func matches(t T, got string, expr string) {
 		t.Errorf("got %q which does not match %q", got, expr)
 	}
 }
+
+// Called asserts that the given mocked function was called n times.
+// receiver and fn identify the function the same way as in gsmock.Invoke,
+// and call recording must be enabled with Manager.EnableRecording.
+func Called(t T, r *gsmock.Manager, receiver any, fn any, n int) {
+	t.Helper()
+	if got := r.CallCount(receiver, fn); got != n {
+		t.Errorf("got %d calls but expect %d", got, n)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmockassert_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// fakeT is a gsmockassert.T that records failures instead of reporting them.
+type fakeT struct {
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertions(t *testing.T) {
+	ft := &fakeT{}
+	errBase := errors.New("base")
+
+	gsmockassert.Nil(ft, (*int)(nil))
+	gsmockassert.Equal(ft, []int{1}, []int{1})
+	gsmockassert.ErrorIs(ft, fmt.Errorf("wrap: %w", errBase), errBase)
+	gsmockassert.Match(ft, "hello world", "^hello")
+	gsmockassert.Panic(ft, func() { panic("boom") }, "boom")
+	gsmockassert.Equal(t, len(ft.errors), 0)
+
+	gsmockassert.Nil(ft, 1)
+	gsmockassert.Equal(ft, 1, 2)
+	gsmockassert.ErrorIs(ft, errors.New("other"), errBase)
+	gsmockassert.Match(ft, "hello", "^world")
+	gsmockassert.Panic(ft, func() {}, "boom")
+	gsmockassert.Equal(t, ft.errors, []string{
+		"got (int) 1 but expect nil",
+		"got (int) 1 but expect (int) 2",
+		"got error other which is not base",
+		`got "hello" which does not match "^world"`,
+		"did not panic",
+	})
+}
+
+func Ping() {}
+
+func TestCalled(t *testing.T) {
+	r := gsmock.NewManager()
+	r.EnableRecording(gsmock.RetentionPolicy{CountOnly: true})
+	gsmock.Func00(Ping, r).ReturnDefault()
+	_, _ = gsmock.Invoke(r, nil, Ping)
+	_, _ = gsmock.Invoke(r, nil, Ping)
+
+	ft := &fakeT{}
+	gsmockassert.Called(ft, r, nil, Ping, 2)
+	gsmockassert.Called(ft, r, nil, Ping, 1)
+	gsmockassert.Equal(t, ft.errors, []string{"got 2 calls but expect 1"})
+}
@@ -0,0 +1,21 @@
+module github.com/go-spring/gs-mock/gsmock/gsmockotel
+
+go 1.26
+
+require (
+	github.com/go-spring/gs-mock v0.0.8
+	go.opentelemetry.io/otel v1.38.0
+	go.opentelemetry.io/otel/trace v1.38.0
+)
+
+require (
+	github.com/bytedance/mockey v1.4.5 // indirect
+	github.com/gopherjs/gopherjs v1.12.80 // indirect
+	github.com/jtolds/gls v4.20.0+incompatible // indirect
+	github.com/smartystreets/assertions v1.2.0 // indirect
+	github.com/smartystreets/goconvey v1.7.2 // indirect
+	golang.org/x/arch v0.11.0 // indirect
+	golang.org/x/sys v0.43.0 // indirect
+)
+
+replace github.com/go-spring/gs-mock => ../..
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package gsmockotel records the calls of gsmock mocks as OpenTelemetry
+// spans, so that integration-style tests can visualize the interactions
+// between the unit under test and its mocked dependencies.
+package gsmockotel
+
+import (
+	"context"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope of the tracer creating the spans.
+const ScopeName = "github.com/go-spring/gs-mock/gsmock"
+
+// Attribute keys of the spans of mocked calls.
+const (
+	FuncKey    = attribute.Key("gsmock.func")    // full name of the mocked function
+	MatchedKey = attribute.Key("gsmock.matched") // whether a mock handled the call
+)
+
+// Tracer is a gsmock.Tracer recording every mocked call as a span named
+// after the mocked function. The span is a child of the span of the first
+// context.Context parameter of the call, if any, and lasts as long as the
+// call.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer returns a Tracer creating spans with the tracers of tp.
+func NewTracer(tp trace.TracerProvider) *Tracer {
+	return &Tracer{tracer: tp.Tracer(ScopeName)}
+}
+
+// Attach traces the mocked calls of r with the tracers of tp, in place of
+// the Tracer attached to r before, if any.
+func Attach(r *gsmock.Manager, tp trace.TracerProvider) {
+	r.AttachTracer(NewTracer(tp))
+}
+
+// StartCall implements gsmock.Tracer.
+func (t *Tracer) StartCall(ctx context.Context, name string) func(matched bool) {
+	_, span := t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(FuncKey.String(name)),
+	)
+	return func(matched bool) {
+		span.SetAttributes(MatchedKey.Bool(matched))
+		span.End()
+	}
+}
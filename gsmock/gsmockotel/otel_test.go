@@ -0,0 +1,111 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmockotel_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+	"github.com/go-spring/gs-mock/gsmock/gsmockotel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// span records the name, the attributes and the end of a span.
+type span struct {
+	noop.Span
+	name   string
+	parent trace.SpanContext
+	attrs  []attribute.KeyValue
+	ended  bool
+}
+
+func (s *span) SetAttributes(kv ...attribute.KeyValue) { s.attrs = append(s.attrs, kv...) }
+
+func (s *span) End(...trace.SpanEndOption) { s.ended = true }
+
+// recorder is a TracerProvider recording the started spans.
+type recorder struct {
+	noop.TracerProvider
+	spans []*span
+}
+
+func (p *recorder) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return tracer{p: p}
+}
+
+type tracer struct {
+	noop.Tracer
+	p *recorder
+}
+
+func (t tracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	cfg := trace.NewSpanStartConfig(opts...)
+	s := &span{name: name, parent: trace.SpanContextFromContext(ctx), attrs: cfg.Attributes()}
+	t.p.spans = append(t.p.spans, s)
+	return trace.ContextWithSpan(ctx, s), s
+}
+
+type Service interface {
+	Get(ctx context.Context, id int) (string, error)
+}
+
+type ServiceMock struct {
+	r *gsmock.Manager
+}
+
+func (m *ServiceMock) Get(ctx context.Context, id int) (string, error) {
+	if ret, ok := gsmock.Invoke(m.r, m, m.Get, ctx, id); ok {
+		return gsmock.Unbox2[string, error](ret)
+	}
+	return "", nil
+}
+
+func (m *ServiceMock) MockGet() *gsmock.Mocker22[context.Context, int, string, error] {
+	return gsmock.Method22(m, m.Get, m.r)
+}
+
+func TestAttach(t *testing.T) {
+	p := &recorder{}
+	r := gsmock.NewManager()
+	gsmockotel.Attach(r, p)
+
+	m := &ServiceMock{r: r}
+	m.MockGet().WhenArgs(context.Background(), 1).ReturnValue("one", nil)
+
+	parent := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{1}})
+	ctx := trace.ContextWithSpanContext(context.Background(), parent)
+	_, _ = m.Get(context.Background(), 1)
+	_, _ = m.Get(ctx, 2)
+
+	gsmockassert.Equal(t, len(p.spans), 2)
+	const name = "github.com/go-spring/gs-mock/gsmock/gsmockotel_test.(*ServiceMock).Get"
+	for i, matched := range []bool{true, false} {
+		s := p.spans[i]
+		gsmockassert.Equal(t, s.name, name)
+		gsmockassert.Equal(t, s.ended, true)
+		gsmockassert.Equal(t, s.attrs, []attribute.KeyValue{
+			gsmockotel.FuncKey.String(name),
+			gsmockotel.MatchedKey.Bool(matched),
+		})
+	}
+	gsmockassert.Equal(t, p.spans[0].parent.IsValid(), false)
+	gsmockassert.Equal(t, p.spans[1].parent, parent)
+}
@@ -0,0 +1,29 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Command gsmockvet reports common misuses of gsmock in tests, see the
+// gsmockvet package. Run it with go vet:
+//
+//	go vet -vettool=$(which gsmockvet) ./...
+package main
+
+import (
+	"github.com/go-spring/gs-mock/gsmock/gsmockvet"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(gsmockvet.Analyzer)
+}
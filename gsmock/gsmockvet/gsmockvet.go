@@ -0,0 +1,451 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package gsmockvet provides an analyzer reporting common misuses of
+// gsmock in test code, which otherwise surface as runtime panics or as
+// mocks that silently never match. Run it with go vet:
+//
+//	go install github.com/go-spring/gs-mock/gsmock/gsmockvet/cmd/gsmockvet@latest
+//	go vet -vettool=$(which gsmockvet) ./...
+package gsmockvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// gsmockPath is the import path of the gsmock package.
+const gsmockPath = "github.com/go-spring/gs-mock/gsmock"
+
+// Analyzer reports the misuses of gsmock in the test files of a package.
+var Analyzer = &analysis.Analyzer{
+	Name: "gsmockvet",
+	Doc:  doc,
+	URL:  "https://pkg.go.dev/github.com/go-spring/gs-mock/gsmock/gsmockvet",
+	Run:  run,
+}
+
+const doc = `report common misuses of gsmock in tests
+
+The gsmockvet analyzer reports, in test files:
+  - method values, such as c.Get, given to FuncNN or InvokeContext instead
+    of method expressions, such as (*Client).Get: calls never go through them;
+  - nil functions given to the methods of mockers, such as Handle or When;
+  - mocks registered after the code under test is started in a goroutine
+    with a context bound to a Manager, which may miss the first calls;
+  - FuncNN mocks registered on a Manager that is never bound to a context
+    with WithManager, which the mocked functions can't find.
+
+With the -shared flag, it also reports the mutable variables, such as
+pointers, shared by the predicate of a mock and its results: the code
+under test mutating a returned value then changes what the mock matches,
+making tests fail depending on the order of their calls.`
+
+// shared enables checkSharedVars, see the -shared flag.
+var shared bool
+
+func init() {
+	Analyzer.Flags.BoolVar(&shared, "shared", false, "report the mutable variables shared by the predicate and the results of a mock")
+}
+
+var (
+	funcMockName   = regexp.MustCompile(`^(Var)?Func\d\d$`)   // e.g. Func22
+	mockerTypeName = regexp.MustCompile(`^(Var)?Mocker\d\d$`) // e.g. Mocker22
+)
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, f := range pass.Files {
+		if !strings.HasSuffix(pass.Fset.File(f.Pos()).Name(), "_test.go") {
+			continue
+		}
+		for _, decl := range f.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok && fd.Body != nil {
+				checkMethodValues(pass, fd.Body)
+				checkNilFuncs(pass, fd.Body)
+				checkLateMocks(pass, fd.Body)
+				checkUnboundManagers(pass, fd.Body)
+				if shared {
+					checkSharedVars(pass, fd.Body)
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// gsmockFunc returns the name of the function of package gsmock called
+// by call, or "" if call calls another function.
+func gsmockFunc(pass *analysis.Pass, call *ast.CallExpr) string {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != gsmockPath {
+		return ""
+	}
+	if sig, ok := fn.Type().(*types.Signature); ok && sig.Recv() != nil {
+		return ""
+	}
+	return fn.Name()
+}
+
+// isMocker reports whether t is a pointer to a mocker of package gsmock,
+// such as *gsmock.Mocker22[T1, T2, R1, R2].
+func isMocker(t types.Type) bool {
+	p, ok := t.(*types.Pointer)
+	if !ok {
+		return false
+	}
+	n, ok := p.Elem().(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := n.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == gsmockPath && mockerTypeName.MatchString(obj.Name())
+}
+
+// mockerMethod returns the selector of call if it calls a method of a
+// mocker, such as m.Handle, or nil.
+func mockerMethod(pass *analysis.Pass, call *ast.CallExpr) *ast.SelectorExpr {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	if s := pass.TypesInfo.Selections[sel]; s != nil && s.Kind() == types.MethodVal && isMocker(s.Recv()) {
+		return sel
+	}
+	return nil
+}
+
+// isRegistration reports whether call registers a mock: it returns a
+// mocker, like gsmock.Func22 or the MockGet methods of generated mocks,
+// without being a method of a mocker refining it, like When.
+func isRegistration(pass *analysis.Pass, call *ast.CallExpr) bool {
+	tv, ok := pass.TypesInfo.Types[call]
+	return ok && isMocker(tv.Type) && mockerMethod(pass, call) == nil
+}
+
+// checkMethodValues reports the method values given to the functions of
+// gsmock identifying the mocked function by its value: these create new
+// closures, which the calls of the method never go through.
+func checkMethodValues(pass *analysis.Pass, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		arg := -1
+		switch name := gsmockFunc(pass, call); {
+		case funcMockName.MatchString(name):
+			arg = 0
+		case name == "InvokeContext":
+			arg = 1
+		}
+		if arg < 0 || arg >= len(call.Args) {
+			return true
+		}
+		sel, ok := ast.Unparen(call.Args[arg]).(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		s := pass.TypesInfo.Selections[sel]
+		if s == nil || s.Kind() != types.MethodVal {
+			return true
+		}
+		recv := s.Obj().Type().(*types.Signature).Recv().Type()
+		expr := types.TypeString(recv, types.RelativeTo(pass.Pkg))
+		if _, ok := recv.(*types.Pointer); ok {
+			expr = "(" + expr + ")"
+		}
+		pass.Reportf(sel.Pos(), "gsmock.%s is given the method value %s, which calls never go through; pass the method expression %s.%s",
+			gsmockFunc(pass, call), types.ExprString(sel), expr, sel.Sel.Name)
+		return true
+	})
+}
+
+// checkNilFuncs reports the nil functions given to the methods of mockers,
+// which either never match or panic when the mock is matched.
+func checkNilFuncs(pass *analysis.Pass, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel := mockerMethod(pass, call)
+		if sel == nil {
+			return true
+		}
+		sig, ok := pass.TypesInfo.Types[sel].Type.(*types.Signature)
+		if !ok {
+			return true
+		}
+		for i, arg := range call.Args {
+			if i >= sig.Params().Len() || !pass.TypesInfo.Types[arg].IsNil() {
+				continue
+			}
+			if _, ok := sig.Params().At(i).Type().Underlying().(*types.Signature); ok {
+				pass.Reportf(arg.Pos(), "nil function given to %s; pass a function", sel.Sel.Name)
+			}
+		}
+		return true
+	})
+}
+
+// boundContexts returns the variables of body assigned a context bound to
+// a Manager by gsmock.WithManager.
+func boundContexts(pass *analysis.Pass, body *ast.BlockStmt) map[types.Object]bool {
+	ret := make(map[types.Object]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		as, ok := n.(*ast.AssignStmt)
+		if !ok || len(as.Lhs) != len(as.Rhs) {
+			return true
+		}
+		for i, rhs := range as.Rhs {
+			call, ok := ast.Unparen(rhs).(*ast.CallExpr)
+			if !ok || gsmockFunc(pass, call) != "WithManager" {
+				continue
+			}
+			if id, ok := as.Lhs[i].(*ast.Ident); ok {
+				if obj := pass.TypesInfo.ObjectOf(id); obj != nil {
+					ret[obj] = true
+				}
+			}
+		}
+		return true
+	})
+	return ret
+}
+
+// uses reports whether n refers to one of the variables vars.
+func uses(pass *analysis.Pass, n ast.Node, vars map[types.Object]bool) bool {
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && vars[pass.TypesInfo.Uses[id]] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// checkLateMocks reports the mocks registered after a statement of the
+// same block started the code under test in a goroutine with a context
+// bound to a Manager: the calls made before the registration miss it.
+func checkLateMocks(pass *analysis.Pass, body *ast.BlockStmt) {
+	contexts := boundContexts(pass, body)
+	if len(contexts) == 0 {
+		return
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		block, ok := n.(*ast.BlockStmt)
+		if !ok {
+			return true
+		}
+		var started token.Pos
+		for _, stmt := range block.List {
+			if !started.IsValid() {
+				if g, ok := stmt.(*ast.GoStmt); ok && uses(pass, g.Call, contexts) {
+					started = g.Pos()
+				}
+				continue
+			}
+			ast.Inspect(stmt, func(n ast.Node) bool {
+				if call, ok := n.(*ast.CallExpr); ok && isRegistration(pass, call) {
+					pass.Reportf(call.Pos(), "mock registered after the code under test is started on line %d; register it before",
+						pass.Fset.Position(started).Line)
+					return false
+				}
+				return true
+			})
+		}
+		return true
+	})
+}
+
+// checkUnboundManagers reports the FuncNN mocks registered on a Manager
+// declared in body, which the mocked functions look up in their context,
+// when the Manager is never given to a call returning a context, such as
+// gsmock.WithManager.
+func checkUnboundManagers(pass *analysis.Pass, body *ast.BlockStmt) {
+	bound := make(map[types.Object]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || !returnsContext(pass, call) {
+			return true
+		}
+		for _, arg := range call.Args {
+			if id, ok := ast.Unparen(arg).(*ast.Ident); ok {
+				bound[pass.TypesInfo.Uses[id]] = true
+			}
+		}
+		return true
+	})
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return true
+		}
+		name := gsmockFunc(pass, call)
+		if !funcMockName.MatchString(name) {
+			return true
+		}
+		id, ok := ast.Unparen(call.Args[1]).(*ast.Ident)
+		if !ok {
+			return true
+		}
+		obj := pass.TypesInfo.Uses[id]
+		if obj == nil || bound[obj] || obj.Pos() < body.Pos() || obj.Pos() >= body.End() {
+			return true // bound, or maybe bound by the caller
+		}
+		pass.Reportf(call.Pos(), "gsmock.%s registers a mock on %s, which is never bound to a context with gsmock.WithManager; the mocked function can't find it",
+			name, id.Name)
+		return true
+	})
+}
+
+// returnsContext reports whether call returns a context.Context.
+func returnsContext(pass *analysis.Pass, call *ast.CallExpr) bool {
+	tv, ok := pass.TypesInfo.Types[call]
+	if !ok {
+		return false
+	}
+	n, ok := tv.Type.(*types.Named)
+	return ok && n.Obj().Pkg() != nil && n.Obj().Pkg().Path() == "context" && n.Obj().Name() == "Context"
+}
+
+// resultMethods are the methods of mockers setting the results of a mock.
+var resultMethods = map[string]bool{
+	"Handle":      true,
+	"Return":      true,
+	"ReturnFrom":  true,
+	"ReturnLazy":  true,
+	"ReturnValue": true,
+}
+
+// checkSharedVars reports the mutable variables referenced both by the
+// predicates of a chain of mocker methods, like When or WhenArgs, and by
+// the method setting its results, like Return or ReturnValue.
+func checkSharedVars(pass *analysis.Pass, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel := mockerMethod(pass, call)
+		if sel == nil || !resultMethods[sel.Sel.Name] {
+			return true
+		}
+		results := make(map[types.Object]bool)
+		for _, arg := range call.Args {
+			mutableVars(pass, arg, results)
+		}
+		for x := sel.X; ; {
+			pred, ok := ast.Unparen(x).(*ast.CallExpr)
+			if !ok {
+				break
+			}
+			predSel := mockerMethod(pass, pred)
+			if predSel == nil {
+				break
+			}
+			if name := predSel.Sel.Name; strings.HasPrefix(name, "When") || name == "Except" {
+				for _, arg := range pred.Args {
+					if v := sharedVar(pass, arg, results); v != nil {
+						pass.Reportf(sel.Sel.Pos(), "%s and %s share the mutable variable %s: the code under test mutating the returned value changes what the mock matches; give each its own copy",
+							name, sel.Sel.Name, v.Name())
+						return true
+					}
+				}
+			}
+			x = predSel.X
+		}
+		return true
+	})
+}
+
+// mutableVars adds to vars the variables that the value of expr may share
+// with the code under test: the variables of pointer, map and slice types
+// it refers to, except through a dereference, and those whose address it
+// takes. The variables declared inside expr are ignored.
+func mutableVars(pass *analysis.Pass, expr ast.Expr, vars map[types.Object]bool) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.StarExpr:
+			if _, ok := ast.Unparen(x.X).(*ast.Ident); ok {
+				return false // copies the value pointed to
+			}
+		case *ast.UnaryExpr:
+			if id, ok := ast.Unparen(x.X).(*ast.Ident); ok && x.Op == token.AND {
+				if v := outerVar(pass, id, expr); v != nil {
+					vars[v] = true
+				}
+				return false
+			}
+		case *ast.Ident:
+			if v := outerVar(pass, x, expr); v != nil && isMutable(v.Type()) {
+				vars[v] = true
+			}
+		}
+		return true
+	})
+}
+
+// sharedVar returns a variable among vars that expr refers to, or nil.
+// Function literals may refer to them with any type, as they capture
+// the variables themselves.
+func sharedVar(pass *analysis.Pass, expr ast.Expr, vars map[types.Object]bool) *types.Var {
+	if _, ok := ast.Unparen(expr).(*ast.FuncLit); !ok {
+		own := make(map[types.Object]bool)
+		mutableVars(pass, expr, own)
+		for v := range own {
+			if vars[v] {
+				return v.(*types.Var)
+			}
+		}
+		return nil
+	}
+	var ret *types.Var
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			if v := outerVar(pass, id, expr); v != nil && vars[v] {
+				ret = v
+			}
+		}
+		return ret == nil
+	})
+	return ret
+}
+
+// outerVar returns the variable id refers to if it is declared outside
+// of scope, or nil.
+func outerVar(pass *analysis.Pass, id *ast.Ident, scope ast.Node) *types.Var {
+	v, ok := pass.TypesInfo.Uses[id].(*types.Var)
+	if !ok || v.IsField() || v.Pos() >= scope.Pos() && v.Pos() < scope.End() {
+		return nil
+	}
+	return v
+}
+
+// isMutable reports whether the values of type t refer to mutable data.
+func isMutable(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Pointer, *types.Map, *types.Slice:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package gsmockvet_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock/gsmockvet"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), gsmockvet.Analyzer, "a")
+}
+
+func TestAnalyzerShared(t *testing.T) {
+	_ = gsmockvet.Analyzer.Flags.Set("shared", "true")
+	defer func() { _ = gsmockvet.Analyzer.Flags.Set("shared", "false") }()
+	analysistest.Run(t, analysistest.TestData(), gsmockvet.Analyzer, "shared")
+}
@@ -0,0 +1,15 @@
+package a
+
+import "context"
+
+type Client struct{}
+
+func (c *Client) Get(ctx context.Context, key string) (string, error) { return key, nil }
+
+func Fetch(ctx context.Context, url string) (string, error) { return url, nil }
+
+func Run(ctx context.Context) {}
+
+type StoreMockImpl struct{}
+
+func (impl *StoreMockImpl) Get(ctx context.Context, key string) (string, error) { return key, nil }
@@ -0,0 +1,56 @@
+package a
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+)
+
+func newContext(r *gsmock.Manager) context.Context {
+	return gsmock.WithManager(context.Background(), r)
+}
+
+func TestMethodValue(t *testing.T) {
+	r := gsmock.NewManager()
+	ctx := gsmock.WithManager(context.Background(), r)
+	c := &Client{}
+	gsmock.Func32((*Client).Get, r).ReturnValue("v", nil)
+	gsmock.Func22(c.Get, r).ReturnValue("v", nil) // want `gsmock.Func22 is given the method value c.Get, which calls never go through; pass the method expression \(\*Client\).Get`
+	gsmock.InvokeContext(ctx, c.Get, ctx, "k")    // want `gsmock.InvokeContext is given the method value c.Get`
+	gsmock.InvokeContext(ctx, (*Client).Get, c, ctx, "k")
+}
+
+func TestNilFunc(t *testing.T) {
+	r := gsmock.NewManager()
+	_ = gsmock.WithManager(context.Background(), r)
+	m := gsmock.Func22(Fetch, r)
+	m.When(nil).Handle(nil) // want `nil function given to When; pass a function` `nil function given to Handle; pass a function`
+	m.ReturnValue("", nil)
+}
+
+func TestLateMock(t *testing.T) {
+	r := gsmock.NewManager()
+	ctx := gsmock.WithManager(context.Background(), r)
+	gsmock.Func22(Fetch, r).ReturnValue("a", nil)
+	go Run(ctx)
+	gsmock.Func22(Fetch, r).ReturnValue("b", nil)                          // want `mock registered after the code under test is started on line 36; register it before`
+	gsmock.Method22(&StoreMockImpl{}, (&StoreMockImpl{}).Get, r).When(nil) // want `mock registered after` `nil function given to When`
+}
+
+func TestUnboundManager(t *testing.T) {
+	r := gsmock.NewManager()
+	gsmock.Func22(Fetch, r).ReturnValue("a", nil) // want `gsmock.Func22 registers a mock on r, which is never bound to a context with gsmock.WithManager; the mocked function can't find it`
+	_, _ = Fetch(context.Background(), "url")
+}
+
+func TestBoundByHelper(t *testing.T) {
+	r := gsmock.NewManager()
+	ctx := newContext(r)
+	gsmock.Func22(Fetch, r).ReturnValue("a", nil)
+	_, _ = Fetch(ctx, "url")
+}
+
+func registerFetch(r *gsmock.Manager) {
+	gsmock.Func22(Fetch, r).ReturnValue("a", nil)
+}
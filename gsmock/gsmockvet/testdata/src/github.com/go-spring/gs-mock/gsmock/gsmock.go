@@ -0,0 +1,41 @@
+// Package gsmock is a stub of the gsmock package declaring what the
+// analyzer recognizes.
+package gsmock
+
+import "context"
+
+type Manager struct{}
+
+func NewManager() *Manager { return &Manager{} }
+
+func WithManager(ctx context.Context, r *Manager) context.Context { return ctx }
+
+func InvokeContext(ctx context.Context, fn any, params ...any) ([]any, bool) { return nil, false }
+
+type Mocker22[T1, T2, R1, R2 any] struct{}
+
+func (m *Mocker22[T1, T2, R1, R2]) Handle(fn func(T1, T2) (R1, R2)) {}
+
+func (m *Mocker22[T1, T2, R1, R2]) When(fn func(T1, T2) bool) *Mocker22[T1, T2, R1, R2] { return m }
+
+func (m *Mocker22[T1, T2, R1, R2]) WhenArgs(t1 T1, t2 T2) *Mocker22[T1, T2, R1, R2] { return m }
+
+func (m *Mocker22[T1, T2, R1, R2]) Return(fn func() (R1, R2)) {}
+
+func (m *Mocker22[T1, T2, R1, R2]) ReturnValue(r1 R1, r2 R2) {}
+
+func Func22[T1, T2, R1, R2 any](f func(T1, T2) (R1, R2), r *Manager) *Mocker22[T1, T2, R1, R2] {
+	return &Mocker22[T1, T2, R1, R2]{}
+}
+
+type Mocker32[T1, T2, T3, R1, R2 any] struct{}
+
+func (m *Mocker32[T1, T2, T3, R1, R2]) ReturnValue(r1 R1, r2 R2) {}
+
+func Func32[T1, T2, T3, R1, R2 any](f func(T1, T2, T3) (R1, R2), r *Manager) *Mocker32[T1, T2, T3, R1, R2] {
+	return &Mocker32[T1, T2, T3, R1, R2]{}
+}
+
+func Method22[T1, T2, R1, R2 any](receiver any, f func(T1, T2) (R1, R2), r *Manager) *Mocker22[T1, T2, R1, R2] {
+	return &Mocker22[T1, T2, R1, R2]{}
+}
@@ -0,0 +1,12 @@
+package shared
+
+import "context"
+
+type Item struct {
+	ID   int
+	Tags []string
+}
+
+type StoreMockImpl struct{}
+
+func (impl *StoreMockImpl) Get(ctx context.Context, item *Item) (*Item, error) { return item, nil }
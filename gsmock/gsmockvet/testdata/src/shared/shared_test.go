@@ -0,0 +1,33 @@
+package shared
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+)
+
+func TestShared(t *testing.T) {
+	r := gsmock.NewManager()
+	s := &StoreMockImpl{}
+	item := &Item{ID: 1}
+	gsmock.Method22(s, s.Get, r).
+		When(func(ctx context.Context, req *Item) bool { return req.ID == item.ID }).
+		ReturnValue(item, nil) // want `When and ReturnValue share the mutable variable item: the code under test mutating the returned value changes what the mock matches; give each its own copy`
+	gsmock.Method22(s, s.Get, r).
+		WhenArgs(context.Background(), item).
+		Return(func() (*Item, error) { return item, nil }) // want `WhenArgs and Return share the mutable variable item`
+
+	want := Item{ID: 2}
+	gsmock.Method22(s, s.Get, r).
+		When(func(ctx context.Context, req *Item) bool { return req.ID == want.ID }).
+		Handle(func(ctx context.Context, req *Item) (*Item, error) { return &want, nil }) // want `When and Handle share the mutable variable want`
+
+	// Copies are not shared
+	gsmock.Method22(s, s.Get, r).
+		When(func(ctx context.Context, req *Item) bool { return req.ID == item.ID }).
+		Return(func() (*Item, error) { copied := *item; return &copied, nil })
+	gsmock.Method22(s, s.Get, r).
+		When(func(ctx context.Context, req *Item) bool { return req.ID == want.ID }).
+		ReturnValue(&Item{ID: want.ID}, nil)
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"errors"
+)
+
+// ErrNotTesting is the error NewManager panics with outside of tests,
+// when built with the gsmock_guard build tag.
+var ErrNotTesting = errors.New("gsmock: Manager created outside of tests")
+
+// isTesting reports whether the binary runs tests. It is nil unless
+// the gsmock_guard build tag is set, see guard_on.go.
+var isTesting func() bool
+
+// checkTesting panics with ErrNotTesting if the guard is enabled and the
+// binary isn't a test binary. Without a Manager, InvokeContext never
+// finds mocks, so production binaries accidentally calling it behave
+// as if they were never mocked.
+func checkTesting() {
+	if isTesting != nil && !isTesting() {
+		panic(ErrNotTesting)
+	}
+}
@@ -0,0 +1,30 @@
+//go:build gsmock_guard
+
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"testing"
+)
+
+func TestGuardOn(t *testing.T) {
+	if isTesting == nil || !isTesting() {
+		t.Fatal("the gsmock_guard build tag doesn't enable the guard")
+	}
+	NewManager()
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"testing"
+)
+
+func TestCheckTesting(t *testing.T) {
+	old := isTesting
+	defer func() { isTesting = old }()
+
+	// run returns the value checkTesting panicked with, or nil
+	run := func() (r any) {
+		defer func() { r = recover() }()
+		checkTesting()
+		return nil
+	}
+
+	isTesting = nil
+	if r := run(); r != nil {
+		t.Fatalf("disabled guard panicked with %v", r)
+	}
+	isTesting = func() bool { return true }
+	if r := run(); r != nil {
+		t.Fatalf("guard panicked in a test binary with %v", r)
+	}
+	isTesting = func() bool { return false }
+	if r := run(); r != ErrNotTesting {
+		t.Fatalf("guard outside of tests panicked with %v, want ErrNotTesting", r)
+	}
+}
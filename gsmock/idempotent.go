@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"context"
+	"maps"
+	"slices"
+)
+
+// AssertIdempotentCalls fails the test if a mocked function was called
+// more than once with different parameters. It verifies the contract of
+// code that retries, such as a payment client resending a request after
+// a timeout, that every attempt carries the same request, including its
+// idempotency key:
+//
+//	r.EnableRecording(gsmock.RetentionPolicy{})
+//	pay.MockCharge().Handle(func(ctx context.Context, req *ChargeRequest) error {
+//		return context.DeadlineExceeded
+//	})
+//	client.Charge(ctx, order) // retries three times
+//	r.AssertIdempotentCalls(t)
+//
+// Each retained call is compared with the previous call of the same
+// function, parameter by parameter, using the comparers registered with
+// RegisterComparer and the options set with SetEqualOptions. Parameters
+// of type context.Context are skipped, as retries commonly derive a new
+// deadline for every attempt.
+func (r *Manager) AssertIdempotentCalls(t TB) {
+	t.Helper()
+	if r.retention == nil || r.retention.CountOnly {
+		t.Errorf("gsmock: parameters are only recorded once EnableRecording is called without CountOnly")
+		return
+	}
+
+	r.recordMux.Lock()
+	keys := slices.Collect(maps.Keys(r.records))
+	calls := make(map[funcKey][]Call, len(keys))
+	for k, c := range r.records {
+		calls[k] = c.retained()
+	}
+	r.recordMux.Unlock()
+	r.sortKeys(keys)
+
+	for _, k := range keys {
+		cs := calls[k]
+		for i := 1; i < len(cs); i++ {
+			if j, ok := paramsDiffer(cs[i-1].Params, cs[i].Params); ok {
+				t.Errorf("gsmock: %s is not called idempotently: call %d has parameter %d %s, call %d has %s",
+					funcName(k), i, j+1, formatValue(cs[i-1].Params[j]), i+1, formatValue(cs[i].Params[j]))
+				break
+			}
+		}
+	}
+}
+
+// paramsDiffer returns the index of the first parameter a and b differ
+// in, ignoring contexts, and reports whether they differ.
+func paramsDiffer(a, b []any) (int, bool) {
+	for i := range a {
+		if _, ok := a[i].(context.Context); ok {
+			continue
+		}
+		if !isEqual(a[i], b[i]) {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+type ChargeRequest struct {
+	Key     string
+	Amount  int
+	Attempt int // ignored by the registered comparer
+}
+
+func Charge(ctx context.Context, req *ChargeRequest) error {
+	return nil
+}
+
+func TestAssertIdempotentCalls(t *testing.T) {
+	gsmock.RegisterComparer(func(a, b *ChargeRequest) bool {
+		return a.Key == b.Key && a.Amount == b.Amount
+	})
+
+	r := gsmock.NewManager()
+	charge := func(req *ChargeRequest) {
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+		defer cancel()
+		_, _ = gsmock.Invoke(r, nil, Charge, ctx, req)
+	}
+	gsmock.Method21(nil, Charge, r).ReturnValue(context.DeadlineExceeded)
+
+	ft := &fakeT{}
+	r.AssertIdempotentCalls(ft)
+	gsmockassert.Equal(t, ft.errors, []string{"gsmock: parameters are only recorded once EnableRecording is called without CountOnly"})
+
+	// retries with the same request, in a new context
+	r.EnableRecording(gsmock.RetentionPolicy{})
+	for i := range 3 {
+		charge(&ChargeRequest{Key: "k1", Amount: 100, Attempt: i})
+	}
+	ft = &fakeT{}
+	r.AssertIdempotentCalls(ft)
+	gsmockassert.Equal(t, len(ft.errors), 0)
+
+	// a retry with a new idempotency key
+	charge(&ChargeRequest{Key: "k2", Amount: 100, Attempt: 3})
+	charge(&ChargeRequest{Key: "k3", Amount: 100, Attempt: 4})
+	r.AssertIdempotentCalls(ft)
+	gsmockassert.Equal(t, len(ft.errors), 1)
+	gsmockassert.Match(t, ft.errors[0], `^gsmock: .*\.Charge is not called idempotently: call 3 has parameter 2 &\{Key:k1 Amount:100 Attempt:2\}, call 4 has &\{Key:k2 Amount:100 Attempt:3\}$`)
+
+	r.Reset()
+	r.AssertIdempotentCalls(ft)
+	gsmockassert.Equal(t, len(ft.errors), 1)
+}
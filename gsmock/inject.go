@@ -0,0 +1,81 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+var (
+	constructorMux sync.RWMutex
+	constructors   = make(map[reflect.Type]func(r *Manager) reflect.Value)
+)
+
+// RegisterMock registers the constructor of the mock of interface I,
+// used by InjectMocks. Generated code registers the constructors of all
+// non-generic mocks at init time; registering I again replaces the
+// previous constructor.
+func RegisterMock[I any](fn func(r *Manager) I) {
+	t := reflect.TypeFor[I]()
+	if t.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("gsmock: RegisterMock requires an interface type, got %s", t))
+	}
+	constructorMux.Lock()
+	defer constructorMux.Unlock()
+	constructors[t] = func(r *Manager) reflect.Value {
+		return reflect.ValueOf(fn(r))
+	}
+}
+
+// InjectMocks assigns a new mock bound to r to every nil interface-typed
+// field of the struct target points to, exported or not, whose interface
+// has a mock registered via RegisterMock. Other fields are left untouched.
+//
+// It replaces the wiring of a constructor-injected service in a test:
+//
+//	s := &Server{}
+//	gsmock.InjectMocks(s, r)
+//	s.repo.(*RepositoryMockImpl).MockGet().ReturnValue(item, nil)
+//
+// It panics if target is not a pointer to a struct.
+func InjectMocks(target any, r *Manager) {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("gsmock: InjectMocks requires a non-nil pointer to a struct, got %T", target))
+	}
+	v = v.Elem()
+
+	constructorMux.RLock()
+	defer constructorMux.RUnlock()
+	for i := range v.NumField() {
+		f := v.Field(i)
+		if f.Kind() != reflect.Interface || !f.IsNil() {
+			continue
+		}
+		fn, ok := constructors[f.Type()]
+		if !ok {
+			continue
+		}
+		if !f.CanSet() { // unexported field
+			f = reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+		}
+		f.Set(fn(r))
+	}
+}
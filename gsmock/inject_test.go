@@ -0,0 +1,64 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func init() {
+	gsmock.RegisterMock(func(r *gsmock.Manager) ClientInterface { return NewMockClient(r) })
+}
+
+type injectTarget struct {
+	Client  ClientInterface
+	client  ClientInterface
+	preset  ClientInterface
+	writer  io.Writer // no registered mock
+	counter int
+}
+
+func TestInjectMocks(t *testing.T) {
+	r := gsmock.NewManager()
+	preset := NewMockClient(gsmock.NewManager())
+
+	s := &injectTarget{preset: preset}
+	gsmock.InjectMocks(s, r)
+
+	gsmockassert.Equal(t, s.Client != nil, true)
+	gsmockassert.Equal(t, s.client != nil, true)
+	gsmockassert.Equal(t, s.client != s.Client, true)
+	gsmockassert.Equal(t, s.preset, ClientInterface(preset))
+	gsmockassert.Nil(t, s.writer)
+
+	// The injected mocks are bound to r
+	s.client.(*MockClient).MockQuery().ReturnValue(&Response{Message: "ok"}, nil)
+	resp, err := s.client.Query(&Request{})
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp.Message, "ok")
+
+	gsmockassert.Panic(t, func() {
+		gsmock.InjectMocks(*s, r)
+	}, "InjectMocks requires a non-nil pointer to a struct, got gsmock_test.injectTarget")
+	gsmockassert.Panic(t, func() {
+		gsmock.RegisterMock(func(r *gsmock.Manager) *MockClient { return NewMockClient(r) })
+	}, `RegisterMock requires an interface type, got \*gsmock_test.MockClient`)
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTooManyMockers is the error the registration of a mock panics with
+// when the function already has the maximum number set by SetMaxMockers.
+var ErrTooManyMockers = errors.New("gsmock: too many mocks registered")
+
+// SetMaxMockers limits the number of mocks registered for each function
+// or method to n, or removes the limit if n is zero. Registering one more
+// panics with ErrTooManyMockers. The calls of a function evaluate its
+// mocks in turn, so test code registering mocks in loops silently slows
+// dispatch down: the error suggests WhenKey and ReturnForKey instead,
+// which register table-style data at once.
+func (r *Manager) SetMaxMockers(n int) {
+	if n < 0 {
+		panic(fmt.Sprintf("gsmock: negative maximum of mocks %d", n))
+	}
+	r.maxMockers = n
+}
+
+// checkMaxMockers panics with ErrTooManyMockers if k already has the
+// maximum number of mocks set by SetMaxMockers.
+func (r *Manager) checkMaxMockers(k funcKey) {
+	if r.maxMockers == 0 || len(r.mockers[k]) < r.maxMockers {
+		return
+	}
+	panic(fmt.Errorf("%w: %s already has %d mocks, the maximum set by SetMaxMockers; "+
+		"rather than registering mocks in a loop, dispatch table-style data by key with WhenKey and ReturnForKey",
+		ErrTooManyMockers, funcName(k), r.maxMockers))
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestSetMaxMockers(t *testing.T) {
+	r := gsmock.NewManager()
+	r.SetMaxMockers(3)
+	c := NewMockClient(r)
+
+	for i := range 3 {
+		c.MockQuery().WhenArgs(&Request{Value: i}).ReturnValue(&Response{}, nil)
+	}
+	// The limit is per function and receiver
+	NewMockClient(r).MockQuery().ReturnDefault()
+
+	defer func() {
+		err, ok := recover().(error)
+		gsmockassert.Equal(t, ok, true)
+		gsmockassert.Equal(t, errors.Is(err, gsmock.ErrTooManyMockers), true)
+		gsmockassert.Match(t, err.Error(), `gsmock: too many mocks registered: .*\(\*MockClient\)\.Query already has 3 mocks, the maximum set by SetMaxMockers; `+
+			`rather than registering mocks in a loop, dispatch table-style data by key with WhenKey and ReturnForKey`)
+
+		// Reset removes the mocks, but not the limit
+		r.Reset()
+		for i := range 3 {
+			c.MockQuery().WhenArgs(&Request{Value: i}).ReturnValue(&Response{}, nil)
+		}
+		gsmockassert.Panic(t, func() {
+			c.MockQuery().ReturnDefault()
+		}, "too many mocks registered")
+
+		r.SetMaxMockers(0)
+		c.MockQuery().ReturnDefault()
+		gsmockassert.Panic(t, func() {
+			r.SetMaxMockers(-1)
+		}, "gsmock: negative maximum of mocks -1")
+	}()
+	c.MockQuery().ReturnDefault()
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+)
+
+// Slice returns a new slice holding the given elements. Unlike a nil
+// slice, the result is never nil, so Slice[T]() stubs an empty list.
+//
+//	repo.MockList().ReturnValue(gsmock.Slice(item1, item2), nil)
+func Slice[T any](elems ...T) []T {
+	return append(make([]T, 0, len(elems)), elems...)
+}
+
+// MapOf returns a new map holding the given key-value pairs. The key and
+// value types are those of the first pair; the remaining arguments must
+// alternate between keys and values of the same types.
+//
+//	repo.MockCounts().ReturnValue(gsmock.MapOf("a", 1, "b", 2), nil)
+func MapOf[K comparable, V any](k K, v V, kvs ...any) map[K]V {
+	if len(kvs)%2 != 0 {
+		panic(fmt.Sprintf("gsmock: MapOf requires key-value pairs, got %d extra arguments", len(kvs)))
+	}
+	m := make(map[K]V, 1+len(kvs)/2)
+	m[k] = v
+	for i := 0; i < len(kvs); i += 2 {
+		key, ok := kvs[i].(K)
+		if !ok {
+			panic(fmt.Sprintf("gsmock: MapOf key of pair %d is %T, not %T", i/2+2, kvs[i], k))
+		}
+		val, ok := kvs[i+1].(V)
+		if !ok && kvs[i+1] != nil {
+			panic(fmt.Sprintf("gsmock: MapOf value of pair %d is %T, not %T", i/2+2, kvs[i+1], v))
+		}
+		m[key] = val
+	}
+	return m
+}
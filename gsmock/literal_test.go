@@ -0,0 +1,57 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestSlice(t *testing.T) {
+	s := gsmock.Slice(1, 2, 3)
+	gsmockassert.Equal(t, s, []int{1, 2, 3})
+
+	e := gsmock.Slice[string]()
+	gsmockassert.Equal(t, len(e), 0)
+	gsmockassert.Equal(t, e == nil, false)
+
+	in := []int{1, 2}
+	s = gsmock.Slice(in...)
+	in[0] = 9
+	gsmockassert.Equal(t, s, []int{1, 2})
+}
+
+func TestMapOf(t *testing.T) {
+	m := gsmock.MapOf("a", 1, "b", 2, "c", 3)
+	gsmockassert.Equal(t, m, map[string]int{"a": 1, "b": 2, "c": 3})
+
+	p := gsmock.MapOf[string, *Request]("a", nil, "b", &Request{Value: 2}, "c", nil)
+	gsmockassert.Equal(t, len(p), 3)
+	gsmockassert.Nil(t, p["c"])
+
+	gsmockassert.Panic(t, func() {
+		gsmock.MapOf("a", 1, "b")
+	}, "MapOf requires key-value pairs, got 1 extra arguments")
+	gsmockassert.Panic(t, func() {
+		gsmock.MapOf("a", 1, 2, 2)
+	}, "MapOf key of pair 2 is int, not string")
+	gsmockassert.Panic(t, func() {
+		gsmock.MapOf("a", 1, "b", "2")
+	}, "MapOf value of pair 2 is string, not int")
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+)
+
+// Logger is the subset of testing.TB used to log dispatch results.
+type Logger interface {
+	Helper()
+	Log(args ...any)
+}
+
+// AttachLogger logs the outcome of every mocked call through l, e.g. a
+// *testing.T, whose logs are only shown when the test fails or with -v.
+// It replaces the logger attached before, if any.
+func (r *Manager) AttachLogger(l Logger) {
+	r.logger = l
+}
+
+// logDispatch logs whether a call of k with params was handled by a mock.
+func (r *Manager) logDispatch(k funcKey, params []any, ret []any, ok bool) {
+	r.logger.Helper()
+	call := funcName(k) + formatParams(params)
+	if ok {
+		r.logger.Log(fmt.Sprintf("gsmock: %s matched, returns %s", call, formatParams(ret)))
+		return
+	}
+	r.logger.Log(fmt.Sprintf("gsmock: %s missed, %d mocks registered", call, len(r.mockers[k])))
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// fakeLogger is a gsmock.Logger that records the logged lines.
+type fakeLogger struct {
+	lines []string
+}
+
+func (l *fakeLogger) Helper() {}
+
+func (l *fakeLogger) Log(args ...any) {
+	l.lines = append(l.lines, fmt.Sprint(args...))
+}
+
+func TestAttachLogger(t *testing.T) {
+	l := &fakeLogger{}
+	r := gsmock.NewManager()
+	r.AttachLogger(l)
+
+	c := NewMockClient(r)
+	c.MockQuery().WhenArgs(&Request{Value: 1}).ReturnValue(&Response{Message: "ok"}, nil)
+
+	_, _ = c.Query(&Request{Value: 1})
+	gsmockassert.Panic(t, func() {
+		_, _ = c.Query(&Request{Value: 2})
+	}, "no mock code matched")
+
+	gsmockassert.Equal(t, len(l.lines), 2)
+	gsmockassert.Panic(t, func() { panic(l.lines[0]) },
+		`^gsmock: .*\(\*MockClient\)\.Query\(&\{Value:1\}\) matched, returns \(&\{Message:ok\}, <nil>\)$`)
+	gsmockassert.Panic(t, func() { panic(l.lines[1]) },
+		`^gsmock: .*\(\*MockClient\)\.Query\(&\{Value:2\}\) missed, 1 mocks registered$`)
+}
@@ -0,0 +1,308 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"bytes"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	comparerMux  sync.RWMutex
+	comparers    = make(map[reflect.Type]func(a, b any) bool)
+	hasComparers atomic.Bool // skips the lookups while no comparer is registered
+)
+
+// EqualOptions configures the equality used by WhenArgs and Eq.
+type EqualOptions struct {
+	// NilEqualsEmpty makes nil slices and maps equal to empty ones, at
+	// any depth, e.g. a nil []byte to []byte{} or a map[string][]string
+	// with a nil value to one with an empty value. By default, like with
+	// reflect.DeepEqual, they differ.
+	NilEqualsEmpty bool
+}
+
+var nilEqualsEmpty atomic.Bool
+
+// SetEqualOptions sets the options of the equality used by WhenArgs and
+// Eq, for all Managers, so tests setting them can't run in parallel, and
+// returns the previous ones, to be restored when the test ends:
+//
+//	old := gsmock.SetEqualOptions(gsmock.EqualOptions{NilEqualsEmpty: true})
+//	t.Cleanup(func() { gsmock.SetEqualOptions(old) })
+func SetEqualOptions(o EqualOptions) EqualOptions {
+	return EqualOptions{NilEqualsEmpty: nilEqualsEmpty.Swap(o.NilEqualsEmpty)}
+}
+
+// RegisterComparer registers a custom equality function for type T, and
+// returns a function restoring the previous one, if any, to be called
+// when the test ends:
+//
+//	t.Cleanup(gsmock.RegisterComparer(func(a, b time.Time) bool { return a.Equal(b) }))
+//
+// The function is used by WhenArgs and Eq whenever two values of type T
+// are compared, instead of reflect.DeepEqual, be they arguments or values
+// nested in them, such as struct fields and slice elements, except for
+// unexported struct fields. It allows type-specific comparison logic (e.g.
+// proto.Equal for protobuf messages, or time.Time with a tolerance) to be
+// defined once rather than in every predicate.
+//
+// T may be an interface type; in that case the comparer applies to
+// parameters and fields declared with that interface type.
+// Registering a comparer for the same type again replaces the previous one.
+// Like SetEqualOptions, it applies to all Managers, so tests registering
+// comparers for the same types can't run in parallel.
+func RegisterComparer[T any](fn func(a, b T) bool) (restore func()) {
+	t := reflect.TypeFor[T]()
+	comparerMux.Lock()
+	defer comparerMux.Unlock()
+	old, ok := comparers[t]
+	comparers[t] = func(a, b any) bool {
+		return fn(a.(T), b.(T))
+	}
+	hasComparers.Store(true)
+	return func() {
+		comparerMux.Lock()
+		defer comparerMux.Unlock()
+		if ok {
+			comparers[t] = old
+		} else {
+			delete(comparers, t)
+		}
+		hasComparers.Store(len(comparers) > 0)
+	}
+}
+
+// getComparer returns the comparer registered for type t, or nil.
+func getComparer(t reflect.Type) func(a, b any) bool {
+	comparerMux.RLock()
+	defer comparerMux.RUnlock()
+	return comparers[t]
+}
+
+// isEqual reports whether a and b are equal.
+//
+// Two nil interface values are equal. Otherwise, a comparer registered
+// for the static type T takes precedence, followed by one registered for
+// the dynamic type of the values, and the values are compared deeply,
+// honoring the comparers registered for the types of nested values.
+// Without any registered comparer, common types such as []byte and
+// map[string][]string are compared directly, and other ones with
+// reflect.DeepEqual, or deepEqual if nil equals empty.
+func isEqual[T any](a, b T) bool {
+	withComparers := hasComparers.Load()
+	if withComparers {
+		if eq, ok := compare(a, b); ok {
+			return eq
+		}
+	}
+	nilEmpty := nilEqualsEmpty.Load()
+	if !withComparers {
+		if eq, ok := fastEqual(any(a), any(b), nilEmpty); ok {
+			return eq // the values don't escape, saving their allocations
+		}
+	}
+	x, y := any(a), any(b)
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if nilEmpty || withComparers {
+		e := equality{nilEmpty: nilEmpty, comparers: withComparers, visited: make(map[[2]uintptr]bool)}
+		return e.deepEqual(reflect.ValueOf(x), reflect.ValueOf(y))
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// compare compares a and b with the comparer registered for their static
+// or dynamic type, if any, and reports whether it did.
+func compare[T any](a, b T) (eq bool, ok bool) {
+	x, y := any(a), any(b)
+	if x == nil || y == nil {
+		return x == nil && y == nil, true
+	}
+	if fn := getComparer(reflect.TypeFor[T]()); fn != nil {
+		return fn(x, y), true
+	}
+	if t := reflect.TypeOf(x); t == reflect.TypeOf(y) {
+		if fn := getComparer(t); fn != nil {
+			return fn(x, y), true
+		}
+	}
+	return false, false
+}
+
+// fastEqual compares x and y without reflection if they are both of a
+// common type, and reports whether it did.
+func fastEqual(x, y any, nilEmpty bool) (eq bool, ok bool) {
+	switch a := x.(type) {
+	case string:
+		b, ok := y.(string)
+		return ok && a == b, ok
+	case int:
+		b, ok := y.(int)
+		return ok && a == b, ok
+	case []byte:
+		b, ok := y.([]byte)
+		return ok && bytesEqual(a, b, nilEmpty), ok
+	case *[]byte:
+		b, ok := y.(*[]byte)
+		if !ok || a == nil || b == nil {
+			return ok && a == b, ok
+		}
+		return bytesEqual(*a, *b, nilEmpty), true
+	case []string:
+		b, ok := y.([]string)
+		return ok && slices.Equal(a, b) && (nilEmpty || (a == nil) == (b == nil)), ok
+	case map[string]string:
+		b, ok := y.(map[string]string)
+		return ok && len(a) == len(b) && (nilEmpty || (a == nil) == (b == nil)) && mapEqual(a, b, func(u, v string) bool {
+			return u == v
+		}), ok
+	case map[string][]string: // e.g. http.Header and url.Values are named types, compared by reflection
+		b, ok := y.(map[string][]string)
+		return ok && len(a) == len(b) && (nilEmpty || (a == nil) == (b == nil)) && mapEqual(a, b, func(u, v []string) bool {
+			return slices.Equal(u, v) && (nilEmpty || (u == nil) == (v == nil))
+		}), ok
+	}
+	return false, false
+}
+
+// bytesEqual reports whether a and b are equal, a nil slice only being
+// equal to an empty one if nilEmpty is true.
+func bytesEqual(a, b []byte, nilEmpty bool) bool {
+	return bytes.Equal(a, b) && (nilEmpty || (a == nil) == (b == nil))
+}
+
+// mapEqual reports whether the maps a and b of the same length hold
+// equal values for the same keys.
+func mapEqual[V any](a, b map[string]V, eq func(u, v V) bool) bool {
+	for k, u := range a {
+		v, ok := b[k]
+		if !ok || !eq(u, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// equality compares values like reflect.DeepEqual, except that nil
+// slices and maps may equal empty ones and registered comparers may
+// compare nested values.
+type equality struct {
+	nilEmpty  bool                // nil slices and maps equal empty ones
+	comparers bool                // comparers are registered
+	visited   map[[2]uintptr]bool // pairs of pointers being compared, assumed equal to stop on cyclic data
+}
+
+// deepEqual reports whether x and y are deeply equal. The comparer
+// registered for their type, if any, compares them, unless they are
+// unexported struct fields, which can't be passed to it.
+func (e *equality) deepEqual(x, y reflect.Value) bool {
+	if !x.IsValid() || !y.IsValid() {
+		return x.IsValid() == y.IsValid()
+	}
+	if x.Type() != y.Type() {
+		return false
+	}
+	if e.comparers && x.CanInterface() {
+		if x.Kind() == reflect.Interface && (x.IsNil() || y.IsNil()) {
+			return x.IsNil() == y.IsNil()
+		}
+		if fn := getComparer(x.Type()); fn != nil {
+			return fn(x.Interface(), y.Interface())
+		}
+	}
+	switch x.Kind() {
+	case reflect.Slice:
+		if x.Len() != y.Len() || !e.nilEmpty && x.IsNil() != y.IsNil() {
+			return false
+		}
+		if x.Len() == 0 {
+			return true
+		}
+		if x.Type().Elem().Kind() == reflect.Uint8 && !e.comparers {
+			return bytes.Equal(x.Bytes(), y.Bytes())
+		}
+		fallthrough
+	case reflect.Array:
+		for i := range x.Len() {
+			if !e.deepEqual(x.Index(i), y.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if x.Len() != y.Len() || !e.nilEmpty && x.IsNil() != y.IsNil() {
+			return false
+		}
+		for it := x.MapRange(); it.Next(); {
+			v := y.MapIndex(it.Key())
+			if !v.IsValid() || !e.deepEqual(it.Value(), v) {
+				return false
+			}
+		}
+		return true
+	case reflect.Pointer:
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() == y.IsNil()
+		}
+		k := [2]uintptr{x.Pointer(), y.Pointer()}
+		if k[0] == k[1] || e.visited[k] {
+			return true
+		}
+		e.visited[k] = true
+		return e.deepEqual(x.Elem(), y.Elem())
+	case reflect.Interface:
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() == y.IsNil()
+		}
+		return e.deepEqual(x.Elem(), y.Elem())
+	case reflect.Struct:
+		for i := range x.NumField() {
+			if !e.deepEqual(x.Field(i), y.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Bool:
+		return x.Bool() == y.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return x.Int() == y.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return x.Uint() == y.Uint()
+	case reflect.Float32, reflect.Float64:
+		return x.Float() == y.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return x.Complex() == y.Complex()
+	case reflect.String:
+		return x.String() == y.String()
+	case reflect.Func:
+		return x.IsNil() && y.IsNil() // like reflect.DeepEqual
+	}
+	return x.Pointer() == y.Pointer() // channels and unsafe pointers
+}
+
+// Eq returns a predicate that reports whether its argument equals v.
+// Equality honors comparers registered via RegisterComparer.
+func Eq[T any](v T) func(T) bool {
+	return func(x T) bool {
+		return isEqual(x, v)
+	}
+}
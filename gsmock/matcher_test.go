@@ -0,0 +1,241 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// Name is a string type compared case-insensitively in tests.
+type Name string
+
+// Named is an interface with a registered comparer in tests.
+type Named interface {
+	Name() string
+}
+
+type named string
+
+func (n named) Name() string { return string(n) }
+
+func TestEq(t *testing.T) {
+	gsmockassert.Equal(t, gsmock.Eq(3)(3), true)
+	gsmockassert.Equal(t, gsmock.Eq(3)(4), false)
+	gsmockassert.Equal(t, gsmock.Eq(&Request{Value: 1})(&Request{Value: 1}), true)
+	gsmockassert.Equal(t, gsmock.Eq[any](nil)(nil), true)
+	gsmockassert.Equal(t, gsmock.Eq[any](nil)(1), false)
+
+	gsmock.RegisterComparer(func(a, b Name) bool {
+		return strings.EqualFold(string(a), string(b))
+	})
+	gsmockassert.Equal(t, gsmock.Eq(Name("abc"))("ABC"), true)
+	gsmockassert.Equal(t, gsmock.Eq(Name("abc"))("abd"), false)
+
+	// comparers registered for dynamic types also apply to interfaces
+	gsmockassert.Equal(t, gsmock.Eq[any](Name("abc"))(Name("ABC")), true)
+
+	// comparers registered for interface types
+	gsmock.RegisterComparer(func(a, b Named) bool {
+		return a.Name() == b.Name()
+	})
+	gsmockassert.Equal(t, gsmock.Eq[Named](named("x"))(named("x")), true)
+	gsmockassert.Equal(t, gsmock.Eq[Named](named("x"))(nil), false)
+}
+
+// tagged nests values of the types Name and Named.
+type tagged struct {
+	Name   Name
+	Names  []Name
+	ByKey  map[string]Named
+	Parent *tagged
+	name   Name
+}
+
+type Label string
+
+func TestNestedComparers(t *testing.T) {
+	restore := gsmock.RegisterComparer(func(a, b Label) bool {
+		return strings.EqualFold(string(a), string(b))
+	})
+	gsmockassert.Equal(t, gsmock.Eq(Label("a"))("A"), true)
+
+	// The comparers apply to fields, elements and values of interfaces
+	gsmockassert.Equal(t, gsmock.Eq(struct{ L Label }{"a"})(struct{ L Label }{"A"}), true)
+	gsmockassert.Equal(t, gsmock.Eq([]Label{"a", "b"})([]Label{"A", "B"}), true)
+	gsmockassert.Equal(t, gsmock.Eq([]Label{"a", "b"})([]Label{"A", "C"}), false)
+	gsmockassert.Equal(t, gsmock.Eq(map[string]any{"k": Label("a")})(map[string]any{"k": Label("A")}), true)
+	gsmockassert.Equal(t, gsmock.Eq(map[string]any{"k": Label("a")})(map[string]any{"k": nil}), false)
+
+	// but not to unexported fields, which can't be passed to them
+	gsmockassert.Equal(t, gsmock.Eq(tagged{name: "abc"})(tagged{name: "ABC"}), false)
+
+	// Comparers for a named type and an interface
+	defer gsmock.RegisterComparer(func(a, b Name) bool {
+		return strings.EqualFold(string(a), string(b))
+	})()
+	defer gsmock.RegisterComparer(func(a, b Named) bool {
+		return a.Name() == b.Name()
+	})()
+	x := &tagged{Name: "abc", Names: []Name{"x"}, ByKey: map[string]Named{"n": named("v")}}
+	y := &tagged{Name: "ABC", Names: []Name{"X"}, ByKey: map[string]Named{"n": named("v")}}
+	x.Parent, y.Parent = x, y
+	gsmockassert.Equal(t, gsmock.Eq(x)(y), true)
+	y.ByKey["n"] = nil
+	gsmockassert.Equal(t, gsmock.Eq(x)(y), false)
+
+	// Restoring removes the comparer
+	restore()
+	gsmockassert.Equal(t, gsmock.Eq(Label("a"))("A"), false)
+	gsmockassert.Equal(t, gsmock.Eq([]Label{"a"})([]Label{"A"}), false)
+}
+
+// payload holds slices and maps, nested to exercise deep comparisons.
+type payload struct {
+	Body    []byte
+	Headers map[string][]string
+	Next    *payload
+	private []int
+}
+
+func TestEqualOptions(t *testing.T) {
+	buf, empty := []byte("abc"), []byte{}
+	headers := map[string][]string{"a": {"1"}}
+
+	t.Run("default", func(t *testing.T) {
+		gsmockassert.Equal(t, gsmock.Eq([]byte("abc"))(buf), true)
+		gsmockassert.Equal(t, gsmock.Eq([]byte(nil))(empty), false)
+		gsmockassert.Equal(t, gsmock.Eq(&buf)(&buf), true)
+		gsmockassert.Equal(t, gsmock.Eq(&empty)(new([]byte)), false)
+		gsmockassert.Equal(t, gsmock.Eq(map[string][]string{"a": {"1"}})(headers), true)
+		gsmockassert.Equal(t, gsmock.Eq(map[string][]string{"a": nil})(map[string][]string{"a": {}}), false)
+		gsmockassert.Equal(t, gsmock.Eq(payload{Headers: map[string][]string{}})(payload{}), false)
+	})
+
+	t.Run("nil equals empty", func(t *testing.T) {
+		old := gsmock.SetEqualOptions(gsmock.EqualOptions{NilEqualsEmpty: true})
+		defer gsmock.SetEqualOptions(old)
+
+		gsmockassert.Equal(t, gsmock.Eq([]byte(nil))(empty), true)
+		gsmockassert.Equal(t, gsmock.Eq([]byte(nil))(buf), false)
+		gsmockassert.Equal(t, gsmock.Eq(&empty)(new([]byte)), true)
+		gsmockassert.Equal(t, gsmock.Eq([]string(nil))([]string{}), true)
+		gsmockassert.Equal(t, gsmock.Eq(map[string][]string{"a": nil})(map[string][]string{"a": {}}), true)
+		gsmockassert.Equal(t, gsmock.Eq(map[string][]string{"a": nil})(map[string][]string{"b": {}}), false)
+
+		// nested values, unexported fields and cycles
+		p := &payload{Body: []byte{}, Headers: map[string][]string{}, private: []int{}}
+		p.Next = p
+		q := &payload{}
+		q.Next = q
+		gsmockassert.Equal(t, gsmock.Eq(p)(q), true)
+		q.private = []int{1}
+		gsmockassert.Equal(t, gsmock.Eq(p)(q), false)
+		gsmockassert.Equal(t, gsmock.Eq[any](payload{})(&payload{}), false)
+	})
+}
+
+func TestWhenArgs(t *testing.T) {
+	r := gsmock.NewManager()
+	mockClient := NewMockClient(r)
+
+	mockClient.MockQuery().
+		WhenArgs(&Request{Value: 5}).
+		ReturnValue(&Response{Message: "five"}, nil)
+
+	gsmock.RegisterComparer(func(a, b *Request) bool {
+		return a.Value%10 == b.Value%10
+	})
+	defer gsmock.RegisterComparer(func(a, b *Request) bool {
+		return a.Value == b.Value
+	})
+
+	mockClient.MockQuery().
+		WhenArgs(&Request{Value: 6}).
+		ReturnValue(&Response{Message: "six"}, nil)
+
+	resp, err := mockClient.Query(&Request{Value: 5})
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp.Message, "five")
+
+	resp, err = mockClient.Query(&Request{Value: 16})
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp.Message, "six")
+
+	gsmockassert.Panic(t, func() {
+		_, _ = mockClient.Query(&Request{Value: 7})
+	}, "no mock code matched for MockClient.Query")
+}
+
+func TestWhenArg(t *testing.T) {
+	r := gsmock.NewManager()
+	c := &cache{r: r}
+
+	// matches by key only, whatever the receiver is
+	gsmock.Method22(nil, (*cache).lookup, r).
+		WhenArg2("a").
+		ReturnValue("x", true)
+
+	gsmock.Method22(nil, (*cache).lookup, r).
+		WhenArg1(c).
+		ReturnValue("y", true)
+
+	v, ok := c.lookup("a")
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, v, "x")
+
+	v, ok = c.lookup("b")
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, v, "y")
+}
+
+func TestExcept(t *testing.T) {
+	r := gsmock.NewManager()
+	mockClient := NewMockClient(r)
+
+	// a broad baseline, carved out for the values handled below
+	mockClient.MockQuery().
+		When(func(req *Request) bool { return req.Value > 0 }).
+		Except(func(req *Request) bool { return req.Value == 5 }).
+		Except(func(req *Request) bool { return req.Value == 6 }).
+		ReturnValue(&Response{Message: "positive"}, nil)
+	mockClient.MockQuery().
+		WhenArgs(&Request{Value: 5}).
+		ReturnValue(&Response{Message: "five"}, nil)
+	mockClient.MockQuery().
+		WhenNot(func(req *Request) bool { return req.Value > 0 }).
+		ReturnValue(&Response{Message: "negative"}, nil)
+
+	resp, err := mockClient.Query(&Request{Value: 1})
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp.Message, "positive")
+
+	resp, err = mockClient.Query(&Request{Value: 5})
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp.Message, "five")
+
+	resp, err = mockClient.Query(&Request{Value: -1})
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, resp.Message, "negative")
+
+	gsmockassert.Panic(t, func() {
+		_, _ = mockClient.Query(&Request{Value: 6})
+	}, "no mock code matched for MockClient.Query")
+}
@@ -20,8 +20,20 @@ package gsmock
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"reflect"
+	"regexp"
+	"runtime"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-spring/gs-mock/gsmock/chaos"
 )
 
 type managerKeyType struct{}
@@ -75,22 +87,225 @@ func newFuncKey(receiver any, fn any) funcKey {
 
 // Manager manages a collection of mock Invokers keyed by function identity.
 //
-// Manager is NOT goroutine-safe.
-// All mock registrations must be completed before any concurrent logic starts.
+// The calls of the mocked functions may run concurrently, as may the
+// methods observing them: Calls, CallsByName, CallCount, MatchedCount,
+// TotalMockTime, WriteTranscript, WriteReport, Seed, NewRand, Close and
+// the WaitForCall methods of the mockers. The other methods, mock
+// registration, Reset and the ones configuring the Manager included, must
+// be called before any concurrent logic starts or after it is over.
 type Manager struct {
-	mockers map[funcKey][]Invoker
+	mockers  map[funcKey][]Invoker
+	snapshot *Snapshot   // mocks inherited by a child Manager, nil if none
+	t        TB          // the test the Manager is bound to, nil if none
+	rand     managedSeed // seed of the random faults, orders and values
+
+	maxMockers int // maximum number of mockers per function, 0 if unlimited
+
+	snapshots []*Snapshot // snapshots returned by Freeze, invalidated by Reset
+
+	closed      atomic.Bool
+	inflightMux sync.Mutex
+	inflight    map[funcKey]int // number of calls in progress per function
+	started     map[funcKey]int // number of calls started per function, see OnCall
+	busy        int             // number of calls in progress, if timed
+	busySince   time.Time       // start of the period with calls in progress
+	mockTime    time.Duration   // total time with calls in progress
+
+	logger    Logger           // nil if no logger is attached
+	tracer    Tracer           // nil if no tracer is attached
+	chaos     *chaos.Injector  // nil if no chaos profile is applied
+	retention *RetentionPolicy // nil if call recording is disabled
+	events    *eventStream     // nil if Events was never called
+	recordMux sync.Mutex
+	records   map[funcKey]*callRecord
+
+	callMux     sync.Mutex
+	callCond    *sync.Cond      // signaled on every call while there are waiters
+	callCounts  map[funcKey]int // number of completed calls per function
+	callWaiters int             // number of goroutines in waitForCalls
+
+	orderMux sync.Mutex
+	order    map[funcKey]int // first-seen index per function, nil if not enabled
+	shuffle  *shuffler       // nil if mockers are evaluated in registration order
+
+	frozenMux  sync.Mutex
+	frozen     map[frozenKey]*frozenValue // values returned by frozen mockers
+	frozenKeys []frozenKey                // keys of frozen in return order
+
+	defaults map[reflect.Type]func() any // values registered with RegisterDefaultFor
+
+	specs     []*specMock           // mocks declared by the loaded spec files
+	specMux   sync.Mutex            // guards specCache
+	specCache map[funcKey][]Invoker // mocks of specs applying to each function
+
+	provenance     atomic.Bool     // whether returned pointers are tagged
+	provenanceKeys []provenanceKey // values tagged, guarded by provenances
+
+	compareMux  sync.Mutex
+	compares    map[any]reflect.Value // real implementations by mock, nil if Compare was never called
+	divergences []error               // calls whose mocked results diverge from the real ones
 }
 
 // NewManager creates and initializes a new Manager.
+// Built with the gsmock_guard build tag, it panics with ErrNotTesting
+// when called from a binary that doesn't run tests.
 func NewManager() *Manager {
-	m := &Manager{}
+	checkTesting()
+	m := &Manager{inflight: make(map[funcKey]int), started: make(map[funcKey]int)}
+	m.rand.seed = newSeed()
+	m.callCond = sync.NewCond(&m.callMux)
 	m.Reset()
 	return m
 }
 
-// Reset removes all registered mockers from the Manager.
+// TB is the minimal interface that *testing.T and *testing.B satisfy.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Cleanup(func())
+}
+
+// NewManagerT creates a new Manager bound to the lifetime of a test.
+// The Manager is closed when the test and all its subtests complete,
+// and the test fails if mocked calls are still in flight at that time.
+func NewManagerT(t TB) *Manager {
+	r := NewManager()
+	r.t = t
+	t.Cleanup(func() {
+		t.Helper()
+		if err := r.Close(); err != nil {
+			t.Errorf("%v", err)
+		}
+		r.logSeed(t)
+	})
+	return r
+}
+
+// ErrClosed is the error a closed Manager panics with when a mock is used.
+var ErrClosed = errors.New("gsmock: mock used after test end")
+
+// Close marks the Manager as finished. Every later mocked call panics
+// with ErrClosed, which typically exposes goroutines leaked by the code
+// under test that keep calling mocks.
+//
+// Close returns an error listing the mocked calls still in flight, the
+// values returned by frozen mockers that contradict their mode and the
+// calls diverging from the real implementations given to Compare, or
+// nil if there are none.
+func (r *Manager) Close() error {
+	r.closed.Store(true)
+
+	var errs []error
+	if err := r.checkInflight(); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, r.checkFrozen()...)
+	errs = append(errs, r.checkCompared()...)
+	r.forgetProvenance()
+	if r.events != nil {
+		r.closeEvents(errs)
+	}
+	return errors.Join(errs...)
+}
+
+// checkInflight returns an error listing the mocked calls still in flight.
+func (r *Manager) checkInflight() error {
+	r.inflightMux.Lock()
+	defer r.inflightMux.Unlock()
+	keys := slices.Collect(maps.Keys(r.inflight))
+	if len(keys) == 0 {
+		return nil
+	}
+	r.sortKeys(keys)
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = fmt.Sprintf("%s (%d)", funcName(k), r.inflight[k])
+	}
+	return fmt.Errorf("gsmock: mocked calls still in flight at test end: %s", strings.Join(names, ", "))
+}
+
+// enter marks a call of k as in flight, and returns its 1-based index
+// among the calls of k and whether it is timed, to pass to exit. It
+// panics if the Manager is closed.
+func (r *Manager) enter(k funcKey) (n int, timed bool) {
+	if r.closed.Load() {
+		panic(fmt.Errorf("%w: %s", ErrClosed, funcName(k)))
+	}
+	if r.snapshot.stale() {
+		panic(fmt.Errorf("%w: %s", ErrSnapshotReset, funcName(k)))
+	}
+	r.seen(k)
+	r.inflightMux.Lock()
+	defer r.inflightMux.Unlock()
+	r.inflight[k]++
+	r.started[k]++
+	if timed = r.retention != nil; timed {
+		r.startBusy()
+	}
+	return r.started[k], timed
+}
+
+// exit marks a call of k as completed, timed if enter said so, even if
+// recording was enabled in the meantime.
+func (r *Manager) exit(k funcKey, timed bool) {
+	r.inflightMux.Lock()
+	if r.inflight[k]--; r.inflight[k] == 0 {
+		delete(r.inflight, k)
+	}
+	if timed {
+		r.endBusy()
+	}
+	r.inflightMux.Unlock()
+}
+
+// forbiddenPrefix starts the reports of forbidden calls.
+const forbiddenPrefix = "gsmock: forbidden call to "
+
+// forbidden reports a call of k matched by a mocker configured with Never.
+// The bound test fails if there is one; otherwise forbidden panics.
+func (r *Manager) forbidden(k funcKey, params []any) {
+	msg := fmt.Sprintf(forbiddenPrefix+"%s with params %s\n%s",
+		funcName(k), formatParams(params), debug.Stack())
+	if r.t == nil {
+		panic(msg)
+	}
+	r.t.Errorf("%s", msg)
+}
+
+// genericMethodValue matches the closure names the compiler gives to
+// method values of generic types returned by the generated funcXxx methods.
+var genericMethodValue = regexp.MustCompile(`\.func(\w+)\.func\d+$`)
+
+// funcName returns a readable name of the function identified by k.
+func funcName(k funcKey) string {
+	f := runtime.FuncForPC(k.fnPC)
+	if f == nil {
+		return fmt.Sprintf("func@%#x", k.fnPC)
+	}
+	name := strings.TrimSuffix(f.Name(), "-fm")
+	return genericMethodValue.ReplaceAllString(name, ".$1")
+}
+
+// Reset removes all registered mockers from the Manager, and invalidates
+// the snapshots frozen from it, along with their child Managers, see Freeze.
+// The mocks a child Manager inherits from its own snapshot, the spec mocks
+// loaded before the Freeze included, are kept.
 func (r *Manager) Reset() {
+	r.invalidateSnapshots()
 	r.mockers = make(map[funcKey][]Invoker)
+	r.records = make(map[funcKey]*callRecord)
+	r.frozen = nil
+	r.frozenKeys = nil
+	r.specs = nil
+	clear(r.specCache)
+	r.callMux.Lock()
+	r.callCounts = make(map[funcKey]int)
+	r.callMux.Unlock()
+	r.inflightMux.Lock()
+	clear(r.started)
+	r.mockTime = 0
+	r.busySince = now()
+	r.inflightMux.Unlock()
 }
 
 // addInvoker registers an Invoker for a specific function.
@@ -109,7 +324,12 @@ func (r *Manager) Reset() {
 // evaluated in registration order.
 func (r *Manager) addInvoker(receiver any, fn any, i Invoker) {
 	k := newFuncKey(receiver, fn)
+	r.checkMaxMockers(k)
+	r.seen(k)
 	r.mockers[k] = append(r.mockers[k], i)
+	if r.events != nil {
+		r.emit(Event{Kind: EventRegister, Func: funcName(k)})
+	}
 }
 
 // Invoke looks up and executes a mock Invoker for the given function call.
@@ -121,10 +341,61 @@ func (r *Manager) addInvoker(receiver any, fn any, i Invoker) {
 // The Invokers are evaluated in registration order.
 // The first Invoker whose Invoke method returns ok == true is selected.
 // Its return values are returned immediately.
-func Invoke(r *Manager, receiver any, fn any, params ...any) ([]any, bool) {
+func Invoke(r *Manager, receiver any, fn any, params ...any) (ret []any, ok bool) {
 	k := newFuncKey(receiver, fn)
-	for _, m := range r.mockers[k] {
-		if ret, ok := m.Invoke(params); ok {
+	n, timed := r.enter(k)
+	defer r.exit(k, timed)
+	if r.tracer != nil {
+		end := r.startCall(k, params)
+		defer func() { end(ok) }()
+	}
+	if r.events != nil {
+		r.emit(Event{Kind: EventDispatch, Func: funcName(k), Params: params})
+	}
+	ret, ok = r.dispatch(k, n, params)
+	if ok && r.compares != nil {
+		r.compare(k, params, ret)
+	}
+	if ok && r.chaos != nil {
+		ret = r.injectChaos(fn, ret)
+	}
+	if r.events != nil {
+		if ok {
+			r.emit(Event{Kind: EventMatch, Func: funcName(k), Params: params, Results: ret})
+		} else {
+			r.emit(Event{Kind: EventMiss, Func: funcName(k), Params: params})
+		}
+	}
+	if r.retention != nil {
+		r.record(k, params, ret, ok)
+	}
+	if r.logger != nil {
+		r.logDispatch(k, params, ret, ok)
+	}
+	r.countCall(k)
+	return ret, ok
+}
+
+// dispatch evaluates the Invokers registered for k in registration order,
+// or in a random order if enabled by EnableShuffledOrder, for the call n.
+func (r *Manager) dispatch(k funcKey, n int, params []any) ([]any, bool) {
+	mockers := r.mockers[k]
+	if r.snapshot != nil {
+		if inherited := r.snapshot.inherited(k); len(inherited) > 0 {
+			mockers = append(slices.Clip(mockers), inherited...)
+		}
+	}
+	if specs := r.specMockers(k); len(specs) > 0 {
+		mockers = append(slices.Clip(mockers), specs...)
+	}
+	if r.shuffle != nil {
+		mockers = r.shuffle.shuffled(mockers)
+	}
+	for _, m := range mockers {
+		if skipsCall(m, n) {
+			continue
+		}
+		if ret, ok := invokeFor(r, m, params); ok {
 			return ret, true
 		}
 	}
@@ -156,6 +427,14 @@ func InvokeContext(ctx context.Context, fn any, params ...any) ([]any, bool) {
 	return nil, false
 }
 
+// param converts a boxed parameter of a mocked call back to its type T.
+// Like the results extracted by Unbox, a nil interface argument, which
+// is boxed as nil, results in the zero value of T.
+func param[T any](v any) T {
+	t, _ := v.(T)
+	return t
+}
+
 // Unbox1 extracts a single return value from a mock result slice.
 //
 // It panics if the number of return values is not exactly 1.
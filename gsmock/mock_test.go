@@ -0,0 +1,194 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// fakeT is a gsmock.TB that records failures instead of reporting them.
+type fakeT struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Cleanup(fn func()) {
+	t.cleanups = append(t.cleanups, fn)
+}
+
+// finish runs the registered cleanups like the testing package does.
+func (t *fakeT) finish() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+func TestManagerClose(t *testing.T) {
+
+	// Test case: closing a manager without in-flight calls
+	{
+		ft := &fakeT{}
+		r := gsmock.NewManagerT(ft)
+		c := NewMockClient(r)
+		c.MockQuery().ReturnValue(&Response{}, nil)
+		_, _ = c.Query(&Request{})
+
+		ft.finish()
+		gsmockassert.Nil(t, ft.errors)
+
+		// using the mock after the test ended panics clearly
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Query(&Request{})
+		}, `gsmock: mock used after test end: .*\(\*MockClient\)\.Query`)
+
+		func() {
+			defer func() {
+				err, _ := recover().(error)
+				gsmockassert.Equal(t, errors.Is(err, gsmock.ErrClosed), true)
+			}()
+			_, _ = c.Query(&Request{})
+		}()
+	}
+
+	// Test case: calls still in flight are reported
+	{
+		ft := &fakeT{}
+		r := gsmock.NewManagerT(ft)
+		c := NewMockClient(r)
+
+		entered := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan struct{})
+		c.MockQuery().Handle(func(req *Request) (*Response, error) {
+			close(entered)
+			<-release
+			return &Response{}, nil
+		})
+
+		go func() {
+			defer close(done)
+			_, _ = c.Query(&Request{})
+		}()
+		<-entered
+
+		ft.finish()
+		gsmockassert.Equal(t, len(ft.errors), 1)
+		gsmockassert.Panic(t, func() {
+			panic(ft.errors[0])
+		}, `in flight at test end: .*\(\*MockClient\)\.Query \(1\)`)
+
+		close(release)
+		<-done
+		gsmockassert.Nil(t, r.Close())
+	}
+}
+
+func TestNever(t *testing.T) {
+
+	// Test case: without a bound test, forbidden calls panic
+	{
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		c.MockQuery().WhenArgs(&Request{Value: 1}).Never()
+		c.MockQuery().ReturnValue(&Response{Message: "ok"}, nil)
+
+		resp, err := c.Query(&Request{Value: 2})
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "ok")
+
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Query(&Request{Value: 1})
+		}, `forbidden call to .*\(\*MockClient\)\.Query with params \(&\{Value:1\}\)`)
+	}
+
+	// Test case: with a bound test, forbidden calls fail the test
+	{
+		ft := &fakeT{}
+		r := gsmock.NewManagerT(ft)
+		c := NewMockClient(r)
+		c.MockQuery().Never()
+
+		resp, err := c.Query(&Request{Value: 3})
+		gsmockassert.Nil(t, err)
+		gsmockassert.Nil(t, resp)
+		gsmockassert.Equal(t, len(ft.errors), 1)
+		gsmockassert.Panic(t, func() {
+			panic(ft.errors[0])
+		}, `(?s)forbidden call to .*Query with params \(&\{Value:3\}\).*goroutine`)
+	}
+}
+
+// cache is an unexported type whose unexported method is mocked through
+// a method expression, as white-box tests in its own package would do.
+type cache struct {
+	r *gsmock.Manager
+}
+
+func (c *cache) lookup(key string) (string, bool) {
+	if ret, ok := gsmock.Invoke(c.r, nil, (*cache).lookup, c, key); ok {
+		return gsmock.Unbox2[string, bool](ret)
+	}
+	return "", false
+}
+
+func TestUnexportedMethod(t *testing.T) {
+	r := gsmock.NewManager()
+	c := &cache{r: r}
+
+	gsmock.Method22(nil, (*cache).lookup, r).
+		WhenArgs(c, "a").
+		ReturnValue("x", true)
+
+	v, ok := c.lookup("a")
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, v, "x")
+
+	v, ok = c.lookup("b")
+	gsmockassert.Equal(t, ok, false)
+	gsmockassert.Equal(t, v, "")
+
+	gsmock.Method22(nil, (*cache).lookup, r).Never()
+	gsmockassert.Panic(t, func() {
+		_, _ = c.lookup("c")
+	}, `forbidden call to .*\.\(\*cache\)\.lookup with params`)
+}
+
+func TestNilInterfaceParam(t *testing.T) {
+	r := gsmock.NewManager()
+	describe := func(err error) string { return err.Error() }
+
+	m := gsmock.Method11(nil, describe, r).
+		When(func(err error) bool { return err == nil })
+	c := m.CaptureArg1()
+	m.ReturnValue("no error")
+
+	ret, ok := gsmock.Invoke(r, nil, describe, nil)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, gsmock.Unbox1[string](ret), "no error")
+	gsmockassert.Equal(t, c.Values(), []error{nil})
+}
@@ -0,0 +1,6659 @@
+// Code generated by internal/mocker. DO NOT EDIT.
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestMocker00(t *testing.T) {
+	fn := func() {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.Method00(nil, fn, r)
+	gsmock.Method00(nil, fn, r).Handle(func() {})
+	ret, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method00(nil, fn, r).
+		When(func() bool { return true }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method00(nil, fn, r).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	gsmock.Method00(nil, fn, r)
+	gsmock.Method00(nil, fn, r).ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+}
+
+func TestVarMocker00(t *testing.T) {
+	fn := func() {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.VarMethod00(nil, fn, r)
+	gsmock.VarMethod00(nil, fn, r).Handle(func() {})
+	ret, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod00(nil, fn, r).
+		When(func() bool { return true }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod00(nil, fn, r).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	gsmock.VarMethod00(nil, fn, r)
+	gsmock.VarMethod00(nil, fn, r).ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+}
+
+func TestMocker01(t *testing.T) {
+	fn := func() int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method01(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func() int {
+		s := 0
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	gsmockassert.Equal(t, d1.Values(), []int{1})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method01(nil, fn, r).
+		When(func() bool { return true }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method01(nil, fn, r).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	gsmock.Method01(nil, fn, r)
+	gsmock.Method01(nil, fn, r).ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+}
+
+func TestVarMocker01(t *testing.T) {
+	fn := func() int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod01(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func() int {
+		s := 0
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	gsmockassert.Equal(t, d1.Values(), []int{1})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod01(nil, fn, r).
+		When(func() bool { return true }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod01(nil, fn, r).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	gsmock.VarMethod01(nil, fn, r)
+	gsmock.VarMethod01(nil, fn, r).ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+}
+
+func TestMocker02(t *testing.T) {
+	fn := func() (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method02(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func() (int, int) {
+		s := 0
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	gsmockassert.Equal(t, d1.Values(), []int{1})
+	gsmockassert.Equal(t, d2.Values(), []int{2})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method02(nil, fn, r).
+		When(func() bool { return true }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+
+	// Test case: ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method02(nil, fn, r).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	gsmock.Method02(nil, fn, r)
+	gsmock.Method02(nil, fn, r).ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+}
+
+func TestVarMocker02(t *testing.T) {
+	fn := func() (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod02(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func() (int, int) {
+		s := 0
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	gsmockassert.Equal(t, d1.Values(), []int{1})
+	gsmockassert.Equal(t, d2.Values(), []int{2})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod02(nil, fn, r).
+		When(func() bool { return true }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+
+	// Test case: ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod02(nil, fn, r).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	gsmock.VarMethod02(nil, fn, r)
+	gsmock.VarMethod02(nil, fn, r).ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+}
+
+func TestMocker03(t *testing.T) {
+	fn := func() (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method03(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func() (int, int, int) {
+		s := 0
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	gsmockassert.Equal(t, d1.Values(), []int{1})
+	gsmockassert.Equal(t, d2.Values(), []int{2})
+	gsmockassert.Equal(t, d3.Values(), []int{3})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method03(nil, fn, r).
+		When(func() bool { return true }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+
+	// Test case: ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method03(nil, fn, r).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	gsmock.Method03(nil, fn, r)
+	gsmock.Method03(nil, fn, r).ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+}
+
+func TestVarMocker03(t *testing.T) {
+	fn := func() (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod03(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func() (int, int, int) {
+		s := 0
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	gsmockassert.Equal(t, d1.Values(), []int{1})
+	gsmockassert.Equal(t, d2.Values(), []int{2})
+	gsmockassert.Equal(t, d3.Values(), []int{3})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod03(nil, fn, r).
+		When(func() bool { return true }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+
+	// Test case: ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod03(nil, fn, r).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	gsmock.VarMethod03(nil, fn, r)
+	gsmock.VarMethod03(nil, fn, r).ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+}
+
+func TestMocker04(t *testing.T) {
+	fn := func() (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method04(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func() (int, int, int, int) {
+		s := 0
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	gsmockassert.Equal(t, d1.Values(), []int{1})
+	gsmockassert.Equal(t, d2.Values(), []int{2})
+	gsmockassert.Equal(t, d3.Values(), []int{3})
+	gsmockassert.Equal(t, d4.Values(), []int{4})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method04(nil, fn, r).
+		When(func() bool { return true }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+
+	// Test case: ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method04(nil, fn, r).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	gsmock.Method04(nil, fn, r)
+	gsmock.Method04(nil, fn, r).ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+}
+
+func TestVarMocker04(t *testing.T) {
+	fn := func() (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod04(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func() (int, int, int, int) {
+		s := 0
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	gsmockassert.Equal(t, d1.Values(), []int{1})
+	gsmockassert.Equal(t, d2.Values(), []int{2})
+	gsmockassert.Equal(t, d3.Values(), []int{3})
+	gsmockassert.Equal(t, d4.Values(), []int{4})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod04(nil, fn, r).
+		When(func() bool { return true }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+
+	// Test case: ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod04(nil, fn, r).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	gsmock.VarMethod04(nil, fn, r)
+	gsmock.VarMethod04(nil, fn, r).ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+}
+
+func TestMocker10(t *testing.T) {
+	fn := func(a1 int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.Method10(nil, fn, r)
+	gsmock.Method10(nil, fn, r).Handle(func(a1 int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method10(nil, fn, r).
+		When(func(a1 int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method10(nil, fn, r).WhenArgs(1).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method10(nil, fn, r).
+		WhenKey(func(a1 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method10(nil, fn, r)
+	c1 := m.CaptureArg1()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method10(nil, fn, r).Bind(1).Handle(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker10(t *testing.T) {
+	fn := func(a1 ...int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.VarMethod10(nil, fn, r)
+	gsmock.VarMethod10(nil, fn, r).Handle(func(a1 []int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod10(nil, fn, r).
+		When(func(a1 []int) bool { return a1[0] == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod10(nil, fn, r).WhenArgs([]int{1}).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod10(nil, fn, r).
+		WhenKey(func(a1 []int) any { return a1[0] == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod10(nil, fn, r)
+	c1 := m.CaptureArg1()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), [][]int{{1}})
+}
+
+func TestMocker11(t *testing.T) {
+	fn := func(a1 int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method11(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int) int {
+		s := a1
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2})
+	gsmockassert.Equal(t, d1.Values(), []int{2})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method11(nil, fn, r).
+		When(func(a1 int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method11(nil, fn, r).WhenArgs(1).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method11(nil, fn, r).
+		WhenKey(func(a1 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method11(nil, fn, r)
+	c1 := m.CaptureArg1()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method11(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method11(nil, fn, r).Bind(1).Handle(func() int {
+		s := 1
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker11(t *testing.T) {
+	fn := func(a1 ...int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod11(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 []int) int {
+		s := a1[0]
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2})
+	gsmockassert.Equal(t, d1.Values(), []int{2})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod11(nil, fn, r).
+		When(func(a1 []int) bool { return a1[0] == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod11(nil, fn, r).WhenArgs([]int{1}).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod11(nil, fn, r).
+		WhenKey(func(a1 []int) any { return a1[0] == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod11(nil, fn, r)
+	c1 := m.CaptureArg1()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), [][]int{{1}})
+}
+
+func TestMocker12(t *testing.T) {
+	fn := func(a1 int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method12(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int) (int, int) {
+		s := a1
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2, 3})
+	gsmockassert.Equal(t, d1.Values(), []int{2})
+	gsmockassert.Equal(t, d2.Values(), []int{3})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method12(nil, fn, r).
+		When(func(a1 int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method12(nil, fn, r).WhenArgs(1).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method12(nil, fn, r).
+		WhenKey(func(a1 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method12(nil, fn, r)
+	c1 := m.CaptureArg1()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method12(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method12(nil, fn, r).Bind(1).Handle(func() (int, int) {
+		s := 1
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker12(t *testing.T) {
+	fn := func(a1 ...int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod12(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 []int) (int, int) {
+		s := a1[0]
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2, 3})
+	gsmockassert.Equal(t, d1.Values(), []int{2})
+	gsmockassert.Equal(t, d2.Values(), []int{3})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod12(nil, fn, r).
+		When(func(a1 []int) bool { return a1[0] == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod12(nil, fn, r).WhenArgs([]int{1}).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod12(nil, fn, r).
+		WhenKey(func(a1 []int) any { return a1[0] == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod12(nil, fn, r)
+	c1 := m.CaptureArg1()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), [][]int{{1}})
+}
+
+func TestMocker13(t *testing.T) {
+	fn := func(a1 int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method13(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int) (int, int, int) {
+		s := a1
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2, 3, 4})
+	gsmockassert.Equal(t, d1.Values(), []int{2})
+	gsmockassert.Equal(t, d2.Values(), []int{3})
+	gsmockassert.Equal(t, d3.Values(), []int{4})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method13(nil, fn, r).
+		When(func(a1 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method13(nil, fn, r).WhenArgs(1).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method13(nil, fn, r).
+		WhenKey(func(a1 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method13(nil, fn, r)
+	c1 := m.CaptureArg1()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method13(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method13(nil, fn, r).Bind(1).Handle(func() (int, int, int) {
+		s := 1
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker13(t *testing.T) {
+	fn := func(a1 ...int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod13(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 []int) (int, int, int) {
+		s := a1[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2, 3, 4})
+	gsmockassert.Equal(t, d1.Values(), []int{2})
+	gsmockassert.Equal(t, d2.Values(), []int{3})
+	gsmockassert.Equal(t, d3.Values(), []int{4})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod13(nil, fn, r).
+		When(func(a1 []int) bool { return a1[0] == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod13(nil, fn, r).WhenArgs([]int{1}).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod13(nil, fn, r).
+		WhenKey(func(a1 []int) any { return a1[0] == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod13(nil, fn, r)
+	c1 := m.CaptureArg1()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), [][]int{{1}})
+}
+
+func TestMocker14(t *testing.T) {
+	fn := func(a1 int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method14(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int) (int, int, int, int) {
+		s := a1
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2, 3, 4, 5})
+	gsmockassert.Equal(t, d1.Values(), []int{2})
+	gsmockassert.Equal(t, d2.Values(), []int{3})
+	gsmockassert.Equal(t, d3.Values(), []int{4})
+	gsmockassert.Equal(t, d4.Values(), []int{5})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method14(nil, fn, r).
+		When(func(a1 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method14(nil, fn, r).WhenArgs(1).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method14(nil, fn, r).
+		WhenKey(func(a1 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method14(nil, fn, r)
+	c1 := m.CaptureArg1()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method14(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method14(nil, fn, r).Bind(1).Handle(func() (int, int, int, int) {
+		s := 1
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2, 3, 4, 5})
+	_, ok = gsmock.Invoke(r, nil, fn, 0)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker14(t *testing.T) {
+	fn := func(a1 ...int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod14(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 []int) (int, int, int, int) {
+		s := a1[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{2, 3, 4, 5})
+	gsmockassert.Equal(t, d1.Values(), []int{2})
+	gsmockassert.Equal(t, d2.Values(), []int{3})
+	gsmockassert.Equal(t, d3.Values(), []int{4})
+	gsmockassert.Equal(t, d4.Values(), []int{5})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod14(nil, fn, r).
+		When(func(a1 []int) bool { return a1[0] == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod14(nil, fn, r).WhenArgs([]int{1}).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod14(nil, fn, r).
+		WhenKey(func(a1 []int) any { return a1[0] == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, []int{0})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod14(nil, fn, r)
+	c1 := m.CaptureArg1()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, []int{1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), [][]int{{1}})
+}
+
+func TestMocker20(t *testing.T) {
+	fn := func(a1 int, a2 int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.Method20(nil, fn, r)
+	gsmock.Method20(nil, fn, r).Handle(func(a1 int, a2 int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method20(nil, fn, r).
+		When(func(a1 int, a2 int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method20(nil, fn, r).WhenArgs(1, 2).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method20(nil, fn, r).
+		WhenKey(func(a1 int, a2 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method20(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method20(nil, fn, r).Bind(1).Handle(func(a2 int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker20(t *testing.T) {
+	fn := func(a1 int, a2 ...int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.VarMethod20(nil, fn, r)
+	gsmock.VarMethod20(nil, fn, r).Handle(func(a1 int, a2 []int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod20(nil, fn, r).
+		When(func(a1 int, a2 []int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod20(nil, fn, r).WhenArgs(1, []int{2}).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod20(nil, fn, r).
+		WhenKey(func(a1 int, a2 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod20(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), [][]int{{2}})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod20(nil, fn, r).Bind(1).Handle(func(a2 []int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker21(t *testing.T) {
+	fn := func(a1 int, a2 int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method21(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 int) int {
+		s := a1 + a2
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4})
+	gsmockassert.Equal(t, d1.Values(), []int{4})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method21(nil, fn, r).
+		When(func(a1 int, a2 int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method21(nil, fn, r).WhenArgs(1, 2).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method21(nil, fn, r).
+		WhenKey(func(a1 int, a2 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method21(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method21(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method21(nil, fn, r).Bind(1).Handle(func(a2 int) int {
+		s := 1 + a2
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker21(t *testing.T) {
+	fn := func(a1 int, a2 ...int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod21(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 []int) int {
+		s := a1 + a2[0]
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4})
+	gsmockassert.Equal(t, d1.Values(), []int{4})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod21(nil, fn, r).
+		When(func(a1 int, a2 []int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod21(nil, fn, r).WhenArgs(1, []int{2}).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod21(nil, fn, r).
+		WhenKey(func(a1 int, a2 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod21(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), [][]int{{2}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod21(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod21(nil, fn, r).Bind(1).Handle(func(a2 []int) int {
+		s := 1 + a2[0]
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker22(t *testing.T) {
+	fn := func(a1 int, a2 int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method22(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 int) (int, int) {
+		s := a1 + a2
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5})
+	gsmockassert.Equal(t, d1.Values(), []int{4})
+	gsmockassert.Equal(t, d2.Values(), []int{5})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method22(nil, fn, r).
+		When(func(a1 int, a2 int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method22(nil, fn, r).WhenArgs(1, 2).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method22(nil, fn, r).
+		WhenKey(func(a1 int, a2 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method22(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method22(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method22(nil, fn, r).Bind(1).Handle(func(a2 int) (int, int) {
+		s := 1 + a2
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker22(t *testing.T) {
+	fn := func(a1 int, a2 ...int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod22(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 []int) (int, int) {
+		s := a1 + a2[0]
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5})
+	gsmockassert.Equal(t, d1.Values(), []int{4})
+	gsmockassert.Equal(t, d2.Values(), []int{5})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod22(nil, fn, r).
+		When(func(a1 int, a2 []int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod22(nil, fn, r).WhenArgs(1, []int{2}).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod22(nil, fn, r).
+		WhenKey(func(a1 int, a2 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod22(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), [][]int{{2}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod22(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod22(nil, fn, r).Bind(1).Handle(func(a2 []int) (int, int) {
+		s := 1 + a2[0]
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker23(t *testing.T) {
+	fn := func(a1 int, a2 int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method23(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 int) (int, int, int) {
+		s := a1 + a2
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5, 6})
+	gsmockassert.Equal(t, d1.Values(), []int{4})
+	gsmockassert.Equal(t, d2.Values(), []int{5})
+	gsmockassert.Equal(t, d3.Values(), []int{6})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method23(nil, fn, r).
+		When(func(a1 int, a2 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method23(nil, fn, r).WhenArgs(1, 2).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method23(nil, fn, r).
+		WhenKey(func(a1 int, a2 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method23(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method23(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method23(nil, fn, r).Bind(1).Handle(func(a2 int) (int, int, int) {
+		s := 1 + a2
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5, 6})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker23(t *testing.T) {
+	fn := func(a1 int, a2 ...int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod23(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 []int) (int, int, int) {
+		s := a1 + a2[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5, 6})
+	gsmockassert.Equal(t, d1.Values(), []int{4})
+	gsmockassert.Equal(t, d2.Values(), []int{5})
+	gsmockassert.Equal(t, d3.Values(), []int{6})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod23(nil, fn, r).
+		When(func(a1 int, a2 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod23(nil, fn, r).WhenArgs(1, []int{2}).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod23(nil, fn, r).
+		WhenKey(func(a1 int, a2 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod23(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), [][]int{{2}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod23(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod23(nil, fn, r).Bind(1).Handle(func(a2 []int) (int, int, int) {
+		s := 1 + a2[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5, 6})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker24(t *testing.T) {
+	fn := func(a1 int, a2 int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method24(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 int) (int, int, int, int) {
+		s := a1 + a2
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5, 6, 7})
+	gsmockassert.Equal(t, d1.Values(), []int{4})
+	gsmockassert.Equal(t, d2.Values(), []int{5})
+	gsmockassert.Equal(t, d3.Values(), []int{6})
+	gsmockassert.Equal(t, d4.Values(), []int{7})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method24(nil, fn, r).
+		When(func(a1 int, a2 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method24(nil, fn, r).WhenArgs(1, 2).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method24(nil, fn, r).
+		WhenKey(func(a1 int, a2 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method24(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method24(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method24(nil, fn, r).Bind(1).Handle(func(a2 int) (int, int, int, int) {
+		s := 1 + a2
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5, 6, 7})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker24(t *testing.T) {
+	fn := func(a1 int, a2 ...int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod24(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 []int) (int, int, int, int) {
+		s := a1 + a2[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5, 6, 7})
+	gsmockassert.Equal(t, d1.Values(), []int{4})
+	gsmockassert.Equal(t, d2.Values(), []int{5})
+	gsmockassert.Equal(t, d3.Values(), []int{6})
+	gsmockassert.Equal(t, d4.Values(), []int{7})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod24(nil, fn, r).
+		When(func(a1 int, a2 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod24(nil, fn, r).WhenArgs(1, []int{2}).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod24(nil, fn, r).
+		WhenKey(func(a1 int, a2 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod24(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), [][]int{{2}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod24(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod24(nil, fn, r).Bind(1).Handle(func(a2 []int) (int, int, int, int) {
+		s := 1 + a2[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, []int{2})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{4, 5, 6, 7})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, []int{2})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker30(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.Method30(nil, fn, r)
+	gsmock.Method30(nil, fn, r).Handle(func(a1 int, a2 int, a3 int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method30(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method30(nil, fn, r).WhenArgs(1, 2, 3).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method30(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method30(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method30(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker30(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 ...int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.VarMethod30(nil, fn, r)
+	gsmock.VarMethod30(nil, fn, r).Handle(func(a1 int, a2 int, a3 []int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod30(nil, fn, r).
+		When(func(a1 int, a2 int, a3 []int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod30(nil, fn, r).WhenArgs(1, 2, []int{3}).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod30(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod30(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), [][]int{{3}})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod30(nil, fn, r).Bind(1).Handle(func(a2 int, a3 []int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker31(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method31(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 int, a3 int) int {
+		s := a1 + a2 + a3
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7})
+	gsmockassert.Equal(t, d1.Values(), []int{7})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method31(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method31(nil, fn, r).WhenArgs(1, 2, 3).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method31(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method31(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method31(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method31(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int) int {
+		s := 1 + a2 + a3
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker31(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 ...int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod31(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 int, a3 []int) int {
+		s := a1 + a2 + a3[0]
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7})
+	gsmockassert.Equal(t, d1.Values(), []int{7})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod31(nil, fn, r).
+		When(func(a1 int, a2 int, a3 []int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod31(nil, fn, r).WhenArgs(1, 2, []int{3}).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod31(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod31(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), [][]int{{3}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod31(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod31(nil, fn, r).Bind(1).Handle(func(a2 int, a3 []int) int {
+		s := 1 + a2 + a3[0]
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker32(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method32(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 int, a3 int) (int, int) {
+		s := a1 + a2 + a3
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8})
+	gsmockassert.Equal(t, d1.Values(), []int{7})
+	gsmockassert.Equal(t, d2.Values(), []int{8})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method32(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method32(nil, fn, r).WhenArgs(1, 2, 3).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method32(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method32(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method32(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method32(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int) (int, int) {
+		s := 1 + a2 + a3
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker32(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 ...int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod32(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 int, a3 []int) (int, int) {
+		s := a1 + a2 + a3[0]
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8})
+	gsmockassert.Equal(t, d1.Values(), []int{7})
+	gsmockassert.Equal(t, d2.Values(), []int{8})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod32(nil, fn, r).
+		When(func(a1 int, a2 int, a3 []int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod32(nil, fn, r).WhenArgs(1, 2, []int{3}).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod32(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod32(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), [][]int{{3}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod32(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod32(nil, fn, r).Bind(1).Handle(func(a2 int, a3 []int) (int, int) {
+		s := 1 + a2 + a3[0]
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker33(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method33(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 int, a3 int) (int, int, int) {
+		s := a1 + a2 + a3
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8, 9})
+	gsmockassert.Equal(t, d1.Values(), []int{7})
+	gsmockassert.Equal(t, d2.Values(), []int{8})
+	gsmockassert.Equal(t, d3.Values(), []int{9})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method33(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method33(nil, fn, r).WhenArgs(1, 2, 3).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method33(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method33(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method33(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method33(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int) (int, int, int) {
+		s := 1 + a2 + a3
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8, 9})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker33(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 ...int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod33(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 int, a3 []int) (int, int, int) {
+		s := a1 + a2 + a3[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8, 9})
+	gsmockassert.Equal(t, d1.Values(), []int{7})
+	gsmockassert.Equal(t, d2.Values(), []int{8})
+	gsmockassert.Equal(t, d3.Values(), []int{9})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod33(nil, fn, r).
+		When(func(a1 int, a2 int, a3 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod33(nil, fn, r).WhenArgs(1, 2, []int{3}).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod33(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod33(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), [][]int{{3}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod33(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod33(nil, fn, r).Bind(1).Handle(func(a2 int, a3 []int) (int, int, int) {
+		s := 1 + a2 + a3[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8, 9})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker34(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method34(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 int, a3 int) (int, int, int, int) {
+		s := a1 + a2 + a3
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8, 9, 10})
+	gsmockassert.Equal(t, d1.Values(), []int{7})
+	gsmockassert.Equal(t, d2.Values(), []int{8})
+	gsmockassert.Equal(t, d3.Values(), []int{9})
+	gsmockassert.Equal(t, d4.Values(), []int{10})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method34(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method34(nil, fn, r).WhenArgs(1, 2, 3).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method34(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method34(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method34(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method34(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int) (int, int, int, int) {
+		s := 1 + a2 + a3
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8, 9, 10})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker34(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 ...int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod34(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 int, a3 []int) (int, int, int, int) {
+		s := a1 + a2 + a3[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8, 9, 10})
+	gsmockassert.Equal(t, d1.Values(), []int{7})
+	gsmockassert.Equal(t, d2.Values(), []int{8})
+	gsmockassert.Equal(t, d3.Values(), []int{9})
+	gsmockassert.Equal(t, d4.Values(), []int{10})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod34(nil, fn, r).
+		When(func(a1 int, a2 int, a3 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod34(nil, fn, r).WhenArgs(1, 2, []int{3}).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod34(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod34(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), [][]int{{3}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod34(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod34(nil, fn, r).Bind(1).Handle(func(a2 int, a3 []int) (int, int, int, int) {
+		s := 1 + a2 + a3[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, []int{3})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{7, 8, 9, 10})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, []int{3})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker40(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.Method40(nil, fn, r)
+	gsmock.Method40(nil, fn, r).Handle(func(a1 int, a2 int, a3 int, a4 int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method40(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method40(nil, fn, r).WhenArgs(1, 2, 3, 4).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method40(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method40(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method40(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker40(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 ...int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.VarMethod40(nil, fn, r)
+	gsmock.VarMethod40(nil, fn, r).Handle(func(a1 int, a2 int, a3 int, a4 []int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod40(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 []int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod40(nil, fn, r).WhenArgs(1, 2, 3, []int{4}).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod40(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod40(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), [][]int{{4}})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod40(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 []int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker41(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method41(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int) int {
+		s := a1 + a2 + a3 + a4
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11})
+	gsmockassert.Equal(t, d1.Values(), []int{11})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method41(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method41(nil, fn, r).WhenArgs(1, 2, 3, 4).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method41(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method41(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method41(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method41(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int) int {
+		s := 1 + a2 + a3 + a4
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker41(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 ...int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod41(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 []int) int {
+		s := a1 + a2 + a3 + a4[0]
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11})
+	gsmockassert.Equal(t, d1.Values(), []int{11})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod41(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 []int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod41(nil, fn, r).WhenArgs(1, 2, 3, []int{4}).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod41(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod41(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), [][]int{{4}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod41(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod41(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 []int) int {
+		s := 1 + a2 + a3 + a4[0]
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker42(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method42(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int) (int, int) {
+		s := a1 + a2 + a3 + a4
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12})
+	gsmockassert.Equal(t, d1.Values(), []int{11})
+	gsmockassert.Equal(t, d2.Values(), []int{12})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method42(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method42(nil, fn, r).WhenArgs(1, 2, 3, 4).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method42(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method42(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method42(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method42(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int) (int, int) {
+		s := 1 + a2 + a3 + a4
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker42(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 ...int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod42(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 []int) (int, int) {
+		s := a1 + a2 + a3 + a4[0]
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12})
+	gsmockassert.Equal(t, d1.Values(), []int{11})
+	gsmockassert.Equal(t, d2.Values(), []int{12})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod42(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 []int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod42(nil, fn, r).WhenArgs(1, 2, 3, []int{4}).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod42(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod42(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), [][]int{{4}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod42(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod42(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 []int) (int, int) {
+		s := 1 + a2 + a3 + a4[0]
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker43(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method43(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int) (int, int, int) {
+		s := a1 + a2 + a3 + a4
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12, 13})
+	gsmockassert.Equal(t, d1.Values(), []int{11})
+	gsmockassert.Equal(t, d2.Values(), []int{12})
+	gsmockassert.Equal(t, d3.Values(), []int{13})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method43(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method43(nil, fn, r).WhenArgs(1, 2, 3, 4).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method43(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method43(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method43(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method43(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int) (int, int, int) {
+		s := 1 + a2 + a3 + a4
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12, 13})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker43(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 ...int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod43(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 []int) (int, int, int) {
+		s := a1 + a2 + a3 + a4[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12, 13})
+	gsmockassert.Equal(t, d1.Values(), []int{11})
+	gsmockassert.Equal(t, d2.Values(), []int{12})
+	gsmockassert.Equal(t, d3.Values(), []int{13})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod43(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod43(nil, fn, r).WhenArgs(1, 2, 3, []int{4}).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod43(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod43(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), [][]int{{4}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod43(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod43(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 []int) (int, int, int) {
+		s := 1 + a2 + a3 + a4[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12, 13})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker44(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method44(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int) (int, int, int, int) {
+		s := a1 + a2 + a3 + a4
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12, 13, 14})
+	gsmockassert.Equal(t, d1.Values(), []int{11})
+	gsmockassert.Equal(t, d2.Values(), []int{12})
+	gsmockassert.Equal(t, d3.Values(), []int{13})
+	gsmockassert.Equal(t, d4.Values(), []int{14})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method44(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method44(nil, fn, r).WhenArgs(1, 2, 3, 4).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method44(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method44(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method44(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method44(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int) (int, int, int, int) {
+		s := 1 + a2 + a3 + a4
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12, 13, 14})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker44(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 ...int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod44(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 []int) (int, int, int, int) {
+		s := a1 + a2 + a3 + a4[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12, 13, 14})
+	gsmockassert.Equal(t, d1.Values(), []int{11})
+	gsmockassert.Equal(t, d2.Values(), []int{12})
+	gsmockassert.Equal(t, d3.Values(), []int{13})
+	gsmockassert.Equal(t, d4.Values(), []int{14})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod44(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod44(nil, fn, r).WhenArgs(1, 2, 3, []int{4}).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod44(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod44(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), [][]int{{4}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod44(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod44(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 []int) (int, int, int, int) {
+		s := 1 + a2 + a3 + a4[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{11, 12, 13, 14})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, []int{4})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker50(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.Method50(nil, fn, r)
+	gsmock.Method50(nil, fn, r).Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method50(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method50(nil, fn, r).WhenArgs(1, 2, 3, 4, 5).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method50(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method50(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method50(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker50(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 ...int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.VarMethod50(nil, fn, r)
+	gsmock.VarMethod50(nil, fn, r).Handle(func(a1 int, a2 int, a3 int, a4 int, a5 []int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod50(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 []int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod50(nil, fn, r).WhenArgs(1, 2, 3, 4, []int{5}).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod50(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod50(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), [][]int{{5}})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod50(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 []int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker51(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method51(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int) int {
+		s := a1 + a2 + a3 + a4 + a5
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16})
+	gsmockassert.Equal(t, d1.Values(), []int{16})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method51(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method51(nil, fn, r).WhenArgs(1, 2, 3, 4, 5).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method51(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method51(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method51(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method51(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int) int {
+		s := 1 + a2 + a3 + a4 + a5
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker51(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 ...int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod51(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 []int) int {
+		s := a1 + a2 + a3 + a4 + a5[0]
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16})
+	gsmockassert.Equal(t, d1.Values(), []int{16})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod51(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 []int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod51(nil, fn, r).WhenArgs(1, 2, 3, 4, []int{5}).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod51(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod51(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), [][]int{{5}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod51(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod51(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 []int) int {
+		s := 1 + a2 + a3 + a4 + a5[0]
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker52(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method52(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int) (int, int) {
+		s := a1 + a2 + a3 + a4 + a5
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17})
+	gsmockassert.Equal(t, d1.Values(), []int{16})
+	gsmockassert.Equal(t, d2.Values(), []int{17})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method52(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method52(nil, fn, r).WhenArgs(1, 2, 3, 4, 5).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method52(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method52(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method52(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method52(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int) (int, int) {
+		s := 1 + a2 + a3 + a4 + a5
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker52(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 ...int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod52(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 []int) (int, int) {
+		s := a1 + a2 + a3 + a4 + a5[0]
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17})
+	gsmockassert.Equal(t, d1.Values(), []int{16})
+	gsmockassert.Equal(t, d2.Values(), []int{17})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod52(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 []int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod52(nil, fn, r).WhenArgs(1, 2, 3, 4, []int{5}).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod52(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod52(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), [][]int{{5}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod52(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod52(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 []int) (int, int) {
+		s := 1 + a2 + a3 + a4 + a5[0]
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker53(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method53(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int) (int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17, 18})
+	gsmockassert.Equal(t, d1.Values(), []int{16})
+	gsmockassert.Equal(t, d2.Values(), []int{17})
+	gsmockassert.Equal(t, d3.Values(), []int{18})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method53(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method53(nil, fn, r).WhenArgs(1, 2, 3, 4, 5).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method53(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method53(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method53(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method53(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int) (int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17, 18})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker53(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 ...int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod53(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 []int) (int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17, 18})
+	gsmockassert.Equal(t, d1.Values(), []int{16})
+	gsmockassert.Equal(t, d2.Values(), []int{17})
+	gsmockassert.Equal(t, d3.Values(), []int{18})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod53(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod53(nil, fn, r).WhenArgs(1, 2, 3, 4, []int{5}).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod53(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod53(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), [][]int{{5}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod53(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod53(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 []int) (int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17, 18})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker54(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method54(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int) (int, int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17, 18, 19})
+	gsmockassert.Equal(t, d1.Values(), []int{16})
+	gsmockassert.Equal(t, d2.Values(), []int{17})
+	gsmockassert.Equal(t, d3.Values(), []int{18})
+	gsmockassert.Equal(t, d4.Values(), []int{19})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method54(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method54(nil, fn, r).WhenArgs(1, 2, 3, 4, 5).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method54(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method54(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method54(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method54(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int) (int, int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17, 18, 19})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker54(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 ...int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod54(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 []int) (int, int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17, 18, 19})
+	gsmockassert.Equal(t, d1.Values(), []int{16})
+	gsmockassert.Equal(t, d2.Values(), []int{17})
+	gsmockassert.Equal(t, d3.Values(), []int{18})
+	gsmockassert.Equal(t, d4.Values(), []int{19})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod54(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod54(nil, fn, r).WhenArgs(1, 2, 3, 4, []int{5}).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod54(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod54(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), [][]int{{5}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod54(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod54(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 []int) (int, int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{16, 17, 18, 19})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, []int{5})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker60(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.Method60(nil, fn, r)
+	gsmock.Method60(nil, fn, r).Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method60(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method60(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method60(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method60(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method60(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker60(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 ...int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.VarMethod60(nil, fn, r)
+	gsmock.VarMethod60(nil, fn, r).Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod60(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod60(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, []int{6}).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod60(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod60(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), [][]int{{6}})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod60(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 []int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker61(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method61(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) int {
+		s := a1 + a2 + a3 + a4 + a5 + a6
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22})
+	gsmockassert.Equal(t, d1.Values(), []int{22})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method61(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method61(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method61(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method61(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method61(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method61(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int) int {
+		s := 1 + a2 + a3 + a4 + a5 + a6
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker61(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 ...int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod61(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) int {
+		s := a1 + a2 + a3 + a4 + a5 + a6[0]
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22})
+	gsmockassert.Equal(t, d1.Values(), []int{22})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod61(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod61(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, []int{6}).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod61(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod61(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), [][]int{{6}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod61(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod61(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 []int) int {
+		s := 1 + a2 + a3 + a4 + a5 + a6[0]
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker62(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method62(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) (int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23})
+	gsmockassert.Equal(t, d1.Values(), []int{22})
+	gsmockassert.Equal(t, d2.Values(), []int{23})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method62(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method62(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method62(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method62(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method62(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method62(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int) (int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker62(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 ...int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod62(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) (int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6[0]
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23})
+	gsmockassert.Equal(t, d1.Values(), []int{22})
+	gsmockassert.Equal(t, d2.Values(), []int{23})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod62(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod62(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, []int{6}).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod62(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod62(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), [][]int{{6}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod62(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod62(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 []int) (int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6[0]
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker63(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method63(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) (int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23, 24})
+	gsmockassert.Equal(t, d1.Values(), []int{22})
+	gsmockassert.Equal(t, d2.Values(), []int{23})
+	gsmockassert.Equal(t, d3.Values(), []int{24})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method63(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method63(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method63(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method63(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method63(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method63(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int) (int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23, 24})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker63(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 ...int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod63(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) (int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23, 24})
+	gsmockassert.Equal(t, d1.Values(), []int{22})
+	gsmockassert.Equal(t, d2.Values(), []int{23})
+	gsmockassert.Equal(t, d3.Values(), []int{24})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod63(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod63(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, []int{6}).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod63(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod63(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), [][]int{{6}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod63(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod63(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 []int) (int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23, 24})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker64(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method64(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) (int, int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23, 24, 25})
+	gsmockassert.Equal(t, d1.Values(), []int{22})
+	gsmockassert.Equal(t, d2.Values(), []int{23})
+	gsmockassert.Equal(t, d3.Values(), []int{24})
+	gsmockassert.Equal(t, d4.Values(), []int{25})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method64(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method64(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method64(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method64(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method64(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method64(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int) (int, int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23, 24, 25})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker64(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 ...int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod64(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) (int, int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23, 24, 25})
+	gsmockassert.Equal(t, d1.Values(), []int{22})
+	gsmockassert.Equal(t, d2.Values(), []int{23})
+	gsmockassert.Equal(t, d3.Values(), []int{24})
+	gsmockassert.Equal(t, d4.Values(), []int{25})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod64(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod64(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, []int{6}).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod64(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod64(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), [][]int{{6}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod64(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod64(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 []int) (int, int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{22, 23, 24, 25})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, []int{6})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker70(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.Method70(nil, fn, r)
+	gsmock.Method70(nil, fn, r).Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method70(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method70(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6, 7).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method70(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method70(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	c7 := m.CaptureArg7()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+	gsmockassert.Equal(t, c7.Values(), []int{7})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method70(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker70(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 ...int) {}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	gsmock.VarMethod70(nil, fn, r)
+	gsmock.VarMethod70(nil, fn, r).Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) {})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod70(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) bool { return a1 == 1 }).
+		Return(func() {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod70(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6, []int{7}).ReturnValue()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod70(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func(){
+			true: func() {},
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod70(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	c7 := m.CaptureArg7()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+	gsmockassert.Equal(t, c7.Values(), [][]int{{7}})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod70(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) {})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker71(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method71(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) int {
+		s := a1 + a2 + a3 + a4 + a5 + a6 + a7
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29})
+	gsmockassert.Equal(t, d1.Values(), []int{29})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method71(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method71(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6, 7).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method71(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method71(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	c7 := m.CaptureArg7()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+	gsmockassert.Equal(t, c7.Values(), []int{7})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method71(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method71(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) int {
+		s := 1 + a2 + a3 + a4 + a5 + a6 + a7
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker71(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 ...int) int { return 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod71(nil, fn, r)
+	d1 := h.CaptureResult1()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) int {
+		s := a1 + a2 + a3 + a4 + a5 + a6 + a7[0]
+		return s + 1
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29})
+	gsmockassert.Equal(t, d1.Values(), []int{29})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod71(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) bool { return a1 == 1 }).
+		Return(func() int { return 1 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod71(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6, []int{7}).ReturnValue(1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod71(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() int{
+			true: func() int { return 1 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod71(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	c7 := m.CaptureArg7()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+	gsmockassert.Equal(t, c7.Values(), [][]int{{7}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod71(nil, fn, r).ReturnFieldOfArg(1, "", 1)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod71(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) int {
+		s := 1 + a2 + a3 + a4 + a5 + a6 + a7[0]
+		return s + 1
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker72(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method72(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) (int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6 + a7
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30})
+	gsmockassert.Equal(t, d1.Values(), []int{29})
+	gsmockassert.Equal(t, d2.Values(), []int{30})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method72(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method72(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6, 7).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method72(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method72(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	c7 := m.CaptureArg7()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+	gsmockassert.Equal(t, c7.Values(), []int{7})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method72(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method72(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) (int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6 + a7
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker72(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 ...int) (int, int) { return 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod72(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) (int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6 + a7[0]
+		return s + 1, s + 2
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30})
+	gsmockassert.Equal(t, d1.Values(), []int{29})
+	gsmockassert.Equal(t, d2.Values(), []int{30})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod72(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) bool { return a1 == 1 }).
+		Return(func() (int, int) { return 1, 2 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod72(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6, []int{7}).ReturnValue(1, 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod72(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int){
+			true: func() (int, int) { return 1, 2 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod72(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	c7 := m.CaptureArg7()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+	gsmockassert.Equal(t, c7.Values(), [][]int{{7}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod72(nil, fn, r).ReturnFieldOfArg(1, "", 2)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod72(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) (int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6 + a7[0]
+		return s + 1, s + 2
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker73(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method73(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) (int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6 + a7
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30, 31})
+	gsmockassert.Equal(t, d1.Values(), []int{29})
+	gsmockassert.Equal(t, d2.Values(), []int{30})
+	gsmockassert.Equal(t, d3.Values(), []int{31})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method73(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method73(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6, 7).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method73(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method73(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	c7 := m.CaptureArg7()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+	gsmockassert.Equal(t, c7.Values(), []int{7})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method73(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method73(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) (int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6 + a7
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30, 31})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker73(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 ...int) (int, int, int) { return 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod73(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) (int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6 + a7[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30, 31})
+	gsmockassert.Equal(t, d1.Values(), []int{29})
+	gsmockassert.Equal(t, d2.Values(), []int{30})
+	gsmockassert.Equal(t, d3.Values(), []int{31})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod73(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int) { return 1, 2, 3 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod73(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6, []int{7}).ReturnValue(1, 2, 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod73(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int){
+			true: func() (int, int, int) { return 1, 2, 3 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod73(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	c7 := m.CaptureArg7()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+	gsmockassert.Equal(t, c7.Values(), [][]int{{7}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod73(nil, fn, r).ReturnFieldOfArg(1, "", 3)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod73(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) (int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6 + a7[0]
+		return s + 1, s + 2, s + 3
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30, 31})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestMocker74(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) (int, int, int, int) { return 0, 0, 0, 0 }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.Method74(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) (int, int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6 + a7
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30, 31, 32})
+	gsmockassert.Equal(t, d1.Values(), []int{29})
+	gsmockassert.Equal(t, d2.Values(), []int{30})
+	gsmockassert.Equal(t, d3.Values(), []int{31})
+	gsmockassert.Equal(t, d4.Values(), []int{32})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.Method74(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.Method74(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6, 7).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.Method74(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.Method74(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	c7 := m.CaptureArg7()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+	gsmockassert.Equal(t, c7.Values(), []int{7})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.Method74(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.Method74(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int, a7 int) (int, int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6 + a7
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30, 31, 32})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, 7)
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestVarMocker74(t *testing.T) {
+	fn := func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 ...int) (int, int, int, int) {
+		return 0, 0, 0, 0
+	}
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	h := gsmock.VarMethod74(nil, fn, r)
+	d1 := h.CaptureResult1()
+	d2 := h.CaptureResult2()
+	d3 := h.CaptureResult3()
+	d4 := h.CaptureResult4()
+	h.Handle(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) (int, int, int, int) {
+		s := a1 + a2 + a3 + a4 + a5 + a6 + a7[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok := gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30, 31, 32})
+	gsmockassert.Equal(t, d1.Values(), []int{29})
+	gsmockassert.Equal(t, d2.Values(), []int{30})
+	gsmockassert.Equal(t, d3.Values(), []int{31})
+	gsmockassert.Equal(t, d4.Values(), []int{32})
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.VarMethod74(nil, fn, r).
+		When(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) bool { return a1 == 1 }).
+		Return(func() (int, int, int, int) { return 1, 2, 3, 4 })
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenArgs && ReturnValue - should return the given values
+	r.Reset()
+	gsmock.VarMethod74(nil, fn, r).WhenArgs(1, 2, 3, 4, 5, 6, []int{7}).ReturnValue(1, 2, 3, 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.VarMethod74(nil, fn, r).
+		WhenKey(func(a1 int, a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) any { return a1 == 1 }).
+		ReturnForKey(map[any]func() (int, int, int, int){
+			true: func() (int, int, int, int) { return 1, 2, 3, 4 },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1, 2, 3, 4})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	m := gsmock.VarMethod74(nil, fn, r)
+	c1 := m.CaptureArg1()
+	c2 := m.CaptureArg2()
+	c3 := m.CaptureArg3()
+	c4 := m.CaptureArg4()
+	c5 := m.CaptureArg5()
+	c6 := m.CaptureArg6()
+	c7 := m.CaptureArg7()
+	m.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 0})
+	gsmockassert.Equal(t, c1.Values(), []int{1})
+	gsmockassert.Equal(t, c2.Values(), []int{2})
+	gsmockassert.Equal(t, c3.Values(), []int{3})
+	gsmockassert.Equal(t, c4.Values(), []int{4})
+	gsmockassert.Equal(t, c5.Values(), []int{5})
+	gsmockassert.Equal(t, c6.Values(), []int{6})
+	gsmockassert.Equal(t, c7.Values(), [][]int{{7}})
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.VarMethod74(nil, fn, r).ReturnFieldOfArg(1, "", 4)
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0, 0, 0, 1})
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.VarMethod74(nil, fn, r).Bind(1).Handle(func(a2 int, a3 int, a4 int, a5 int, a6 int, a7 []int) (int, int, int, int) {
+		s := 1 + a2 + a3 + a4 + a5 + a6 + a7[0]
+		return s + 1, s + 2, s + 3, s + 4
+	})
+	ret, ok = gsmock.Invoke(r, nil, fn, 1, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{29, 30, 31, 32})
+	_, ok = gsmock.Invoke(r, nil, fn, 0, 2, 3, 4, 5, 6, []int{7})
+	gsmockassert.Equal(t, ok, false)
+}
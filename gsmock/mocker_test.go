@@ -23,7 +23,7 @@ import (
 	"testing"
 
 	"github.com/go-spring/gs-mock/gsmock"
-	"github.com/go-spring/gs-mock/internal/assert"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
 )
 
 type Request struct {
@@ -46,8 +46,8 @@ func TestFuncMock(t *testing.T) {
 	// Test case: Unmocked - should return default value
 	{
 		resp, err := Get(t.Context(), &Request{})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "9:xxx")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "9:xxx")
 	}
 
 	// Test case: When && Return - should return mocked value when condition is met
@@ -62,8 +62,8 @@ func TestFuncMock(t *testing.T) {
 			})
 
 		resp, err := Get(ctx, &Request{Value: 5})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "1:abc")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "1:abc")
 
 		gsmock.Func22(Get, r).
 			When(func(ctx context.Context, req *Request) bool {
@@ -74,12 +74,12 @@ func TestFuncMock(t *testing.T) {
 			})
 
 		resp, err = Get(ctx, &Request{Value: 10})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "3:xyz")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "3:xyz")
 
 		resp, err = Get(ctx, &Request{Value: 15})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "9:xxx")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "9:xxx")
 	}
 
 	// Test case: Handle - should handle all calls with the provided function
@@ -91,18 +91,16 @@ func TestFuncMock(t *testing.T) {
 			})
 
 		resp, err := Get(ctx, &Request{Value: 5})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "6:xyz")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "6:xyz")
 	}
 
-	// Test case: Invalid Handle - should fall back to default implementation when handle is nil
+	// Test case: Invalid Handle - should panic with the call site when handle is nil
 	{
 		r.Reset()
-		gsmock.Func22(Get, r).Handle(nil)
-
-		resp, err := Get(ctx, &Request{})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "9:xxx")
+		gsmockassert.Panic(t, func() {
+			gsmock.Func22(Get, r).Handle(nil)
+		}, `nil function passed to Handle of the mocker of .*\.Get at .*mocker_test\.go:\d+`)
 	}
 
 	// Test case: Mock that returns an error
@@ -117,8 +115,8 @@ func TestFuncMock(t *testing.T) {
 			})
 
 		resp, err := Get(ctx, &Request{Value: 7})
-		assert.Equal(t, err, context.DeadlineExceeded)
-		assert.Nil(t, resp)
+		gsmockassert.Equal(t, err, context.DeadlineExceeded)
+		gsmockassert.Nil(t, resp)
 	}
 }
 
@@ -142,8 +140,8 @@ func TestMethodMock(t *testing.T) {
 	// Test case: Unmocked - should return default value
 	{
 		resp, err := c1.Get(t.Context(), &Request{})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "9:xxx")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "9:xxx")
 	}
 
 	// Test case: When && Return - should return mocked value when condition is met
@@ -166,12 +164,12 @@ func TestMethodMock(t *testing.T) {
 			})
 
 		resp, err := c1.Get(ctx, &Request{Value: 5})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "1:abc")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "1:abc")
 
 		resp, err = c2.Get(ctx, &Request{Value: 5})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "3:xyz")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "3:xyz")
 	}
 
 	// Test case: Handle - should handle all calls with the provided function
@@ -183,18 +181,16 @@ func TestMethodMock(t *testing.T) {
 			})
 
 		resp, err := c1.Get(ctx, &Request{Value: 5})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "6:xyz")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "6:xyz")
 	}
 
-	// Test case: Invalid Handle - should fall back to default implementation when handle is nil
+	// Test case: Invalid Handle - should panic with the call site when handle is nil
 	{
 		r.Reset()
-		gsmock.Func32((*Client).Get, r).Handle(nil)
-
-		resp, err := c1.Get(ctx, &Request{})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "9:xxx")
+		gsmockassert.Panic(t, func() {
+			gsmock.Func32((*Client).Get, r).Handle(nil)
+		}, `nil function passed to Handle of the mocker of .*\(\*Client\)\.Get at .*mocker_test\.go:\d+`)
 	}
 
 	// Test case: Method mock that returns an error
@@ -209,8 +205,8 @@ func TestMethodMock(t *testing.T) {
 			})
 
 		resp, err := c1.Get(ctx, &Request{Value: 5})
-		assert.Equal(t, err, context.Canceled)
-		assert.Nil(t, resp)
+		gsmockassert.Equal(t, err, context.Canceled)
+		gsmockassert.Nil(t, resp)
 	}
 }
 
@@ -231,7 +227,8 @@ func NewMockClient(r *gsmock.Manager) *MockClient {
 
 // Query mocks the Query method by invoking a registered mock implementation.
 func (c *MockClient) Query(req *Request) (*Response, error) {
-	if ret, ok := gsmock.Invoke(c.r, c, c.Query, req); ok {
+	if ret, ok := gsmock.InvokeBoxed(c.r, c, c.Query, gsmock.Box(req)); ok {
+		defer gsmock.Release(ret)
 		return gsmock.Unbox2[*Response, error](ret)
 	}
 	panic("no mock code matched for MockClient.Query")
@@ -269,14 +266,14 @@ func TestInterfaceMock(t *testing.T) {
 			})
 
 		resp, err := c.Query(&Request{Value: 5})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "1:abc")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "1:abc")
 
 		resp, err = c.Query(&Request{Value: 10})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "3:xyz")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "3:xyz")
 
-		assert.Panic(t, func() {
+		gsmockassert.Panic(t, func() {
 			_, _ = c.Query(&Request{Value: 15})
 		}, "no mock code matched for MockClient.Query")
 	}
@@ -290,21 +287,149 @@ func TestInterfaceMock(t *testing.T) {
 			})
 
 		resp, err := c.Query(&Request{Value: 5})
-		assert.Nil(t, err)
-		assert.Equal(t, resp.Message, "6:xyz")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "6:xyz")
+	}
+
+	// Test case: When && Handle - handler only applies to matching calls
+	{
+		r.Reset()
+		mockClient.MockQuery().
+			When(func(req *Request) bool {
+				return req.Value > 0
+			}).
+			Handle(func(req *Request) (resp *Response, err error) {
+				return &Response{Message: fmt.Sprint("positive:", req.Value)}, nil
+			})
+
+		resp, err := c.Query(&Request{Value: 5})
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "positive:5")
+
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Query(&Request{Value: -1})
+		}, "no mock code matched for MockClient.Query")
 	}
 
-	// Test case: Invalid Handle - should panic when handle is nil and no other mock matches
+	// Test case: ReturnFrom - provider is evaluated on every matched call
 	{
 		r.Reset()
-		mockClient.MockQuery().Handle(nil)
+		n := 0
+		mockClient.MockQuery().
+			ReturnFrom(func() (*Response, error) {
+				n++
+				return &Response{Message: fmt.Sprint("from:", n)}, nil
+			})
 
-		assert.Panic(t, func() {
+		resp, _ := c.Query(&Request{Value: 1})
+		gsmockassert.Equal(t, resp.Message, "from:1")
+		resp, _ = c.Query(&Request{Value: 2})
+		gsmockassert.Equal(t, resp.Message, "from:2")
+	}
+
+	// Test case: ReturnLazy - provider runs once, and only when matched
+	{
+		r.Reset()
+		n := 0
+		mockClient.MockQuery().
+			WhenArgs(&Request{Value: 1}).
+			ReturnLazy(func() (*Response, error) {
+				n++
+				return &Response{Message: "lazy"}, nil
+			})
+		mockClient.MockQuery().ReturnDefault()
+
+		_, _ = c.Query(&Request{Value: 2})
+		gsmockassert.Equal(t, n, 0)
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, _ := c.Query(&Request{Value: 1})
+				gsmockassert.Equal(t, resp.Message, "lazy")
+			}()
+		}
+		wg.Wait()
+		gsmockassert.Equal(t, n, 1)
+	}
+
+	// Test case: Invalid Handle - should panic with the call site when handle is nil
+	{
+		r.Reset()
+		gsmockassert.Panic(t, func() {
+			mockClient.MockQuery().Handle(nil)
+		}, `nil function passed to Handle of the mocker of .*Query.* at .*mocker_test\.go:\d+`)
+		gsmockassert.Panic(t, func() {
+			mockClient.MockQuery().ReturnFrom(nil)
+		}, `nil function passed to ReturnFrom of the mocker of .*Query.* at .*mocker_test\.go:\d+`)
+
+		gsmockassert.Panic(t, func() {
 			_, _ = c.Query(&Request{})
 		}, "no mock code matched for MockClient.Query")
 	}
 }
 
+// Find is a sample function whose leading arguments are bound in tests.
+func Find(ctx context.Context, tenant string, id int) (string, error) {
+	return "", nil
+}
+
+func TestBind(t *testing.T) {
+	r := gsmock.NewManager()
+	ctx := t.Context()
+	find := func(ctx context.Context, tenant string, id int) (string, error) {
+		if ret, ok := gsmock.Invoke(r, nil, Find, ctx, tenant, id); ok {
+			return gsmock.Unbox2[string, error](ret)
+		}
+		return "unmatched", nil
+	}
+
+	m := gsmock.Method32(nil, Find, r).Bind(ctx).Bind("acme")
+	m.Handle(func(id int) (string, error) {
+		return fmt.Sprint("acme:", id), nil
+	})
+	gsmock.Method32(nil, Find, r).Bind(ctx).Bind("other").
+		When(func(id int) bool { return id > 10 }).
+		ReturnValue("other", nil)
+	ids := gsmock.Method32(nil, Find, r).Bind(ctx).Bind("other").CaptureArg1()
+	gsmock.Method32(nil, Find, r).Bind(ctx).Bind("other").ReturnDefault()
+
+	v, _ := find(ctx, "acme", 1)
+	gsmockassert.Equal(t, v, "acme:1")
+	v, _ = find(ctx, "other", 11)
+	gsmockassert.Equal(t, v, "other")
+	v, _ = find(ctx, "other", 2)
+	gsmockassert.Equal(t, v, "")
+	v, _ = find(context.Background(), "acme", 1)
+	gsmockassert.Equal(t, v, "unmatched")
+
+	// a mocker without Return or Handle doesn't match
+	gsmockassert.Equal(t, ids.Len(), 0)
+
+	// bound mockers apply to the same function
+	gsmockassert.Nil(t, m.WaitForCalls(ctx, 4))
+	gsmock.Method32(nil, Find, r).Bind(ctx).Never()
+	gsmockassert.Panic(t, func() {
+		_, _ = find(ctx, "none", 1)
+	}, `forbidden call to .*\.Find with params \(none, 1\)`)
+}
+
+func TestDeprecatedInvoker(t *testing.T) {
+	r := gsmock.NewManager()
+	m := gsmock.Method32(nil, Find, r)
+	m.When(func(_ context.Context, tenant string, _ int) bool { return tenant == "acme" }).ReturnValue("acme", nil)
+
+	// the Invoker types kept for compatibility dispatch like the registered one
+	var i gsmock.Invoker = &gsmock.Invoker32[context.Context, string, int, string, error]{Mocker32: m}
+	ret, ok := i.Invoke([]any{t.Context(), "acme", 1})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{"acme", nil})
+	_, ok = i.Invoke([]any{t.Context(), "other", 1})
+	gsmockassert.Equal(t, ok, false)
+}
+
 func TestConcurrentMock(t *testing.T) {
 	r := gsmock.NewManager()
 
@@ -383,7 +508,7 @@ func TestConcurrentDifferentManagers(t *testing.T) {
 				})
 
 			resp, err := c.Query(&Request{Value: k})
-			assert.Nil(t, err)
+			gsmockassert.Nil(t, err)
 			if resp == nil {
 				t.Errorf("Expected non-nil response for manager %d", k)
 			}
@@ -0,0 +1,42 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+)
+
+// setOnCall restricts the mocker to the call n of its function, 1-based.
+// It panics if n is not positive, or if the mocker was returned by Bind,
+// whose calls are those of the mocker Bind was called on.
+func (m *mockerBase) setOnCall(n int) {
+	if n < 1 {
+		panic(fmt.Sprintf("gsmock: OnCall(%d) of the mocker of %s: calls are numbered from 1", n, funcName(m.k)))
+	}
+	if m.fn == nil {
+		panic(fmt.Sprintf("gsmock: OnCall of a mocker of %s returned by Bind; call it on the mocker Bind was called on", funcName(m.k)))
+	}
+	m.onCall = n
+}
+
+// skipsCall reports whether the Invoker i doesn't apply to the call n of
+// its function, being restricted by OnCall to another call. The Invoker
+// is then skipped without evaluating its predicate.
+func skipsCall(i Invoker, n int) bool {
+	x, ok := i.(*invoker)
+	return ok && x.onCall != 0 && x.onCall != n
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestOnCall(t *testing.T) {
+	r := gsmock.NewManager()
+	c := NewMockClient(r)
+
+	errUnavailable := errors.New("unavailable")
+	c.MockQuery().OnCall(3).ReturnValue(nil, errUnavailable)
+	c.MockQuery().
+		OnCall(4).
+		When(func(req *Request) bool { return req.Value > 0 }).
+		ReturnValue(&Response{Message: "fourth"}, nil)
+	c.MockQuery().ReturnValue(&Response{Message: "ok"}, nil)
+
+	// The third call fails whatever its arguments
+	for i := 1; i <= 3; i++ {
+		resp, err := c.Query(&Request{Value: i})
+		if i < 3 {
+			gsmockassert.Nil(t, err)
+			gsmockassert.Equal(t, resp.Message, "ok")
+		} else {
+			gsmockassert.Equal(t, err, errUnavailable)
+		}
+	}
+
+	// Predicates still apply to the selected call
+	resp, _ := c.Query(&Request{Value: 0})
+	gsmockassert.Equal(t, resp.Message, "ok")
+	resp, _ = c.Query(&Request{Value: 1})
+	gsmockassert.Equal(t, resp.Message, "ok")
+
+	// Calls are counted per receiver, and again after Reset
+	c2 := NewMockClient(r)
+	c2.MockQuery().OnCall(1).ReturnValue(&Response{Message: "first"}, nil)
+	resp, _ = c2.Query(&Request{})
+	gsmockassert.Equal(t, resp.Message, "first")
+
+	r.Reset()
+	c.MockQuery().OnCall(1).ReturnValue(&Response{Message: "first"}, nil)
+	resp, _ = c.Query(&Request{})
+	gsmockassert.Equal(t, resp.Message, "first")
+	gsmockassert.Panic(t, func() {
+		_, _ = c.Query(&Request{})
+	}, "no mock code matched for MockClient.Query")
+
+	gsmockassert.Panic(t, func() {
+		c.MockQuery().OnCall(0)
+	}, `gsmock: OnCall\(0\) of the mocker of .*Query: calls are numbered from 1`)
+	gsmockassert.Panic(t, func() {
+		gsmock.Method12(nil, func(int) (int, error) { return 0, nil }, r).Bind(1).OnCall(1)
+	}, "gsmock: OnCall of a mocker of .* returned by Bind")
+}
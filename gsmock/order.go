@@ -0,0 +1,63 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"cmp"
+	"slices"
+)
+
+// EnableDeterministicOrder makes the Manager report functions in the order
+// they were first seen, i.e. mocked or called, instead of by name. Names are
+// not unique, e.g. for the methods of two mocks of the same type, and the
+// names of closures vary across Go versions, so this keeps golden-file
+// comparisons of transcripts and failure messages stable. Calling it again
+// has no effect.
+func (r *Manager) EnableDeterministicOrder() {
+	if r.order == nil {
+		r.order = make(map[funcKey]int)
+	}
+}
+
+// seen records the first time k is mocked or called,
+// if deterministic order is enabled.
+func (r *Manager) seen(k funcKey) {
+	if r.order == nil {
+		return
+	}
+	r.orderMux.Lock()
+	defer r.orderMux.Unlock()
+	if _, ok := r.order[k]; !ok {
+		r.order[k] = len(r.order)
+	}
+}
+
+// sortKeys sorts keys in the order they were first seen if deterministic
+// order is enabled, or else by function name.
+func (r *Manager) sortKeys(keys []funcKey) {
+	if r.order == nil {
+		slices.SortStableFunc(keys, func(a, b funcKey) int {
+			return cmp.Compare(funcName(a), funcName(b))
+		})
+		return
+	}
+	r.orderMux.Lock()
+	defer r.orderMux.Unlock()
+	slices.SortFunc(keys, func(a, b funcKey) int {
+		return cmp.Compare(r.order[a], r.order[b])
+	})
+}
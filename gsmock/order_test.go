@@ -0,0 +1,49 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestEnableDeterministicOrder(t *testing.T) {
+	for range 10 {
+		r := gsmock.NewManager()
+		r.EnableDeterministicOrder()
+		r.EnableRecording(gsmock.RetentionPolicy{})
+
+		// The methods of both mocks have the same name
+		c1, c2 := NewMockClient(r), NewMockClient(r)
+		c2.MockQuery().ReturnValue(&Response{Message: "second"}, nil)
+		c1.MockQuery().ReturnValue(&Response{Message: "first"}, nil)
+
+		_, _ = c1.Query(&Request{Value: 1})
+		_, _ = c2.Query(&Request{Value: 2})
+
+		var buf bytes.Buffer
+		gsmockassert.Nil(t, r.WriteTranscript(&buf))
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		gsmockassert.Equal(t, len(lines), 2)
+		gsmockassert.Match(t, lines[0], `Message:\\"second`)
+		gsmockassert.Match(t, lines[1], `Message:\\"first`)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"slices"
+)
+
+// Override runs fn, which registers mocks with r, and makes them override
+// the mocks already registered for the same functions until the end of
+// the test t: they take precedence over the earlier ones, and are removed
+// when t and its subtests complete. It keeps the overrides of nested
+// subtests from leaking into the tests that follow them:
+//
+//	s.MockGet().ReturnValue(defaultUser, nil)
+//	t.Run("not found", func(t *testing.T) {
+//		gsmock.Override(t, r, func() {
+//			s.MockGet().ReturnValue(nil, ErrNotFound)
+//		})
+//		...
+//	})
+func Override(t TB, r *Manager, fn func()) {
+	before := make(map[funcKey]int, len(r.mockers))
+	for k, mockers := range r.mockers {
+		before[k] = len(mockers)
+	}
+	fn()
+
+	added := make(map[funcKey][]Invoker)
+	for k, mockers := range r.mockers {
+		n := before[k]
+		if len(mockers) <= n {
+			continue
+		}
+		added[k] = slices.Clone(mockers[n:])
+		r.mockers[k] = append(slices.Clone(mockers[n:]), mockers[:n]...)
+	}
+
+	t.Cleanup(func() {
+		for k, overrides := range added {
+			r.mockers[k] = slices.DeleteFunc(r.mockers[k], func(i Invoker) bool {
+				return slices.Contains(overrides, i)
+			})
+		}
+	})
+}
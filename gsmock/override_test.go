@@ -0,0 +1,64 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestOverride(t *testing.T) {
+	r := gsmock.NewManager()
+	c := NewMockClient(r)
+	c.MockQuery().ReturnValue(&Response{Message: "default"}, nil)
+
+	query := func() string {
+		resp, err := c.Query(&Request{})
+		gsmockassert.Nil(t, err)
+		return resp.Message
+	}
+
+	t.Run("outer", func(t *testing.T) {
+		gsmock.Override(t, r, func() {
+			c.MockQuery().ReturnValue(&Response{Message: "outer"}, nil)
+		})
+		gsmockassert.Equal(t, query(), "outer")
+
+		t.Run("inner", func(t *testing.T) {
+			gsmock.Override(t, r, func() {
+				c.MockQuery().
+					When(func(req *Request) bool { return req.Value == 0 }).
+					ReturnValue(&Response{Message: "inner"}, nil)
+			})
+			gsmockassert.Equal(t, query(), "inner")
+		})
+		gsmockassert.Equal(t, query(), "outer")
+	})
+	gsmockassert.Equal(t, query(), "default")
+
+	t.Run("cleanup", func(t *testing.T) {
+		ft := &fakeT{}
+		gsmock.Override(ft, r, func() {
+			c.MockQuery().ReturnValue(&Response{Message: "fake"}, nil)
+		})
+		gsmockassert.Equal(t, query(), "fake")
+		ft.finish()
+		gsmockassert.Equal(t, query(), "default")
+	})
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Pager serves the pages of methods shaped like
+// List(ctx, cursor C) ([]T, C, error), following the cursors it returns.
+// The zero cursor requests the first page, and the cursors of the next
+// pages encode their indexes, so C must be a string, an integer, or a
+// pointer to one of them.
+type Pager[T, C any] struct {
+	pages    [][]T
+	finalErr error
+}
+
+// NewPager creates a Pager serving pages in sequence. The last page
+// returns a zero cursor, unless finalErr is not nil, in which case the
+// call with the cursor it returns fails with finalErr, e.g. to simulate
+// a failure in the middle of a listing.
+// It panics if C is not supported as a cursor type.
+func NewPager[T, C any](pages [][]T, finalErr error) *Pager[T, C] {
+	t := reflect.TypeFor[C]()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+	default:
+		panic(fmt.Sprintf("gsmock: unsupported page cursor type %s", reflect.TypeFor[C]()))
+	}
+	return &Pager[T, C]{pages: pages, finalErr: finalErr}
+}
+
+// Page returns the page at cursor, and the cursor of the next page.
+// It returns an error for cursors it didn't return.
+func (p *Pager[T, C]) Page(cursor C) ([]T, C, error) {
+	var zero C
+	n := len(p.pages)
+	i, ok := pageIndex(reflect.ValueOf(&cursor).Elem())
+	switch {
+	case ok && i < n-1, ok && i == n-1 && p.finalErr != nil:
+		return p.pages[i], pageCursor[C](i + 1), nil
+	case ok && i == n-1:
+		return p.pages[i], zero, nil
+	case ok && i == n && p.finalErr != nil:
+		return nil, zero, p.finalErr
+	case ok && i == 0: // no pages at all
+		return nil, zero, nil
+	default:
+		return nil, zero, fmt.Errorf("gsmock: unknown page cursor %s", formatValue(cursor))
+	}
+}
+
+// pageIndex returns the page index encoded by the cursor v.
+func pageIndex(v reflect.Value) (int, bool) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return 0, true
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		if v.String() == "" {
+			return 0, true
+		}
+		i, err := strconv.Atoi(v.String())
+		return i, err == nil && i > 0
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int()), v.Int() >= 0
+	default:
+		return int(v.Uint()), true
+	}
+}
+
+// pageCursor returns the cursor encoding the page index i.
+func pageCursor[C any](i int) C {
+	var c C
+	v := reflect.ValueOf(&c).Elem()
+	if v.Kind() == reflect.Pointer {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(strconv.Itoa(i))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(i))
+	default:
+		v.SetUint(uint64(i))
+	}
+	return c
+}
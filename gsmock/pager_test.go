@@ -0,0 +1,82 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestPager(t *testing.T) {
+
+	t.Run("string cursor", func(t *testing.T) {
+		p := gsmock.NewPager[int, string]([][]int{{1, 2}, {3}}, nil)
+		page, next, err := p.Page("")
+		gsmockassert.Equal(t, page, []int{1, 2})
+		gsmockassert.Equal(t, next, "1")
+		gsmockassert.Nil(t, err)
+		page, next, err = p.Page(next)
+		gsmockassert.Equal(t, page, []int{3})
+		gsmockassert.Equal(t, next, "")
+		gsmockassert.Nil(t, err)
+		_, _, err = p.Page("abc")
+		gsmockassert.Match(t, err.Error(), "gsmock: unknown page cursor abc")
+		_, _, err = p.Page("2")
+		gsmockassert.Match(t, err.Error(), "gsmock: unknown page cursor 2")
+	})
+
+	t.Run("pointer cursor", func(t *testing.T) {
+		p := gsmock.NewPager[string, *int64]([][]string{{"a"}, {"b"}}, nil)
+		page, next, err := p.Page(nil)
+		gsmockassert.Equal(t, page, []string{"a"})
+		gsmockassert.Equal(t, *next, int64(1))
+		gsmockassert.Nil(t, err)
+		page, next, err = p.Page(next)
+		gsmockassert.Equal(t, page, []string{"b"})
+		gsmockassert.Nil(t, next)
+		gsmockassert.Nil(t, err)
+	})
+
+	t.Run("final error", func(t *testing.T) {
+		errBroken := errors.New("broken")
+		p := gsmock.NewPager[int, uint]([][]int{{1}}, errBroken)
+		page, next, err := p.Page(0)
+		gsmockassert.Equal(t, page, []int{1})
+		gsmockassert.Equal(t, next, uint(1))
+		gsmockassert.Nil(t, err)
+		page, next, err = p.Page(next)
+		gsmockassert.Nil(t, page)
+		gsmockassert.Equal(t, next, uint(0))
+		gsmockassert.ErrorIs(t, err, errBroken)
+	})
+
+	t.Run("no pages", func(t *testing.T) {
+		page, next, err := gsmock.NewPager[int, string](nil, nil).Page("")
+		gsmockassert.Nil(t, page)
+		gsmockassert.Equal(t, next, "")
+		gsmockassert.Nil(t, err)
+	})
+
+	t.Run("unsupported cursor", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
+			gsmock.NewPager[int, float64](nil, nil)
+		}, `gsmock: unsupported page cursor type float64`)
+	})
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+// PanicError is the value a mocked call panics with when the predicate,
+// handler or return function of the matched mocker panics. It augments
+// the original panic value with the call and the registration site of
+// the mocker, which the stack of a panic in generated code hides.
+type PanicError struct {
+	Func   string // name of the mocked function
+	Params []any  // parameters of the call
+	Site   string // file:line where the mocker was created, if known
+	Value  any    // the original panic value
+	Stack  []byte // stack of the goroutine when it panicked
+}
+
+// Error implements the error interface.
+func (e *PanicError) Error() string {
+	site := e.Site
+	if site == "" {
+		site = "unknown site"
+	}
+	return fmt.Sprintf("gsmock: mock of %s registered at %s panicked with params %s: %v\n%s",
+		e.Func, site, formatParams(e.Params), e.Value, e.Stack)
+}
+
+// Unwrap returns the original panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// recoverPanic is deferred by the Invokers of the mockers. It re-panics with
+// a PanicError describing the call if the mocker's functions panicked.
+func (m *mockerBase) recoverPanic(params []any) {
+	if v := recover(); v != nil {
+		panic(m.panicError(v, params))
+	}
+}
+
+// panicError returns the PanicError describing a call whose functions
+// panicked with v, with the stack of the panicking goroutine. Panics already
+// wrapped by a nested mocked call, and the reports of forbidden calls of
+// mockers configured with Never or of calls timed out, are returned as is.
+func (m *mockerBase) panicError(v any, params []any) any {
+	if _, ok := v.(*PanicError); ok {
+		return v
+	}
+	if s, ok := v.(string); ok && (strings.HasPrefix(s, forbiddenPrefix) || strings.HasPrefix(s, timedOutPrefix)) {
+		return v
+	}
+	return &PanicError{
+		Func:   funcName(m.k),
+		Params: params,
+		Site:   callerSite(m.pcs),
+		Value:  v,
+		Stack:  debug.Stack(),
+	}
+}
+
+// gsmockPrefix is the prefix of the names of the functions of this package.
+var gsmockPrefix = reflect.TypeFor[Manager]().PkgPath() + "."
+
+// callerSite returns the file:line of the first frame of pcs outside this
+// package and the MockXxx methods of generated mocks, or "" if none.
+func callerSite(pcs []uintptr) string {
+	frames := runtime.CallersFrames(pcs)
+	for {
+		f, more := frames.Next()
+		if !strings.HasPrefix(f.Function, gsmockPrefix) && !strings.Contains(f.Function, "MockImpl") {
+			return fmt.Sprintf("%s:%d", f.File, f.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
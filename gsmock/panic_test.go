@@ -0,0 +1,50 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestPanicError(t *testing.T) {
+	r := gsmock.NewManager()
+	c := &cache{r: r}
+
+	errBoom := errors.New("boom")
+	gsmock.Method22(nil, (*cache).lookup, r).
+		Handle(func(c *cache, key string) (string, bool) {
+			panic(errBoom)
+		})
+
+	gsmockassert.Panic(t, func() {
+		_, _ = c.lookup("a")
+	}, `gsmock: mock of .*\.\(\*cache\)\.lookup registered at .*panic_test\.go:\d+ panicked with params \(.*, a\): boom`)
+
+	func() {
+		defer func() {
+			err, ok := recover().(*gsmock.PanicError)
+			gsmockassert.Equal(t, ok, true)
+			gsmockassert.Equal(t, errors.Is(err, errBoom), true)
+			gsmockassert.Equal(t, err.Params[1], any("b"))
+		}()
+		_, _ = c.lookup("b")
+	}()
+}
@@ -82,14 +82,14 @@ func PatchOnce[T any](f T) {
 }
 
 // PatchFunc generates a wrapper function for f.
-// The wrapper attempts to intercept calls using InvokeContext()
+// The wrapper attempts to intercept calls like InvokeContext()
 // when a context.Context is found among the arguments.
 // If interception does not apply, it falls back to calling the original function.
 func PatchFunc[T any](f T, o *OriginHolder[T]) T {
 	t := reflect.TypeOf(f)
 	return reflect.MakeFunc(t, func(args []reflect.Value) []reflect.Value {
 		if n := len(args); n > 0 {
-			params := make([]any, n)
+			params := newBox(n)
 			for i, v := range args {
 				params[i] = v.Interface()
 			}
@@ -100,9 +100,13 @@ func PatchFunc[T any](f T, o *OriginHolder[T]) T {
 				ctx, ok = params[1].(context.Context)
 			}
 
-			// If a context is found, attempt context-bound invocation.
+			// If a context bound to a Manager is found, attempt invocation.
+			var r *Manager
 			if ok {
-				if ret, ok := InvokeContext(ctx, f, params...); ok {
+				r, _ = ctx.Value(&managerKey).(*Manager)
+			}
+			if r != nil {
+				if ret, ok := InvokeBoxed(r, nil, f, params); ok {
 					out := make([]reflect.Value, len(ret))
 					for i, v := range ret {
 						if v == nil {
@@ -111,8 +115,11 @@ func PatchFunc[T any](f T, o *OriginHolder[T]) T {
 							out[i] = reflect.ValueOf(v)
 						}
 					}
+					Release(ret)
 					return out
 				}
+			} else {
+				Release(params)
 			}
 		}
 
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"sync"
+)
+
+// boxArray is the pooled backing array of the slices boxing the
+// parameters and results of mocked calls.
+type boxArray = [MaxParamCount]any
+
+// boxPool pools the backing arrays of boxed parameters and results.
+var boxPool = sync.Pool{New: func() any { return new(boxArray) }}
+
+// newBox returns a slice of length n, backed by a pooled array
+// unless n exceeds MaxParamCount.
+func newBox(n int) []any {
+	if n > MaxParamCount {
+		return make([]any, n)
+	}
+	return boxPool.Get().(*boxArray)[:n]
+}
+
+// Box returns args boxed in a pooled slice, to be passed to InvokeBoxed.
+// It saves the generated mocks the allocation of the parameter slice.
+func Box(args ...any) []any {
+	s := newBox(len(args))
+	copy(s, args)
+	return s
+}
+
+// Release gives the results returned by InvokeBoxed back to the pool,
+// once they have been unboxed. The results must not be used afterward.
+// Slices not backed by a pooled array, such as those retained by the
+// Manager, are ignored.
+func Release(s []any) {
+	if cap(s) != MaxParamCount {
+		return
+	}
+	a := (*boxArray)(s[:MaxParamCount])
+	clear(a[:])
+	boxPool.Put(a)
+}
+
+// InvokeBoxed is like Invoke, for the parameters boxed by Box, which it
+// gives back to the pool: they must not be used afterward. The results
+// may be given back with Release once unboxed. It is used by the
+// generated mocks, whose calls then allocate no slices, unless the
+// Manager keeps them, e.g. when recording calls or streaming events.
+func InvokeBoxed(r *Manager, receiver any, fn any, params []any) ([]any, bool) {
+	ret, ok := Invoke(r, receiver, fn, params...)
+	if r.retains() {
+		return ret[:len(ret):len(ret)], ok // not released by Release
+	}
+	Release(params)
+	return ret, ok
+}
+
+// retains reports whether the Manager keeps the parameters and results
+// of calls after they return.
+func (r *Manager) retains() bool {
+	return r.retention != nil && !r.retention.CountOnly || r.events != nil
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestInvokeBoxed(t *testing.T) {
+
+	t.Run("recording", func(t *testing.T) {
+		r := gsmock.NewManager()
+		r.EnableRecording(gsmock.RetentionPolicy{})
+		c := NewMockClient(r)
+		c.MockQuery().Handle(func(req *Request) (*Response, error) {
+			return &Response{Message: "ok"}, nil
+		})
+		req1, req2 := &Request{Value: 1}, &Request{Value: 2}
+		_, _ = c.Query(req1)
+		_, _ = c.Query(req2)
+		calls := r.Calls(c, c.Query)
+		gsmockassert.Equal(t, len(calls), 2)
+		gsmockassert.Equal(t, calls[0].Params, []any{req1})
+		gsmockassert.Equal(t, calls[1].Params, []any{req2})
+		gsmockassert.Equal(t, calls[0].Results[0].(*Response).Message, "ok")
+	})
+
+	t.Run("reused", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		c.MockQuery().Handle(func(req *Request) (*Response, error) {
+			return &Response{Message: "ok"}, nil
+		})
+		for i := range 3 {
+			resp, err := c.Query(&Request{Value: i})
+			gsmockassert.Nil(t, err)
+			gsmockassert.Equal(t, resp.Message, "ok")
+		}
+	})
+
+	t.Run("oversized", func(t *testing.T) {
+		args := make([]any, gsmock.MaxParamCount+1)
+		s := gsmock.Box(args...)
+		gsmockassert.Equal(t, len(s), gsmock.MaxParamCount+1)
+		gsmock.Release(s) // ignored
+	})
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// Provenance tells which mock returned a value, as reported by ProvenanceOf.
+type Provenance struct {
+	Mock string // the mocked function, e.g. "pkg.(*StoreMockImpl).Get"
+	Site string // where the mocker was registered, as file:line
+	Call int    // index of the call among those returned by the mocker, from 1
+}
+
+// String describes the provenance for a test failure message.
+func (p Provenance) String() string {
+	return fmt.Sprintf("call #%d of the mock of %s registered at %s", p.Call, p.Mock, p.Site)
+}
+
+// provenanceKey identifies a value returned by a mocker. The type tells
+// apart pointers to a struct and to its first field.
+type provenanceKey struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+// provenanceEntry is the provenance of a value, which it keeps reachable
+// so that its address isn't reused while the entry exists.
+type provenanceEntry struct {
+	r *Manager
+	v any
+	p Provenance
+}
+
+// provenances holds the provenance of the values returned by the mockers
+// of the Managers with provenance enabled, until they close.
+var provenances struct {
+	sync.Mutex
+	m map[provenanceKey]provenanceEntry
+}
+
+// EnableProvenance makes the mockers of r tag the non-nil pointers they
+// return with the mock, the registration site and the call that returned
+// them, retrieved with ProvenanceOf. It speeds up finding which mock
+// produced the data of a failed assertion in complex flows:
+//
+//	r.EnableProvenance()
+//	...
+//	if user.Name != "alice" {
+//		p, _ := gsmock.ProvenanceOf(user)
+//		t.Errorf("unexpected user %q returned by %s", user.Name, p)
+//	}
+//
+// The values are kept reachable until r closes, which forgets them.
+func (r *Manager) EnableProvenance() {
+	r.provenance.Store(true)
+}
+
+// ProvenanceOf returns the provenance of the pointer v, if it was returned
+// by a mocker of a Manager with provenance enabled that isn't closed yet.
+// A pointer returned by several calls keeps the provenance of the first.
+func ProvenanceOf(v any) (Provenance, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return Provenance{}, false
+	}
+	provenances.Lock()
+	defer provenances.Unlock()
+	e, ok := provenances.m[provenanceKey{typ: rv.Type(), ptr: rv.Pointer()}]
+	return e.p, ok
+}
+
+// tag records the provenance of the non-nil pointers among the values
+// returned by a call of the mocker dispatched by r, if r has provenance
+// enabled.
+func (m *mockerBase) tag(r *Manager, ret []any) {
+	call := int(atomic.AddInt64(&m.calls, 1))
+	if !r.provenance.Load() {
+		return
+	}
+	var p *Provenance
+	for _, x := range ret {
+		v := reflect.ValueOf(x)
+		if v.Kind() != reflect.Pointer || v.IsNil() {
+			continue
+		}
+		if p == nil {
+			p = &Provenance{Mock: funcName(m.k), Site: callerSite(m.pcs), Call: call}
+		}
+		r.addProvenance(provenanceKey{typ: v.Type(), ptr: v.Pointer()}, provenanceEntry{r: r, v: x, p: *p})
+	}
+}
+
+// addProvenance records the provenance of a value, unless it is known.
+func (r *Manager) addProvenance(key provenanceKey, e provenanceEntry) {
+	provenances.Lock()
+	defer provenances.Unlock()
+	if _, ok := provenances.m[key]; ok {
+		return
+	}
+	if provenances.m == nil {
+		provenances.m = make(map[provenanceKey]provenanceEntry)
+	}
+	provenances.m[key] = e
+	r.provenanceKeys = append(r.provenanceKeys, key)
+}
+
+// forgetProvenance removes the provenance of the values returned by the
+// mockers of r.
+func (r *Manager) forgetProvenance() {
+	provenances.Lock()
+	defer provenances.Unlock()
+	for _, key := range r.provenanceKeys {
+		if provenances.m[key].r == r {
+			delete(provenances.m, key)
+		}
+	}
+	r.provenanceKeys = nil
+}
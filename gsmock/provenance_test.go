@@ -0,0 +1,85 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestProvenance(t *testing.T) {
+
+	t.Run("tagged", func(t *testing.T) {
+		r := gsmock.NewManager()
+		r.EnableProvenance()
+		c := NewMockClient(r)
+		c.MockQuery().When(valueIs(1)).ReturnValue(&Response{Message: "one"}, nil)
+		c.MockQuery().Handle(func(req *Request) (*Response, error) {
+			return &Response{Message: "other"}, nil
+		})
+
+		one, _ := c.Query(&Request{Value: 1})
+		_, _ = c.Query(&Request{Value: 2})
+		other, _ := c.Query(&Request{Value: 3})
+
+		p, ok := gsmock.ProvenanceOf(one)
+		gsmockassert.Equal(t, ok, true)
+		gsmockassert.Equal(t, p.Call, 1)
+		gsmockassert.Match(t, p.Mock, `\(\*MockClient\)\.Query$`)
+		gsmockassert.Match(t, p.Site, `_test\.go:\d+$`)
+
+		p, ok = gsmock.ProvenanceOf(other)
+		gsmockassert.Equal(t, ok, true)
+		gsmockassert.Equal(t, p.Call, 2)
+		gsmockassert.Match(t, p.String(), `^call #2 of the mock of .*\(\*MockClient\)\.Query registered at .*_test\.go:\d+$`)
+
+		_, ok = gsmock.ProvenanceOf(&Response{})
+		gsmockassert.Equal(t, ok, false)
+		_, ok = gsmock.ProvenanceOf(*one)
+		gsmockassert.Equal(t, ok, false)
+
+		gsmockassert.Nil(t, r.Close())
+		_, ok = gsmock.ProvenanceOf(one)
+		gsmockassert.Equal(t, ok, false)
+	})
+
+	t.Run("first call kept", func(t *testing.T) {
+		r := gsmock.NewManagerT(t)
+		r.EnableProvenance()
+		c := NewMockClient(r)
+		resp := &Response{}
+		c.MockQuery().ReturnValue(resp, nil)
+
+		_, _ = c.Query(&Request{})
+		_, _ = c.Query(&Request{})
+		p, ok := gsmock.ProvenanceOf(resp)
+		gsmockassert.Equal(t, ok, true)
+		gsmockassert.Equal(t, p.Call, 1)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		r := gsmock.NewManagerT(t)
+		c := NewMockClient(r)
+		c.MockQuery().ReturnValue(&Response{}, nil)
+
+		resp, _ := c.Query(&Request{})
+		_, ok := gsmock.ProvenanceOf(resp)
+		gsmockassert.Equal(t, ok, false)
+	})
+}
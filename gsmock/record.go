@@ -0,0 +1,152 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"strings"
+)
+
+// RetentionPolicy controls how much of the call history the Manager keeps
+// once call recording is enabled. The policy is applied independently to
+// each mocked function, so memory stays bounded no matter how many calls
+// a long-running test performs.
+type RetentionPolicy struct {
+	// KeepLast keeps only the most recent N calls. Zero means no limit.
+	KeepLast int
+
+	// SampleEvery records only one of every K calls (the 1st, K+1th, ...).
+	// Zero or one records every call.
+	SampleEvery int
+
+	// CountOnly keeps aggregate counters only and discards the parameters
+	// and results of every call.
+	CountOnly bool
+}
+
+// Call is a recorded invocation of a mocked function.
+type Call struct {
+	Params  []any // parameters the function was called with
+	Results []any // results returned by the matched mock, nil if unmatched
+	Matched bool  // whether a registered mock handled the call
+}
+
+// callRecord holds the recorded history of a single mocked function.
+type callRecord struct {
+	count   int    // total number of calls
+	matched int    // number of calls handled by a mock
+	calls   []Call // retained calls, used as a ring buffer if KeepLast > 0
+	next    int    // next ring buffer slot to overwrite
+}
+
+// EnableRecording turns on call recording with the given retention policy,
+// in place of the one given before, if any. The calls made before recording
+// is enabled are counted by WriteReport, but not recorded.
+func (r *Manager) EnableRecording(p RetentionPolicy) {
+	r.retention = &p
+}
+
+// record appends a call to the history of k according to the retention policy.
+func (r *Manager) record(k funcKey, params []any, ret []any, ok bool) {
+	r.recordMux.Lock()
+	defer r.recordMux.Unlock()
+
+	c := r.records[k]
+	if c == nil {
+		c = &callRecord{}
+		r.records[k] = c
+	}
+	c.count++
+	if ok {
+		c.matched++
+	}
+
+	p := r.retention
+	if p.CountOnly {
+		return
+	}
+	if p.SampleEvery > 1 && (c.count-1)%p.SampleEvery != 0 {
+		return
+	}
+
+	call := Call{Params: params, Results: ret, Matched: ok}
+	if p.KeepLast > 0 && c.calls == nil {
+		c.calls = make([]Call, 0, p.KeepLast)
+	}
+	if p.KeepLast <= 0 || len(c.calls) < p.KeepLast {
+		c.calls = append(c.calls, call)
+		return
+	}
+	c.calls[c.next] = call
+	c.next = (c.next + 1) % p.KeepLast
+}
+
+// Calls returns the retained calls of the given function, oldest first.
+// receiver and fn identify the function the same way as in Invoke.
+func (r *Manager) Calls(receiver any, fn any) []Call {
+	r.recordMux.Lock()
+	defer r.recordMux.Unlock()
+	c := r.records[newFuncKey(receiver, fn)]
+	if c == nil {
+		return nil
+	}
+	return c.retained()
+}
+
+// CallsByName returns the retained calls of the method of a generated
+// mock, oldest first, identifying the method by its name, such as one of
+// the constants generated along with the mock:
+//
+//	calls := r.CallsByName(m, ServiceMethodProcess)
+func (r *Manager) CallsByName(receiver any, method string) []Call {
+	r.recordMux.Lock()
+	defer r.recordMux.Unlock()
+	for k, c := range r.records {
+		if k.receiver == receiver && strings.HasSuffix(funcName(k), "."+method) {
+			return c.retained()
+		}
+	}
+	return nil
+}
+
+// retained returns the retained calls, oldest first.
+func (c *callRecord) retained() []Call {
+	ret := make([]Call, 0, len(c.calls))
+	ret = append(ret, c.calls[c.next:]...)
+	return append(ret, c.calls[:c.next]...)
+}
+
+// CallCount returns the total number of calls of the given function,
+// including calls that were not retained and calls no mock handled.
+func (r *Manager) CallCount(receiver any, fn any) int {
+	r.recordMux.Lock()
+	defer r.recordMux.Unlock()
+	if c := r.records[newFuncKey(receiver, fn)]; c != nil {
+		return c.count
+	}
+	return 0
+}
+
+// MatchedCount returns the number of calls of the given function that
+// were handled by a registered mock.
+func (r *Manager) MatchedCount(receiver any, fn any) int {
+	r.recordMux.Lock()
+	defer r.recordMux.Unlock()
+	if c := r.records[newFuncKey(receiver, fn)]; c != nil {
+		return c.matched
+	}
+	return 0
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestRecording(t *testing.T) {
+
+	// Test case: recording disabled - nothing is recorded
+	{
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		c.MockQuery().ReturnValue(&Response{Message: "ok"}, nil)
+
+		_, _ = c.Query(&Request{Value: 1})
+		gsmockassert.Equal(t, r.CallCount(c, c.Query), 0)
+		gsmockassert.Nil(t, r.Calls(c, c.Query))
+	}
+
+	// Test case: unlimited retention - all calls are recorded
+	{
+		r := gsmock.NewManager()
+		r.EnableRecording(gsmock.RetentionPolicy{})
+		c := NewMockClient(r)
+		c.MockQuery().WhenArgs(&Request{Value: 1}).ReturnValue(&Response{Message: "ok"}, nil)
+
+		_, _ = c.Query(&Request{Value: 1})
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Query(&Request{Value: 2})
+		}, "no mock code matched for MockClient.Query")
+
+		calls := r.Calls(c, c.Query)
+		gsmockassert.Equal(t, len(calls), 2)
+		gsmockassert.Equal(t, calls[0].Params, []any{&Request{Value: 1}})
+		gsmockassert.Equal(t, calls[0].Results, []any{&Response{Message: "ok"}, nil})
+		gsmockassert.Equal(t, calls[0].Matched, true)
+		gsmockassert.Equal(t, calls[1].Matched, false)
+		gsmockassert.Equal(t, r.CallCount(c, c.Query), 2)
+		gsmockassert.Equal(t, r.MatchedCount(c, c.Query), 1)
+
+		r.Reset()
+		gsmockassert.Equal(t, r.CallCount(c, c.Query), 0)
+	}
+
+	// Test case: keep last N calls
+	{
+		r := gsmock.NewManager()
+		r.EnableRecording(gsmock.RetentionPolicy{KeepLast: 3})
+		c := NewMockClient(r)
+		c.MockQuery().ReturnValue(&Response{}, nil)
+
+		for i := range 10 {
+			_, _ = c.Query(&Request{Value: i})
+		}
+		var values []int
+		for _, call := range r.Calls(c, c.Query) {
+			values = append(values, call.Params[0].(*Request).Value)
+		}
+		gsmockassert.Equal(t, values, []int{7, 8, 9})
+		gsmockassert.Equal(t, r.CallCount(c, c.Query), 10)
+
+		// the same calls, looked up by method name
+		gsmockassert.Equal(t, r.CallsByName(c, "Query"), r.Calls(c, c.Query))
+		gsmockassert.Nil(t, r.CallsByName(c, "Get"))
+		gsmockassert.Nil(t, r.CallsByName(NewMockClient(r), "Query"))
+	}
+
+	// Test case: sample 1 of every K calls
+	{
+		r := gsmock.NewManager()
+		r.EnableRecording(gsmock.RetentionPolicy{SampleEvery: 4})
+		c := NewMockClient(r)
+		c.MockQuery().ReturnValue(&Response{}, nil)
+
+		for i := range 10 {
+			_, _ = c.Query(&Request{Value: i})
+		}
+		var values []int
+		for _, call := range r.Calls(c, c.Query) {
+			values = append(values, call.Params[0].(*Request).Value)
+		}
+		gsmockassert.Equal(t, values, []int{0, 4, 8})
+		gsmockassert.Equal(t, r.CallCount(c, c.Query), 10)
+	}
+
+	// Test case: aggregate counters only
+	{
+		r := gsmock.NewManager()
+		r.EnableRecording(gsmock.RetentionPolicy{CountOnly: true})
+		c := NewMockClient(r)
+		c.MockQuery().ReturnValue(&Response{}, nil)
+
+		for i := range 10 {
+			_, _ = c.Query(&Request{Value: i})
+		}
+		gsmockassert.Equal(t, len(r.Calls(c, c.Query)), 0)
+		gsmockassert.Equal(t, r.CallCount(c, c.Query), 10)
+		gsmockassert.Equal(t, r.MatchedCount(c, c.Query), 10)
+	}
+}
+
+func TestRecordingBoundedMemory(t *testing.T) {
+	r := gsmock.NewManager()
+	r.EnableRecording(gsmock.RetentionPolicy{KeepLast: 10, SampleEvery: 10})
+	c := NewMockClient(r)
+	c.MockQuery().ReturnValue(&Response{}, nil)
+
+	const n = 1000
+	for i := range n {
+		_, _ = c.Query(&Request{Value: i})
+	}
+
+	// The calls are retained in a ring buffer which never outgrows KeepLast.
+	calls := r.Calls(c, c.Query)
+	gsmockassert.Equal(t, len(calls), 10)
+	gsmockassert.Equal(t, gsmock.RetainedCap(r, c, c.Query), 10)
+	gsmockassert.Equal(t, r.CallCount(c, c.Query), n)
+
+	// only the last sampled calls are retained
+	gsmockassert.Equal(t, calls[0].Params[0].(*Request).Value, n-100)
+	gsmockassert.Equal(t, calls[9].Params[0].(*Request).Value, n-10)
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Redacted replaces sensitive values in diagnostic output.
+const Redacted = "[REDACTED]"
+
+var (
+	redactMux    sync.RWMutex
+	redactFields = make(map[string]struct{})
+	redactors    = make(map[reflect.Type]func(v any) string)
+)
+
+// RedactFields registers field names (case-insensitive) whose values are
+// replaced by Redacted in all diagnostic output of gsmock, such as forbidden
+// call reports. The names apply to struct fields and to string map keys.
+//
+// Struct fields can also be redacted with the `gsmock:"redact"` tag.
+func RedactFields(names ...string) {
+	redactMux.Lock()
+	defer redactMux.Unlock()
+	for _, name := range names {
+		redactFields[strings.ToLower(name)] = struct{}{}
+	}
+}
+
+// RegisterRedactor registers a function formatting values of type T in
+// diagnostic output, e.g. to mask all but the last digits of a card number.
+func RegisterRedactor[T any](fn func(v T) string) {
+	redactMux.Lock()
+	defer redactMux.Unlock()
+	redactors[reflect.TypeFor[T]()] = func(v any) string {
+		return fn(v.(T))
+	}
+}
+
+// isRedactedField reports whether the field or map key name is redacted.
+func isRedactedField(name string) bool {
+	redactMux.RLock()
+	defer redactMux.RUnlock()
+	_, ok := redactFields[strings.ToLower(name)]
+	return ok
+}
+
+// getRedactor returns the redactor registered for type t, or nil.
+func getRedactor(t reflect.Type) func(v any) string {
+	redactMux.RLock()
+	defer redactMux.RUnlock()
+	return redactors[t]
+}
+
+// formatParams formats call parameters for diagnostic messages.
+func formatParams(params []any) string {
+	ss := make([]string, len(params))
+	for i, p := range params {
+		ss[i] = formatValue(p)
+	}
+	return "(" + strings.Join(ss, ", ") + ")"
+}
+
+// formatValue formats a value like fmt's %+v verb,
+// honoring the registered redaction rules.
+func formatValue(v any) string {
+	return valueString(reflect.ValueOf(v), 0)
+}
+
+// valueString returns the formatted value v.
+func valueString(v reflect.Value, depth int) string {
+	var sb strings.Builder
+	writeValue(&sb, v, depth)
+	return sb.String()
+}
+
+// maxFormatDepth limits the nesting depth formatValue descends into,
+// which also protects against cyclic data structures.
+const maxFormatDepth = 8
+
+var stringerType = reflect.TypeFor[fmt.Stringer]()
+
+// writeValue writes the formatted value v to sb.
+func writeValue(sb *strings.Builder, v reflect.Value, depth int) {
+	if !v.IsValid() {
+		sb.WriteString("<nil>")
+		return
+	}
+	if depth > maxFormatDepth {
+		sb.WriteString("...")
+		return
+	}
+	if v.CanInterface() {
+		if fn := getRedactor(v.Type()); fn != nil {
+			sb.WriteString(fn(v.Interface()))
+			return
+		}
+		if v.Type().Implements(errorType) || v.Type().Implements(stringerType) {
+			if v.Kind() != reflect.Pointer && v.Kind() != reflect.Interface || !v.IsNil() {
+				_, _ = fmt.Fprintf(sb, "%v", v.Interface())
+				return
+			}
+		}
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		writeValue(sb, v.Elem(), depth)
+	case reflect.Pointer:
+		if v.IsNil() {
+			sb.WriteString("<nil>")
+			return
+		}
+		if k := v.Elem().Kind(); k == reflect.Struct || k == reflect.Slice || k == reflect.Array || k == reflect.Map {
+			sb.WriteString("&")
+			writeValue(sb, v.Elem(), depth+1)
+			return
+		}
+		_, _ = fmt.Fprintf(sb, "%#x", v.Pointer())
+	case reflect.Struct:
+		t := v.Type()
+		sb.WriteString("{")
+		for i := range v.NumField() {
+			if i > 0 {
+				sb.WriteString(" ")
+			}
+			f := t.Field(i)
+			sb.WriteString(f.Name)
+			sb.WriteString(":")
+			if f.Tag.Get("gsmock") == "redact" || isRedactedField(f.Name) {
+				sb.WriteString(Redacted)
+				continue
+			}
+			writeValue(sb, v.Field(i), depth+1)
+		}
+		sb.WriteString("}")
+	case reflect.Map:
+		if v.IsNil() {
+			sb.WriteString("map[]")
+			return
+		}
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(valueString(a, depth+1), valueString(b, depth+1))
+		})
+		sb.WriteString("map[")
+		for i, k := range keys {
+			if i > 0 {
+				sb.WriteString(" ")
+			}
+			writeValue(sb, k, depth+1)
+			sb.WriteString(":")
+			if k.Kind() == reflect.String && isRedactedField(k.String()) {
+				sb.WriteString(Redacted)
+				continue
+			}
+			writeValue(sb, v.MapIndex(k), depth+1)
+		}
+		sb.WriteString("]")
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			sb.WriteString("[]")
+			return
+		}
+		sb.WriteString("[")
+		for i := range v.Len() {
+			if i > 0 {
+				sb.WriteString(" ")
+			}
+			writeValue(sb, v.Index(i), depth+1)
+		}
+		sb.WriteString("]")
+	case reflect.String:
+		sb.WriteString(v.String())
+	case reflect.Bool:
+		sb.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		sb.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		sb.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		sb.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case reflect.Complex64, reflect.Complex128:
+		sb.WriteString(strconv.FormatComplex(v.Complex(), 'g', -1, 128))
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if v.IsNil() {
+			sb.WriteString("<nil>")
+			return
+		}
+		_, _ = fmt.Fprintf(sb, "%#x", v.Pointer())
+	default:
+		sb.WriteString(v.Type().String())
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+type Credentials struct {
+	User     string
+	Password string `gsmock:"redact"`
+	Token    string
+	Headers  map[string]string
+	Card     CardNumber
+	inner    *Credentials
+}
+
+type CardNumber string
+
+// forbiddenMessage returns the report of a forbidden call with the given params.
+func forbiddenMessage(params ...any) (msg string) {
+	r := gsmock.NewManager()
+	fn := func(...any) {}
+	gsmock.VarMethod10(nil, fn, r).Never()
+	defer func() { msg = recover().(string) }()
+	gsmock.Invoke(r, nil, fn, params)
+	return
+}
+
+func TestRedact(t *testing.T) {
+	gsmock.RedactFields("token", "Authorization")
+	gsmock.RegisterRedactor(func(v CardNumber) string {
+		return "****" + string(v[len(v)-4:])
+	})
+
+	c := &Credentials{
+		User:     "alice",
+		Password: "secret1",
+		Token:    "secret2",
+		Headers:  map[string]string{"Authorization": "secret3", "Accept": "json"},
+		Card:     "4111111111111111",
+		inner:    &Credentials{Password: "secret4", Token: "secret5"},
+	}
+	msg := forbiddenMessage(c, 3, errors.New("boom"), time.Duration(0), nil)
+	msg = msg[:strings.Index(msg, "\n")]
+
+	gsmockassert.Equal(t, strings.Contains(msg, "secret"), false)
+	gsmockassert.Panic(t, func() { panic(msg) }, strings.Join([]string{
+		`with params \(\[&\{User:alice Password:\[REDACTED\] Token:\[REDACTED\] `,
+		`Headers:map\[Accept:json Authorization:\[REDACTED\]\] Card:\*\*\*\*1111 `,
+		`inner:&\{User: Password:\[REDACTED\] Token:\[REDACTED\] Headers:map\[\] Card: inner:<nil>\}\} `,
+		`3 boom 0s <nil>\]\)$`,
+	}, ""))
+}
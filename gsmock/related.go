@@ -0,0 +1,45 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+// AssertRelated checks a relationship between the values collected by two
+// captors, usually of different mockers, such as the ID returned by
+// idGenerator.Next and the ID of the entity passed to repository.Save:
+//
+//	ids := gen.MockNext().CaptureResult1()
+//	saved := repo.MockSave().CaptureArg1()
+//	...
+//	gsmock.AssertRelated(t, ids, saved, func(id string, u *User) bool {
+//		return u.ID == id
+//	})
+//
+// The values are paired in call order, the i-th value of a with the i-th
+// value of b. The test fails if the captors collected different numbers
+// of values, and for every pair fn rejects.
+func AssertRelated[A, B any](t TB, a *Captor[A], b *Captor[B], fn func(a A, b B) bool) {
+	t.Helper()
+	va, vb := a.Values(), b.Values()
+	if len(va) != len(vb) {
+		t.Errorf("gsmock: related captors collected %d and %d values", len(va), len(vb))
+		return
+	}
+	for i := range va {
+		if !fn(va[i], vb[i]) {
+			t.Errorf("gsmock: values of call %d are not related: %v and %v", i+1, va[i], vb[i])
+		}
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// NextID is a sample ID generator.
+func NextID() (string, error) {
+	return "", nil
+}
+
+// SaveUser is a sample repository method.
+func SaveUser(u *User) error {
+	return nil
+}
+
+func TestAssertRelated(t *testing.T) {
+	r := gsmock.NewManager()
+	next := func() (string, error) {
+		ret, _ := gsmock.Invoke(r, nil, NextID)
+		return gsmock.Unbox2[string, error](ret)
+	}
+	save := func(u *User) error {
+		ret, _ := gsmock.Invoke(r, nil, SaveUser, u)
+		return gsmock.Unbox1[error](ret)
+	}
+
+	n := 0
+	m1 := gsmock.Method02(nil, NextID, r)
+	m1.Handle(func() (string, error) {
+		n++
+		return fmt.Sprint("id-", n), nil
+	})
+	ids, errs := m1.CaptureResult1(), m1.CaptureResult2()
+	m2 := gsmock.Method11(nil, SaveUser, r)
+	m2.ReturnValue(nil)
+	saved := m2.CaptureArg1()
+
+	related := func(id string, u *User) bool { return u.Name == id }
+	for range 2 {
+		id, _ := next()
+		_ = save(&User{Name: id})
+	}
+	gsmockassert.Equal(t, ids.Values(), []string{"id-1", "id-2"})
+	gsmockassert.Equal(t, errs.Values(), []error{nil, nil})
+
+	ft := &fakeT{}
+	gsmock.AssertRelated(ft, ids, saved, related)
+	gsmockassert.Equal(t, len(ft.errors), 0)
+
+	_ = save(&User{Name: "id-3"})
+	gsmock.AssertRelated(ft, ids, saved, related)
+	gsmockassert.Equal(t, ft.errors, []string{"gsmock: related captors collected 2 and 3 values"})
+
+	ft = &fakeT{}
+	_, _ = next()
+	_, _ = next()
+	_ = save(&User{Name: "id-3"})
+	gsmock.AssertRelated(ft, ids, saved, related)
+	gsmockassert.Equal(t, len(ft.errors), 1)
+	gsmockassert.Match(t, ft.errors[0], `gsmock: values of call 4 are not related: id-4 and &\{id-3\}`)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"io"
+	"maps"
+	"slices"
+)
+
+// ReportEntry is the usage of a mocked function written by WriteReport.
+type ReportEntry struct {
+	Func    string `json:"func"`    // full name of the mocked function
+	Mockers int    `json:"mockers"` // number of mockers registered for the function
+	Calls   int    `json:"calls"`   // number of calls of the function
+}
+
+// WriteReport writes the usage of every function mocked or called since
+// the Manager was created or reset to w, as JSON lines of ReportEntry,
+// ordered like WriteTranscript. Unlike transcripts, reports don't need
+// call recording.
+//
+// The reports of test runs tell which generated mocks are never used:
+// `gs-mock prune report.jsonl` suggests the methods to stop generating.
+func (r *Manager) WriteReport(w io.Writer) error {
+	return jsonSerializer{}.WriteReport(w, r.report())
+}
+
+// report returns the usage of every function mocked or called,
+// ordered like WriteReport.
+func (r *Manager) report() []ReportEntry {
+	r.callMux.Lock()
+	counts := maps.Clone(r.callCounts)
+	r.callMux.Unlock()
+
+	keys := slices.Collect(maps.Keys(r.mockers))
+	for k := range counts {
+		if _, ok := r.mockers[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	r.sortKeys(keys)
+
+	entries := make([]ReportEntry, len(keys))
+	for i, k := range keys {
+		entries[i] = ReportEntry{
+			Func:    funcName(k),
+			Mockers: len(r.mockers[k]),
+			Calls:   counts[k],
+		}
+	}
+	return entries
+}
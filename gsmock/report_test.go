@@ -0,0 +1,46 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestWriteReport(t *testing.T) {
+	r := gsmock.NewManager()
+
+	m := NewMockClient(r)
+	m.MockQuery().WhenArgs(&Request{Value: 1}).ReturnValue(&Response{Message: "ok"}, nil)
+	m.MockQuery().WhenArgs(&Request{Value: 2}).ReturnValue(&Response{Message: "ok"}, nil)
+	_, _ = m.Query(&Request{Value: 1})
+
+	c := &cache{r: r}
+	_, _ = c.lookup("a")
+	_, _ = c.lookup("b")
+
+	var buf bytes.Buffer
+	gsmockassert.Nil(t, r.WriteReport(&buf))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	gsmockassert.Equal(t, len(lines), 2)
+	gsmockassert.Equal(t, lines[0], `{"func":"github.com/go-spring/gs-mock/gsmock_test.(*MockClient).Query","mockers":2,"calls":1}`)
+	gsmockassert.Equal(t, lines[1], `{"func":"github.com/go-spring/gs-mock/gsmock_test.(*cache).lookup","mockers":0,"calls":2}`)
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// SeedEnv is the environment variable that sets the seed of every Manager,
+// to replay the random faults, orders and values of a failed test run.
+const SeedEnv = "GSMOCK_SEED"
+
+// managedSeed is the randomness of a Manager, derived from a single seed.
+type managedSeed struct {
+	mux     sync.Mutex
+	seed    uint64
+	streams uint64 // number of generators derived from seed
+	used    bool   // whether a generator was derived from seed
+}
+
+// Seed returns the seed of the random faults of ApplyChaos, the random
+// order of EnableShuffledOrder and the generators of NewRand, unless they
+// are given their own seed. It is read from the GSMOCK_SEED environment
+// variable, or else chosen at random when the Manager is created.
+//
+// A Manager bound to a test by NewManagerT logs its seed if the test
+// fails after using it, so that the run can be replayed exactly with:
+//
+//	GSMOCK_SEED=<seed> go test -run <test>
+func (r *Manager) Seed() uint64 {
+	r.rand.mux.Lock()
+	defer r.rand.mux.Unlock()
+	return r.rand.seed
+}
+
+// SetSeed replaces the seed of r, e.g. to pin a test to a known sequence.
+// It only affects the generators derived afterward.
+func (r *Manager) SetSeed(seed uint64) {
+	r.rand.mux.Lock()
+	defer r.rand.mux.Unlock()
+	r.rand.seed = seed
+	r.rand.streams = 0
+}
+
+// NewRand returns a generator derived from the seed of r, to draw random
+// test data reproducibly, e.g. with GenFunc and ReturnGen. The generators
+// returned by successive calls differ, but are the same from one run to
+// the next with the same seed, provided they are created in the same
+// order. A generator is not safe for concurrent use.
+func (r *Manager) NewRand() *rand.Rand {
+	r.rand.mux.Lock()
+	defer r.rand.mux.Unlock()
+	r.rand.used = true
+	r.rand.streams++
+	return rand.New(rand.NewPCG(r.rand.seed, r.rand.streams))
+}
+
+// newSeed returns the seed of a new Manager, set by SeedEnv or else
+// random. It panics if SeedEnv isn't an unsigned integer.
+func newSeed() uint64 {
+	s := os.Getenv(SeedEnv)
+	if s == "" {
+		return rand.Uint64()
+	}
+	seed, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("gsmock: invalid %s %q: %s", SeedEnv, s, err))
+	}
+	return seed
+}
+
+// derivedSeed returns seed if non-zero, or else a seed derived from the
+// seed of r.
+func (r *Manager) derivedSeed(seed uint64) uint64 {
+	if seed != 0 {
+		return seed
+	}
+	return r.NewRand().Uint64()
+}
+
+// logSeed logs the seed of r to t if the test failed after randomness
+// derived from it was used.
+func (r *Manager) logSeed(t TB) {
+	r.rand.mux.Lock()
+	seed, used := r.rand.seed, r.rand.used
+	r.rand.mux.Unlock()
+	if !used {
+		return
+	}
+	if f, ok := t.(interface {
+		Failed() bool
+		Logf(format string, args ...any)
+	}); ok && f.Failed() {
+		f.Logf("gsmock: random seed %d, rerun with %s=%d to replay", seed, SeedEnv, seed)
+	}
+}
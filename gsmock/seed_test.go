@@ -0,0 +1,106 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/chaos"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// loggingT is a fakeT that can fail and records its logs.
+type loggingT struct {
+	fakeT
+	failed bool
+	logs   []string
+}
+
+func (t *loggingT) Failed() bool { return t.failed || len(t.errors) > 0 }
+
+func (t *loggingT) Logf(format string, args ...any) {
+	t.logs = append(t.logs, fmt.Sprintf(format, args...))
+}
+
+func TestSeed(t *testing.T) {
+
+	t.Run("replay", func(t *testing.T) {
+		draw := func(r *gsmock.Manager) []uint64 {
+			r1, r2 := r.NewRand(), r.NewRand()
+			return []uint64{r1.Uint64(), r1.Uint64(), r2.Uint64()}
+		}
+		r := gsmock.NewManager()
+		first := draw(r)
+		gsmockassert.Equal(t, first[0] != first[2], true)
+
+		r2 := gsmock.NewManager()
+		r2.SetSeed(r.Seed())
+		gsmockassert.Equal(t, draw(r2), first)
+	})
+
+	t.Run("env", func(t *testing.T) {
+		t.Setenv(gsmock.SeedEnv, "42")
+		gsmockassert.Equal(t, gsmock.NewManager().Seed(), uint64(42))
+
+		t.Setenv(gsmock.SeedEnv, "x")
+		gsmockassert.Panic(t, func() {
+			gsmock.NewManager()
+		}, `gsmock: invalid GSMOCK_SEED "x"`)
+	})
+
+	t.Run("chaos", func(t *testing.T) {
+		outcomes := func(seed uint64) []bool {
+			r := gsmock.NewManager()
+			r.SetSeed(seed)
+			gsmock.ApplyChaos(r, chaos.Profile{ErrorRate: 0.5})
+			c := NewMockClient(r)
+			c.MockQuery().ReturnValue(&Response{}, nil)
+			var failed []bool
+			for range 32 {
+				_, err := c.Query(&Request{})
+				failed = append(failed, err != nil)
+			}
+			return failed
+		}
+		failed := outcomes(7)
+		gsmockassert.Equal(t, slices.Contains(failed, true) && slices.Contains(failed, false), true)
+		gsmockassert.Equal(t, outcomes(7), failed)
+	})
+
+	t.Run("logged on failure", func(t *testing.T) {
+		lt := &loggingT{failed: true}
+		r := gsmock.NewManagerT(lt)
+		r.SetSeed(42)
+		_ = r.NewRand()
+		lt.finish()
+		gsmockassert.Equal(t, lt.logs, []string{"gsmock: random seed 42, rerun with GSMOCK_SEED=42 to replay"})
+
+		// not logged if the test passes or didn't use randomness
+		lt = &loggingT{}
+		gsmock.NewManagerT(lt).NewRand()
+		lt.finish()
+		gsmockassert.Nil(t, lt.logs)
+
+		lt = &loggingT{failed: true}
+		gsmock.NewManagerT(lt)
+		lt.finish()
+		gsmockassert.Nil(t, lt.logs)
+	})
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Serializer writes transcripts and reports in a given format.
+// JSON lines ("json") and plain text ("text") are built in; other
+// formats, e.g. protobuf or a CSV of call counts, are registered with
+// RegisterSerializer. A Serializer supporting only one of transcripts
+// and reports returns errors.ErrUnsupported for the other.
+type Serializer interface {
+	// WriteTranscript writes the entries of a transcript to w.
+	WriteTranscript(w io.Writer, entries []TranscriptEntry) error
+	// WriteReport writes the entries of a report to w.
+	WriteReport(w io.Writer, entries []ReportEntry) error
+}
+
+var (
+	serializerMux sync.RWMutex
+	serializers   = map[string]Serializer{
+		"json": jsonSerializer{},
+		"text": textSerializer{},
+	}
+)
+
+// RegisterSerializer registers s as the Serializer of format, used by
+// WriteTranscriptAs and WriteReportAs. Registering a format again,
+// built-in ones included, replaces the previous Serializer.
+func RegisterSerializer(format string, s Serializer) {
+	if s == nil {
+		panic("gsmock: RegisterSerializer called with a nil Serializer")
+	}
+	serializerMux.Lock()
+	defer serializerMux.Unlock()
+	serializers[format] = s
+}
+
+// serializer returns the Serializer registered for format.
+func serializer(format string) (Serializer, error) {
+	serializerMux.RLock()
+	defer serializerMux.RUnlock()
+	s, ok := serializers[format]
+	if !ok {
+		return nil, fmt.Errorf("gsmock: no serializer registered for format %q", format)
+	}
+	return s, nil
+}
+
+// WriteTranscriptAs is like WriteTranscript, in the given format.
+func (r *Manager) WriteTranscriptAs(w io.Writer, format string) error {
+	s, err := serializer(format)
+	if err != nil {
+		return err
+	}
+	return s.WriteTranscript(w, r.transcript())
+}
+
+// WriteReportAs is like WriteReport, in the given format.
+func (r *Manager) WriteReportAs(w io.Writer, format string) error {
+	s, err := serializer(format)
+	if err != nil {
+		return err
+	}
+	return s.WriteReport(w, r.report())
+}
+
+// jsonSerializer writes entries as JSON lines, the format read by the tool.
+type jsonSerializer struct{}
+
+func (jsonSerializer) WriteTranscript(w io.Writer, entries []TranscriptEntry) error {
+	return writeJSONLines(w, entries)
+}
+
+func (jsonSerializer) WriteReport(w io.Writer, entries []ReportEntry) error {
+	return writeJSONLines(w, entries)
+}
+
+// writeJSONLines writes each entry as a line of JSON.
+func writeJSONLines[T any](w io.Writer, entries []T) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// textSerializer writes entries as lines of text meant to be read by humans.
+type textSerializer struct{}
+
+func (textSerializer) WriteTranscript(w io.Writer, entries []TranscriptEntry) error {
+	for _, e := range entries {
+		call := e.Func + "(" + strings.Join(e.Params, ", ") + ")"
+		if !e.Matched {
+			call += " unmatched"
+		} else if len(e.Results) > 0 {
+			call += " = " + strings.Join(e.Results, ", ")
+		}
+		if _, err := fmt.Fprintln(w, call); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (textSerializer) WriteReport(w io.Writer, entries []ReportEntry) error {
+	for _, e := range entries {
+		if _, err := fmt.Fprintf(w, "%s: %d mockers, %d calls\n", e.Func, e.Mockers, e.Calls); err != nil {
+			return err
+		}
+	}
+	return nil
+}
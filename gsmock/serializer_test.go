@@ -0,0 +1,92 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// csvSerializer writes the call counts of reports as CSV.
+type csvSerializer struct{}
+
+func (csvSerializer) WriteTranscript(w io.Writer, entries []gsmock.TranscriptEntry) error {
+	return errors.ErrUnsupported
+}
+
+func (csvSerializer) WriteReport(w io.Writer, entries []gsmock.ReportEntry) error {
+	cw := csv.NewWriter(w)
+	for _, e := range entries {
+		_ = cw.Write([]string{e.Func, strconv.Itoa(e.Calls)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func TestSerializer(t *testing.T) {
+	gsmock.RegisterSerializer("csv", csvSerializer{})
+
+	r := gsmock.NewManager()
+	r.EnableRecording(gsmock.RetentionPolicy{})
+	c := NewMockClient(r)
+	c.MockQuery().WhenArgs(&Request{Value: 1}).ReturnValue(&Response{Message: "ok"}, nil)
+	_, _ = c.Query(&Request{Value: 1})
+	gsmockassert.Panic(t, func() {
+		_, _ = c.Query(&Request{Value: 2})
+	}, "no mock code matched")
+
+	const name = "github.com/go-spring/gs-mock/gsmock_test.(*MockClient).Query"
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		gsmockassert.Nil(t, r.WriteTranscriptAs(&buf, "text"))
+		gsmockassert.Equal(t, buf.String(),
+			name+`(&gsmock_test.Request{Value:1}) = &gsmock_test.Response{Message:"ok"}, nil`+"\n"+
+				name+"(&gsmock_test.Request{Value:2}) unmatched\n")
+
+		buf.Reset()
+		gsmockassert.Nil(t, r.WriteReportAs(&buf, "text"))
+		gsmockassert.Equal(t, buf.String(), name+": 1 mockers, 2 calls\n")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var a, b bytes.Buffer
+		gsmockassert.Nil(t, r.WriteReportAs(&a, "json"))
+		gsmockassert.Nil(t, r.WriteReport(&b))
+		gsmockassert.Equal(t, a.String(), b.String())
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		var buf bytes.Buffer
+		gsmockassert.Nil(t, r.WriteReportAs(&buf, "csv"))
+		gsmockassert.Equal(t, buf.String(), name+",2\n")
+		err := r.WriteTranscriptAs(&buf, "csv")
+		gsmockassert.Equal(t, errors.Is(err, errors.ErrUnsupported), true)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		err := r.WriteReportAs(io.Discard, "xml")
+		gsmockassert.Match(t, err.Error(), `no serializer registered for format "xml"`)
+	})
+}
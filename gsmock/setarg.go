@@ -0,0 +1,61 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// setArg registers the writing of value into the pointer passed as
+// argument n, 1-based, of the calls matched by the mocker, whose
+// function takes count arguments.
+func (m *mockerBase) setArg(count int, n int, value any) {
+	if n < 1 || n > count {
+		panic(fmt.Sprintf("gsmock: SetArg argument %d out of range [1, %d]", n, count))
+	}
+	m.setArgs = append(m.setArgs, func(params []any) {
+		if err := assignArg(params[n-1], value); err != nil {
+			panic(fmt.Sprintf("gsmock: SetArg argument %d: %s", n, err))
+		}
+	})
+}
+
+// assignArg stores value into the variable ptr points to. value may also
+// be a pointer to the value to store, which is then copied. A nil value
+// stores the zero value.
+func assignArg(ptr any, value any) error {
+	p := reflect.ValueOf(ptr)
+	if p.Kind() != reflect.Pointer || p.IsNil() {
+		return fmt.Errorf("got %T, not a non-nil pointer", ptr)
+	}
+	dst := p.Elem()
+	if value == nil {
+		dst.SetZero()
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Type().AssignableTo(dst.Type()) {
+		dst.Set(v)
+		return nil
+	}
+	if v.Kind() == reflect.Pointer && !v.IsNil() && v.Elem().Type().AssignableTo(dst.Type()) {
+		dst.Set(v.Elem())
+		return nil
+	}
+	return fmt.Errorf("cannot assign %T to %s", value, dst.Type())
+}
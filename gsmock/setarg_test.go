@@ -0,0 +1,88 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+type User struct {
+	Name string
+}
+
+// Decode is a sample function filling its out parameter v.
+func Decode(data []byte, v any) error {
+	return nil
+}
+
+func TestSetArg(t *testing.T) {
+	r := gsmock.NewManager()
+	decode := func(data []byte, v any) error {
+		if ret, ok := gsmock.Invoke(r, nil, Decode, data, v); ok {
+			return gsmock.Unbox1[error](ret)
+		}
+		return Decode(data, v)
+	}
+
+	gsmock.Method21(nil, Decode, r).
+		WhenArg1([]byte("a")).
+		SetArg(2, User{Name: "a"}).
+		ReturnValue(nil)
+	gsmock.Method21(nil, Decode, r).
+		WhenArg1([]byte("b")).
+		SetArg(2, &User{Name: "b"}).
+		ReturnValue(nil)
+	gsmock.Method21(nil, Decode, r).
+		WhenArg1([]byte("nil")).
+		SetArg(2, nil).
+		ReturnValue(nil)
+	gsmock.Method21(nil, Decode, r).
+		SetArg(2, "c").
+		ReturnValue(nil)
+
+	// value stored into the pointer
+	var u User
+	gsmockassert.Nil(t, decode([]byte("a"), &u))
+	gsmockassert.Equal(t, u, User{Name: "a"})
+
+	// pointer to the value copied
+	gsmockassert.Nil(t, decode([]byte("b"), &u))
+	gsmockassert.Equal(t, u, User{Name: "b"})
+
+	// nil stores the zero value
+	gsmockassert.Nil(t, decode([]byte("nil"), &u))
+	gsmockassert.Equal(t, u, User{})
+
+	// any pointer type
+	var s string
+	gsmockassert.Nil(t, decode([]byte("c"), &s))
+	gsmockassert.Equal(t, s, "c")
+
+	gsmockassert.Panic(t, func() {
+		_ = decode([]byte("c"), &u)
+	}, `gsmock: SetArg argument 2: cannot assign string to gsmock_test.User`)
+	gsmockassert.Panic(t, func() {
+		_ = decode([]byte("c"), u)
+	}, `gsmock: SetArg argument 2: got gsmock_test.User, not a non-nil pointer`)
+
+	gsmockassert.Panic(t, func() {
+		gsmock.Method21(nil, Decode, r).SetArg(3, nil)
+	}, `gsmock: SetArg argument 3 out of range \[1, 2\]`)
+}
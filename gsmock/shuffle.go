@@ -0,0 +1,94 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// shuffler evaluates the mockers of a function in a random order.
+type shuffler struct {
+	mux  sync.Mutex
+	rand *rand.Rand
+}
+
+// EnableShuffledOrder makes the Manager evaluate the mockers registered
+// for a function in a random order, derived from seed, instead of their
+// registration order. Tests whose outcome changes rely on the order of
+// overlapping mockers, e.g. a catch-all mocker registered after specific
+// ones. A zero seed is replaced by one derived from the seed of r.
+func (r *Manager) EnableShuffledOrder(seed uint64) {
+	seed = r.derivedSeed(seed)
+	r.shuffle = &shuffler{rand: rand.New(rand.NewPCG(seed, seed))}
+}
+
+// shuffled returns a shuffled copy of the mockers.
+func (s *shuffler) shuffled(mockers []Invoker) []Invoker {
+	mockers = slices.Clone(mockers)
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.rand.Shuffle(len(mockers), func(i, j int) {
+		mockers[i], mockers[j] = mockers[j], mockers[i]
+	})
+	return mockers
+}
+
+// CheckOrderIndependence runs fn n times with a new Manager each time,
+// first evaluating the mockers in registration order, and then in the
+// random orders of EnableShuffledOrder with the seeds 1 to n-1. The test
+// fails for every run whose outcome differs from the first one, i.e.
+// when fn accidentally relies on the registration order of mockers.
+//
+// The outcome of a run is the value returned by fn, e.g. the results of
+// the code under test, compared with reflect.DeepEqual, or the first line
+// of its panic message if it panics.
+func CheckOrderIndependence(t TB, n int, fn func(r *Manager) any) {
+	t.Helper()
+	var first any
+	for seed := range n {
+		r := NewManager()
+		if seed > 0 {
+			r.EnableShuffledOrder(uint64(seed))
+		}
+		outcome := runOutcome(r, fn)
+		if seed == 0 {
+			first = outcome
+			continue
+		}
+		if !reflect.DeepEqual(outcome, first) {
+			t.Errorf("gsmock: outcome depends on the order of mockers: registration order gave %v, shuffled order (seed %d) gave %v",
+				first, seed, outcome)
+		}
+	}
+}
+
+// runOutcome returns the value returned by fn, or the first
+// line of its panic message as a string if it panics.
+func runOutcome(r *Manager, fn func(r *Manager) any) (outcome any) {
+	defer func() {
+		if v := recover(); v != nil {
+			msg, _, _ := strings.Cut(fmt.Sprint(v), "\n")
+			outcome = "panic: " + msg
+		}
+	}()
+	return fn(r)
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestCheckOrderIndependence(t *testing.T) {
+
+	// Test case: disjoint mockers don't depend on their order
+	{
+		ft := &fakeT{}
+		gsmock.CheckOrderIndependence(ft, 10, func(r *gsmock.Manager) any {
+			c := NewMockClient(r)
+			c.MockQuery().WhenArgs(&Request{Value: 1}).ReturnValue(&Response{Message: "one"}, nil)
+			c.MockQuery().WhenArgs(&Request{Value: 2}).ReturnValue(&Response{Message: "two"}, nil)
+			resp1, _ := c.Query(&Request{Value: 1})
+			resp2, _ := c.Query(&Request{Value: 2})
+			return []string{resp1.Message, resp2.Message}
+		})
+		gsmockassert.Equal(t, len(ft.errors), 0)
+	}
+
+	// Test case: a catch-all mocker registered last hides the others
+	{
+		ft := &fakeT{}
+		gsmock.CheckOrderIndependence(ft, 10, func(r *gsmock.Manager) any {
+			c := NewMockClient(r)
+			c.MockQuery().WhenArgs(&Request{Value: 1}).ReturnValue(&Response{Message: "one"}, nil)
+			c.MockQuery().ReturnValue(&Response{Message: "any"}, nil)
+			resp, _ := c.Query(&Request{Value: 1})
+			return resp.Message
+		})
+		gsmockassert.Equal(t, len(ft.errors) > 0, true)
+		gsmockassert.Match(t, ft.errors[0], `outcome depends on the order of mockers: registration order gave one, shuffled order \(seed \d+\) gave any`)
+	}
+
+	// Test case: panics are outcomes too
+	{
+		ft := &fakeT{}
+		gsmock.CheckOrderIndependence(ft, 10, func(r *gsmock.Manager) any {
+			c := NewMockClient(r)
+			c.MockQuery().ReturnValue(&Response{Message: "any"}, nil)
+			c.MockQuery().Never()
+			resp, _ := c.Query(&Request{Value: 1})
+			return resp.Message
+		})
+		gsmockassert.Equal(t, len(ft.errors) > 0, true)
+		gsmockassert.Match(t, ft.errors[0], `registration order gave any, shuffled order \(seed \d+\) gave panic: gsmock: forbidden call to .*Query`)
+	}
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// CheckSignature reports whether handler can stand in for calls of fn:
+// it returns nil if handler takes the parameters of fn and returns its
+// results, and otherwise an error listing every mismatch, such as
+//
+//	gsmock: func(int) string does not match func(*Request) (*Response, error):
+//	1 result instead of 2, parameter 1 is int instead of *Request
+//
+// Parameters of handler must accept the arguments of fn and results of
+// handler must be assignable to those of fn. The variadic parameter of fn
+// is passed as a slice, so handler may take it either as a slice or as a
+// variadic parameter of the same element type.
+func CheckSignature(fn any, handler any) error {
+	ft, ht := reflect.TypeOf(fn), reflect.TypeOf(handler)
+	if ft == nil || ft.Kind() != reflect.Func {
+		return fmt.Errorf("gsmock: mock target %s is not a function", typeString(ft))
+	}
+	if ht == nil || ht.Kind() != reflect.Func {
+		return fmt.Errorf("gsmock: handler %s is not a function", typeString(ht))
+	}
+	var mismatches []string
+	if ht.NumIn() != ft.NumIn() {
+		mismatches = append(mismatches, countMismatch(ht.NumIn(), ft.NumIn(), "parameter"))
+	}
+	if ht.NumOut() != ft.NumOut() {
+		mismatches = append(mismatches, countMismatch(ht.NumOut(), ft.NumOut(), "result"))
+	}
+	for i := 0; i < min(ht.NumIn(), ft.NumIn()); i++ {
+		if !ft.In(i).AssignableTo(ht.In(i)) {
+			mismatches = append(mismatches, fmt.Sprintf("parameter %d is %s instead of %s", i+1, ht.In(i), ft.In(i)))
+		}
+	}
+	for i := 0; i < min(ht.NumOut(), ft.NumOut()); i++ {
+		if !ht.Out(i).AssignableTo(ft.Out(i)) {
+			mismatches = append(mismatches, fmt.Sprintf("result %d is %s instead of %s", i+1, ht.Out(i), ft.Out(i)))
+		}
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+	return fmt.Errorf("gsmock: %s does not match %s: %s", ht, ft, strings.Join(mismatches, ", "))
+}
+
+// typeString returns the name of t, or "nil" for the type of nil.
+func typeString(t reflect.Type) string {
+	if t == nil {
+		return "nil"
+	}
+	return t.String()
+}
+
+// countMismatch describes a handler having got instead of want
+// parameters or results.
+func countMismatch(got, want int, what string) string {
+	if got != 1 {
+		what += "s"
+	}
+	return fmt.Sprintf("%d %s instead of %d", got, what, want)
+}
+
+// MethodHandle registers handler as the Handle function of a mock of the
+// method fn of receiver, for hand-written mocks built on Invoke and Unbox
+// whose methods have no generated MethodXY mocker, or have more
+// parameters or results than MaxParamCount. Unlike a mismatched handler
+// of such a mock, which only panics inside Unbox once the method is
+// called, a handler not matching the signature of fn, as reported by
+// CheckSignature, makes MethodHandle panic with the mismatches and the
+// call site.
+func MethodHandle(receiver any, fn any, r *Manager, handler any) {
+	if err := CheckSignature(fn, handler); err != nil || reflect.ValueOf(handler).IsNil() {
+		var pcs [16]uintptr
+		site := callerSite(pcs[:runtime.Callers(2, pcs[:])])
+		if err == nil {
+			err = fmt.Errorf("gsmock: nil function passed to MethodHandle")
+		}
+		panic(fmt.Sprintf("%v at %s", err, site))
+	}
+	m := &funcMocker{fn: reflect.ValueOf(handler), t: reflect.TypeOf(fn)}
+	m.register(r, receiver, fn, m.invoker(func([]any) bool { return true }, m.call))
+}
+
+// funcMocker is the mocker registered by MethodHandle, calling its
+// handler through reflection.
+type funcMocker struct {
+	mockerBase
+	fn reflect.Value // the handler
+	t  reflect.Type  // the type of the mocked method
+}
+
+// call calls the handler with params, nil parameters being passed as the
+// zero value of their type, and returns its results boxed as the result
+// types of the method, so that a nil *T returned as an error is still a
+// nil error.
+func (m *funcMocker) call(params []any) []any {
+	t := m.fn.Type()
+	args := make([]reflect.Value, len(params))
+	for i, p := range params {
+		if p == nil {
+			args[i] = reflect.Zero(t.In(i))
+		} else {
+			args[i] = reflect.ValueOf(p)
+		}
+	}
+	var out []reflect.Value
+	if t.IsVariadic() {
+		out = m.fn.CallSlice(args)
+	} else {
+		out = m.fn.Call(args)
+	}
+	ret := make([]any, len(out))
+	for i, v := range out {
+		ret[i] = v.Convert(m.t.Out(i)).Interface()
+	}
+	return ret
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+type queryError struct{}
+
+func (*queryError) Error() string { return "query error" }
+
+func TestCheckSignature(t *testing.T) {
+	c := NewMockClient(gsmock.NewManager())
+
+	gsmockassert.Nil(t, gsmock.CheckSignature(c.Query, func(req *Request) (*Response, error) {
+		return nil, nil
+	}))
+	gsmockassert.Nil(t, gsmock.CheckSignature(c.Query, func(req any) (*Response, *queryError) {
+		return nil, nil
+	}))
+	gsmockassert.Nil(t, gsmock.CheckSignature(func(...int) {}, func([]int) {}))
+	gsmockassert.Nil(t, gsmock.CheckSignature(func(...int) {}, func(...int) {}))
+
+	err := gsmock.CheckSignature(c.Query, func(v int) string { return "" })
+	gsmockassert.Equal(t, err.Error(), "gsmock: func(int) string does not match "+
+		"func(*gsmock_test.Request) (*gsmock_test.Response, error): "+
+		"1 result instead of 2, parameter 1 is int instead of *gsmock_test.Request, "+
+		"result 1 is string instead of *gsmock_test.Response")
+
+	err = gsmock.CheckSignature(c.Query, func() (*Response, error) { return nil, nil })
+	gsmockassert.Match(t, err.Error(), "0 parameters instead of 1$")
+
+	err = gsmock.CheckSignature(c.Query, "handler")
+	gsmockassert.Equal(t, err.Error(), "gsmock: handler string is not a function")
+}
+
+func TestMethodHandle(t *testing.T) {
+
+	t.Run("handle", func(t *testing.T) {
+		r := gsmock.NewManager()
+		r.EnableRecording(gsmock.RetentionPolicy{})
+		c := NewMockClient(r)
+		gsmock.MethodHandle(c, c.Query, r, func(req *Request) (*Response, *queryError) {
+			if req == nil {
+				return nil, &queryError{}
+			}
+			return &Response{Message: "ok"}, nil
+		})
+
+		resp, err := c.Query(&Request{Value: 1})
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "ok")
+
+		_, err = c.Query(nil)
+		var qe *queryError
+		gsmockassert.Equal(t, errors.As(err, &qe), true)
+		gsmockassert.Called(t, r, c, c.Query, 2)
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		gsmockassert.Panic(t, func() {
+			gsmock.MethodHandle(c, c.Query, r, func(req *Request) *Response {
+				return nil
+			})
+		}, `1 result instead of 2 at .*signature_test.go:\d+$`)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		var handler func(req *Request) (*Response, error)
+		gsmockassert.Panic(t, func() {
+			gsmock.MethodHandle(c, c.Query, r, handler)
+		}, `gsmock: nil function passed to MethodHandle at .*signature_test.go:\d+$`)
+	})
+}
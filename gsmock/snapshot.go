@@ -0,0 +1,192 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sync/atomic"
+)
+
+// Snapshot is an immutable copy of the mocks registered with a Manager,
+// returned by Manager.Freeze. It is shared by the child Managers of
+// parallel tests, which inherit its mocks without registering them again.
+type Snapshot struct {
+	mockers  map[funcKey][]Invoker
+	byType   map[typeKey][]Invoker // mockers of the methods of receivers whose type is unique
+	defaults map[reflect.Type]func() any
+	specs    []*specMock
+	from     *Snapshot   // snapshot inherited by the Manager frozen, nil if none
+	reset    atomic.Bool // whether the Manager frozen was reset since
+}
+
+// ErrSnapshotReset is the error the child Managers of a snapshot panic with
+// once the Manager it was frozen from, or one of its ancestors, was reset.
+var ErrSnapshotReset = errors.New("gsmock: snapshot used after its Manager was reset")
+
+// typeKey identifies a method by the type of its receiver.
+type typeKey struct {
+	typ  reflect.Type
+	fnPC uintptr
+}
+
+// Freeze returns a snapshot of the mocks registered with r, typically a
+// heavyweight fixture shared by the tests of a package, from which the
+// parallel tests create child Managers with Snapshot.Child:
+//
+//	var fixture = func() *gsmock.Snapshot {
+//		r := gsmock.NewManager()
+//		s := NewStoreMockImpl(r)
+//		s.MockGet().Handle(loadUsers())
+//		return r.Freeze()
+//	}()
+//
+//	func TestX(t *testing.T) {
+//		t.Parallel()
+//		r := fixture.Child(t)
+//		s := NewStoreMockImpl(r) // inherits the mocks of the fixture's StoreMockImpl
+//		...
+//	}
+//
+// Mocks registered with r afterward are not part of the snapshot. The
+// mockers of the snapshot are shared by the child Managers, so they must not
+// be configured any further, and those keeping state, such as captors, see
+// the calls of all the tests.
+//
+// Resetting r invalidates the snapshot, and in cascade the snapshots frozen
+// from its children: their child Managers then panic with ErrSnapshotReset
+// on every call, rather than keep calling the mocks r discarded, and Child
+// panics likewise. Freeze r again for new children.
+func (r *Manager) Freeze() *Snapshot {
+	s := &Snapshot{
+		mockers:  make(map[funcKey][]Invoker),
+		byType:   make(map[typeKey][]Invoker),
+		defaults: maps.Clone(r.defaults),
+		specs:    slices.Clone(r.specs),
+		from:     r.snapshot,
+	}
+	if r.snapshot != nil {
+		s.specs = append(s.specs, r.snapshot.specs...)
+	}
+	r.snapshots = append(r.snapshots, s)
+	for k, mockers := range r.mockers {
+		s.mockers[k] = slices.Clone(mockers)
+		if r.snapshot != nil {
+			s.mockers[k] = append(s.mockers[k], r.snapshot.inherited(k)...)
+		}
+	}
+
+	// Methods are inherited by the mocks of the children created for the
+	// same type, as long as a single receiver of the type has mocks.
+	receivers := make(map[reflect.Type]any)
+	for k := range r.mockers {
+		if k.receiver == nil {
+			continue
+		}
+		t := reflect.TypeOf(k.receiver)
+		if other, ok := receivers[t]; ok && other != k.receiver {
+			receivers[t] = nil // ambiguous
+		} else if !ok {
+			receivers[t] = k.receiver
+		}
+	}
+	for k := range r.mockers {
+		if k.receiver == nil {
+			continue
+		}
+		if t := reflect.TypeOf(k.receiver); receivers[t] != nil {
+			s.byType[typeKey{typ: t, fnPC: k.fnPC}] = s.mockers[k]
+		}
+	}
+
+	// The mocks of a child's snapshot are inherited by those of its own
+	// snapshot, after them.
+	if p := r.snapshot; p != nil {
+		for k, mockers := range p.mockers {
+			if _, ok := s.mockers[k]; !ok {
+				s.mockers[k] = mockers
+			}
+		}
+		for k, mockers := range p.byType {
+			if _, ok := s.byType[k]; !ok {
+				s.byType[k] = mockers
+			}
+		}
+		for t, fn := range p.defaults {
+			if _, ok := s.defaults[t]; !ok {
+				if s.defaults == nil {
+					s.defaults = make(map[reflect.Type]func() any)
+				}
+				s.defaults[t] = fn
+			}
+		}
+	}
+	return s
+}
+
+// Child creates a Manager bound to the lifetime of the test t, like
+// NewManagerT, inheriting the mocks of the snapshot. The mocks registered
+// with it take precedence over the inherited ones and are only seen by its
+// own calls, so that parallel tests don't interfere. The calls of the
+// inherited mocks are reported to the child too, e.g. a forbidden call
+// fails t, and ReturnDefault returns the defaults of the child. The calls
+// of mocks created with it for a receiver type that has mocks in the snapshot are
+// dispatched to these mocks too, if a single receiver of that type has
+// mocks in the snapshot. It panics with ErrSnapshotReset if the snapshot
+// was invalidated by a Reset.
+func (s *Snapshot) Child(t TB) *Manager {
+	if s.stale() {
+		panic(fmt.Errorf("%w: Child", ErrSnapshotReset))
+	}
+	r := NewManagerT(t)
+	r.snapshot = s
+	r.defaults = maps.Clone(s.defaults)
+	return r
+}
+
+// inherited returns the mockers of the snapshot applying to the calls of k.
+func (s *Snapshot) inherited(k funcKey) []Invoker {
+	if mockers, ok := s.mockers[k]; ok {
+		return mockers
+	}
+	if k.receiver == nil {
+		return nil
+	}
+	return s.byType[typeKey{typ: reflect.TypeOf(k.receiver), fnPC: k.fnPC}]
+}
+
+// stale reports whether the Manager the snapshot was frozen from, or one
+// of the Managers it inherits mocks from, was reset since.
+func (s *Snapshot) stale() bool {
+	for ; s != nil; s = s.from {
+		if s.reset.Load() {
+			return true
+		}
+	}
+	return false
+}
+
+// invalidateSnapshots invalidates the snapshots frozen from r, see Freeze.
+func (r *Manager) invalidateSnapshots() {
+	for _, s := range r.snapshots {
+		s.reset.Store(true)
+	}
+	r.snapshots = nil
+}
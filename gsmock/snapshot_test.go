@@ -0,0 +1,217 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"testing/fstest"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestSnapshot(t *testing.T) {
+
+	t.Run("inherited", func(t *testing.T) {
+		r := gsmock.NewManager()
+		NewMockClient(r).MockQuery().Handle(func(req *Request) (*Response, error) {
+			return &Response{Message: "fixture"}, nil
+		})
+		snap := r.Freeze()
+
+		child := snap.Child(t)
+		resp, err := NewMockClient(child).Query(&Request{})
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "fixture")
+	})
+
+	t.Run("not after freeze", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		c.MockQuery().When(valueIs(1)).ReturnValue(&Response{Message: "fixture"}, nil)
+		snap := r.Freeze()
+		c.MockQuery().When(valueIs(2)).ReturnValue(&Response{Message: "late"}, nil)
+
+		child := NewMockClient(snap.Child(t))
+		gsmockassert.Panic(t, func() {
+			_, _ = child.Query(&Request{Value: 2})
+		}, "no mock code matched for MockClient.Query")
+	})
+
+	t.Run("ambiguous receivers", func(t *testing.T) {
+		r := gsmock.NewManager()
+		NewMockClient(r).MockQuery().ReturnValue(&Response{Message: "a"}, nil)
+		NewMockClient(r).MockQuery().ReturnValue(&Response{Message: "b"}, nil)
+		snap := r.Freeze()
+
+		child := NewMockClient(snap.Child(t))
+		gsmockassert.Panic(t, func() {
+			_, _ = child.Query(&Request{})
+		}, "no mock code matched for MockClient.Query")
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		r := gsmock.NewManager()
+		NewMockClient(r).MockQuery().When(valueIs(1)).ReturnValue(&Response{Message: "one"}, nil)
+		child := snap2(t, r.Freeze())
+
+		c := NewMockClient(child.Freeze().Child(t))
+		resp, _ := c.Query(&Request{Value: 1})
+		gsmockassert.Equal(t, resp.Message, "one")
+		resp, _ = c.Query(&Request{Value: 2})
+		gsmockassert.Equal(t, resp.Message, "two")
+	})
+
+	t.Run("reset parent", func(t *testing.T) {
+		r := gsmock.NewManager()
+		NewMockClient(r).MockQuery().When(valueIs(1)).ReturnValue(&Response{Message: "one"}, nil)
+		snap := r.Freeze()
+		child := snap2(t, snap)
+		grandchild := child.Freeze().Child(t)
+
+		r.Reset()
+
+		// The children and their own children fail on every call
+		for _, m := range []*gsmock.Manager{child, grandchild} {
+			func() {
+				defer func() {
+					err, ok := recover().(error)
+					gsmockassert.Equal(t, ok, true)
+					gsmockassert.Equal(t, errors.Is(err, gsmock.ErrSnapshotReset), true)
+					gsmockassert.Match(t, err.Error(), `gsmock: snapshot used after its Manager was reset: .*\(\*MockClient\)\.Query`)
+				}()
+				_, _ = NewMockClient(m).Query(&Request{Value: 2})
+			}()
+		}
+	})
+
+	t.Run("reset child", func(t *testing.T) {
+		r := gsmock.NewManager()
+		NewMockClient(r).MockQuery().When(valueIs(1)).ReturnValue(&Response{Message: "one"}, nil)
+		gsmock.MustLoad(r, fstest.MapFS{"three.spec": {Data: []byte(`ClientInterface.Query({"Value": 3}) => {"Message": "three"}, null`)}}, "three.spec")
+		snap := r.Freeze()
+		child := snap2(t, snap)
+		grandSnap := child.Freeze()
+
+		// The child keeps the mocks it inherits, spec ones included, but not its own ones
+		child.Reset()
+		c := NewMockClient(child)
+		resp, _ := c.Query(&Request{Value: 1})
+		gsmockassert.Equal(t, resp.Message, "one")
+		resp, _ = c.Query(&Request{Value: 3})
+		gsmockassert.Equal(t, resp.Message, "three")
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Query(&Request{Value: 2})
+		}, "no mock code matched for MockClient.Query")
+
+		gsmockassert.Panic(t, func() {
+			grandSnap.Child(t)
+		}, "gsmock: snapshot used after its Manager was reset: Child")
+
+		// Siblings are not affected, and new snapshots are valid
+		resp, _ = NewMockClient(snap.Child(t)).Query(&Request{Value: 1})
+		gsmockassert.Equal(t, resp.Message, "one")
+		resp, _ = NewMockClient(child.Freeze().Child(t)).Query(&Request{Value: 1})
+		gsmockassert.Equal(t, resp.Message, "one")
+	})
+
+	t.Run("reported to the child", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		c.MockQuery().When(valueIs(1)).Never()
+		c.MockQuery().When(valueIs(2)).Freeze().ReturnValue(&Response{Message: "frozen"}, nil)
+		c.MockQuery().When(valueIs(3)).ReturnDefault()
+		gsmock.RegisterDefaultFor(r, func() *Response { return &Response{Message: "parent"} })
+		snap := r.Freeze()
+
+		ft := &fakeT{}
+		child := snap.Child(ft)
+		gsmock.RegisterDefaultFor(child, func() *Response { return &Response{Message: "child"} })
+		cc := NewMockClient(child)
+
+		// The inherited mocks fail the child's test, not the parent's
+		_, _ = cc.Query(&Request{Value: 1})
+		gsmockassert.Equal(t, len(ft.errors), 1)
+		gsmockassert.Match(t, ft.errors[0], `^gsmock: forbidden call to .*\(\*MockClient\)\.Query`)
+
+		resp, _ := cc.Query(&Request{Value: 2})
+		resp.Message = "mutated"
+
+		// The inherited mocks return the defaults of the Manager calling them
+		resp, _ = cc.Query(&Request{Value: 3})
+		gsmockassert.Equal(t, resp.Message, "child")
+		resp, _ = NewMockClient(snap.Child(t)).Query(&Request{Value: 3})
+		gsmockassert.Equal(t, resp.Message, "parent")
+
+		ft.finish()
+		gsmockassert.Equal(t, len(ft.errors), 2)
+		gsmockassert.Match(t, ft.errors[1], `gsmock: result 1 \(\*gsmock_test.Response\) of .*\(\*MockClient\)\.Query was mutated after being returned`)
+		gsmockassert.Nil(t, r.Close())
+	})
+}
+
+// snap2 returns a child of snap adding a mock for the requests of value 2.
+func snap2(t *testing.T, snap *gsmock.Snapshot) *gsmock.Manager {
+	child := snap.Child(t)
+	NewMockClient(child).MockQuery().When(valueIs(2)).ReturnValue(&Response{Message: "two"}, nil)
+	return child
+}
+
+// valueIs returns a matcher of the requests of value v.
+func valueIs(v int) func(*Request) bool {
+	return func(req *Request) bool { return req.Value == v }
+}
+
+func TestSnapshotParallel(t *testing.T) {
+	r := gsmock.NewManager()
+	NewMockClient(r).MockQuery().Handle(func(req *Request) (*Response, error) {
+		return &Response{Message: "fixture " + strconv.Itoa(req.Value)}, nil
+	})
+	snap := r.Freeze()
+
+	const calls = 200
+	for i := range 32 {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Parallel()
+			child := snap.Child(t)
+			child.EnableRecording(gsmock.RetentionPolicy{CountOnly: true})
+			c := NewMockClient(child)
+			override := i%2 == 0
+			if override {
+				c.MockQuery().When(valueIs(i)).ReturnValue(&Response{Message: fmt.Sprint("child ", i)}, nil)
+			}
+
+			for n := range calls {
+				v := n % 4 * 32
+				if n%3 == 0 {
+					v = i
+				}
+				resp, err := c.Query(&Request{Value: v})
+				gsmockassert.Nil(t, err)
+				want := "fixture " + strconv.Itoa(v)
+				if v == i && override {
+					want = fmt.Sprint("child ", i)
+				}
+				gsmockassert.Equal(t, resp.Message, want)
+			}
+			gsmockassert.Equal(t, child.CallCount(c, c.Query), calls)
+		})
+	}
+}
@@ -0,0 +1,358 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Load registers with r the mocks declared by the spec file name of fsys,
+// typically embedded in the test binary so that it needs no file path at
+// run time:
+//
+//	//go:embed mocks.spec
+//	var mocks embed.FS
+//
+//	gsmock.MustLoad(r, mocks, "mocks.spec")
+//
+// Each line of the spec declares the results of the calls of a method of
+// an interface whose mock is registered with RegisterMock, as done by the
+// generated code, for the given parameters:
+//
+//	# comments start with #
+//	Store.Get(1) => {"ID": 1, "Name": "alice"}, null
+//	Store.Get(_) => null, "not found"
+//
+// The interface is named as in its package or qualified by it, e.g.
+// repo.Store. Parameters and results are JSON values decoded into their
+// types, a string giving the message of an error result; _ matches any
+// parameter and is required for contexts. A result may also be $N or
+// $N.Path, the parameter N, 1-based, or its field at Path, as with
+// ReturnFieldOfArg, for echo-style mocks:
+//
+//	Store.Save(_, _) => $2.ID, null
+//
+// The declared mocks apply to every
+// mock of the interface created with r, after the mocks registered with
+// it, and the lines are matched in order.
+func Load(r *Manager, fsys fs.FS, name string) error {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return fmt.Errorf("gsmock: %w", err)
+	}
+	var specs []*specMock
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		m, err := parseSpecMock(line)
+		if err != nil {
+			return fmt.Errorf("gsmock: %s:%d: %w", name, n, err)
+		}
+		m.site = fmt.Sprintf("%s:%d", name, n)
+		specs = append(specs, m)
+	}
+	if err = s.Err(); err != nil {
+		return fmt.Errorf("gsmock: %s: %w", name, err)
+	}
+	r.specs = append(r.specs, specs...)
+	clear(r.specCache)
+	return nil
+}
+
+// MustLoad is like Load, but panics on error.
+func MustLoad(r *Manager, fsys fs.FS, name string) {
+	if err := Load(r, fsys, name); err != nil {
+		panic(err)
+	}
+}
+
+// specMock is a mock declared by a line of a spec file.
+type specMock struct {
+	iface  reflect.Type
+	method string
+	args   []any       // parameters to match, anyArg for any value
+	ret    []any       // results of the matched calls
+	fields []*argField // results taken from the parameters, by index
+	site   string      // file:line of the declaration
+}
+
+// anyArg is the parameter of a specMock matching any value.
+var anyArg = new(struct{})
+
+// Invoke returns the results of the mock if the parameters match.
+func (m *specMock) Invoke(params []any) ([]any, bool) {
+	for i, arg := range m.args {
+		if arg != anyArg && !isEqual(params[i], arg) {
+			return nil, false
+		}
+	}
+	ret := slices.Clone(m.ret)
+	for i, f := range m.fields {
+		if f != nil {
+			ret[i] = f.get(params)
+		}
+	}
+	return ret, true
+}
+
+// parseSpecMock parses a line of a spec file, of the form
+// Interface.Method(param, ...) => result, ...
+func parseSpecMock(line string) (*specMock, error) {
+	open := strings.IndexByte(line, '(')
+	end := closingParen(line, open)
+	if open < 0 || end < 0 {
+		return nil, errors.New("expected Interface.Method(params) => results")
+	}
+	rest := strings.TrimSpace(line[end+1:])
+	if !strings.HasPrefix(rest, "=>") {
+		return nil, errors.New("expected => after the parameters")
+	}
+
+	name := strings.TrimSpace(line[:open])
+	dot := strings.LastIndexByte(name, '.')
+	if dot < 0 {
+		return nil, fmt.Errorf("expected Interface.Method, got %q", name)
+	}
+	iface, err := mockedInterface(name[:dot])
+	if err != nil {
+		return nil, err
+	}
+	method, ok := iface.MethodByName(name[dot+1:])
+	if !ok {
+		return nil, fmt.Errorf("interface %s has no method %s", iface, name[dot+1:])
+	}
+	m := &specMock{iface: iface, method: method.Name}
+
+	args := splitSpecValues(line[open+1 : end])
+	if len(args) != method.Type.NumIn() {
+		return nil, fmt.Errorf("%s has %d parameters, got %d", name, method.Type.NumIn(), len(args))
+	}
+	for i, arg := range args {
+		t := method.Type.In(i)
+		if arg == "_" {
+			m.args = append(m.args, anyArg)
+			continue
+		}
+		if t.Implements(reflect.TypeFor[context.Context]()) {
+			return nil, fmt.Errorf("parameter %d of %s is a context, expected _", i+1, name)
+		}
+		v, err := decodeSpecValue(arg, t)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %d of %s: %w", i+1, name, err)
+		}
+		m.args = append(m.args, v)
+	}
+
+	results := splitSpecValues(rest[len("=>"):])
+	if len(results) != method.Type.NumOut() {
+		return nil, fmt.Errorf("%s has %d results, got %d", name, method.Type.NumOut(), len(results))
+	}
+	m.ret = make([]any, 0, len(results))
+	for i, result := range results {
+		if strings.HasPrefix(result, "$") {
+			f, err := parseSpecField(result, method.Type, i+1)
+			if err != nil {
+				return nil, fmt.Errorf("result %d of %s: %w", i+1, name, err)
+			}
+			if m.fields == nil {
+				m.fields = make([]*argField, len(results))
+			}
+			m.fields[i] = f
+			m.ret = append(m.ret, nil)
+			continue
+		}
+		v, err := decodeSpecValue(result, method.Type.Out(i))
+		if err != nil {
+			return nil, fmt.Errorf("result %d of %s: %w", i+1, name, err)
+		}
+		m.ret = append(m.ret, v)
+	}
+	return m, nil
+}
+
+// parseSpecField parses the result into, 1-based, of a method of type t
+// given as $N or $N.Path, the field at Path of its parameter N.
+func parseSpecField(s string, t reflect.Type, into int) (*argField, error) {
+	n, path, _ := strings.Cut(s[1:], ".")
+	arg, err := strconv.Atoi(n)
+	if err != nil {
+		return nil, fmt.Errorf("expected $N or $N.Path, got %s", s)
+	}
+	params := make([]reflect.Type, t.NumIn())
+	for i := range params {
+		params[i] = t.In(i)
+	}
+	results := make([]reflect.Type, t.NumOut())
+	for i := range results {
+		results[i] = t.Out(i)
+	}
+	return newArgField(params, arg, path, results, into)
+}
+
+// mockedInterface returns the interface named name, as in its package or
+// qualified by it, among those whose mock is registered with RegisterMock.
+func mockedInterface(name string) (reflect.Type, error) {
+	constructorMux.RLock()
+	defer constructorMux.RUnlock()
+	var found []reflect.Type
+	for t := range constructors {
+		if t.String() == name || t.Name() == name {
+			found = append(found, t)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no mock registered for interface %s", name)
+	case 1:
+		return found[0], nil
+	default:
+		names := make([]string, len(found))
+		for i, t := range found {
+			names[i] = t.PkgPath() + "." + t.Name()
+		}
+		slices.Sort(names)
+		return nil, fmt.Errorf("interface %s is ambiguous: %s", name, strings.Join(names, ", "))
+	}
+}
+
+// decodeSpecValue decodes the JSON value s into a value of type t. A
+// string gives an error of that message for the error type.
+func decodeSpecValue(s string, t reflect.Type) (any, error) {
+	if t == reflect.TypeFor[error]() {
+		if s == "null" {
+			return nil, nil
+		}
+		var msg string
+		if err := json.Unmarshal([]byte(s), &msg); err != nil {
+			return nil, fmt.Errorf("expected null or the message of the error, got %s", s)
+		}
+		return errors.New(msg), nil
+	}
+	v := reflect.New(t)
+	if err := json.Unmarshal([]byte(s), v.Interface()); err != nil {
+		return nil, err
+	}
+	return v.Elem().Interface(), nil
+}
+
+// closingParen returns the index of the parenthesis closing the one at
+// index open of s, or -1 if there is none.
+func closingParen(s string, open int) int {
+	if open < 0 {
+		return -1
+	}
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			i = stringEnd(s, i)
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitSpecValues splits the comma-separated JSON values of s, ignoring
+// the commas of strings, arrays and objects.
+func splitSpecValues(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	var values []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			i = stringEnd(s, i)
+		case '[', '{':
+			depth++
+		case ']', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				values = append(values, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(values, strings.TrimSpace(s[start:]))
+}
+
+// stringEnd returns the index of the quote ending the JSON string starting
+// at index start of s, or the last index if the string is unterminated.
+func stringEnd(s string, start int) int {
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return len(s) - 1
+}
+
+// specMockers returns the mockers declared by the spec files loaded into r,
+// then into the Managers its snapshot was frozen from, that apply to the
+// calls of k, the method of a mock implementing their interface.
+func (r *Manager) specMockers(k funcKey) []Invoker {
+	var inherited []*specMock
+	if r.snapshot != nil {
+		inherited = r.snapshot.specs
+	}
+	if len(r.specs)+len(inherited) == 0 || k.receiver == nil {
+		return nil
+	}
+	r.specMux.Lock()
+	defer r.specMux.Unlock()
+	if mockers, ok := r.specCache[k]; ok {
+		return mockers
+	}
+	name := funcName(k)
+	method := name[strings.LastIndexByte(name, '.')+1:]
+	t := reflect.TypeOf(k.receiver)
+	var mockers []Invoker
+	for _, m := range slices.Concat(r.specs, inherited) {
+		if m.method == method && t.Implements(m.iface) {
+			mockers = append(mockers, m)
+		}
+	}
+	if r.specCache == nil {
+		r.specCache = make(map[funcKey][]Invoker)
+	}
+	r.specCache[k] = mockers
+	return mockers
+}
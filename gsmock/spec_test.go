@@ -0,0 +1,153 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"embed"
+	"testing"
+	"testing/fstest"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+//go:embed testdata/mocks.spec
+var specs embed.FS
+
+func init() {
+	gsmock.RegisterMock(func(r *gsmock.Manager) Echoer { return &MockEchoer{r} })
+}
+
+// Echoer is an interface whose results echo its parameters.
+type Echoer interface {
+	Echo(req *Request) (int, error)
+}
+
+// MockEchoer is a mock implementation of Echoer.
+type MockEchoer struct {
+	r *gsmock.Manager
+}
+
+// Echo mocks the Echo method by invoking a registered mock implementation.
+func (e *MockEchoer) Echo(req *Request) (int, error) {
+	if ret, ok := gsmock.Invoke(e.r, e, e.Echo, req); ok {
+		return gsmock.Unbox2[int, error](ret)
+	}
+	panic("no mock code matched for MockEchoer.Echo")
+}
+
+func TestLoad(t *testing.T) {
+
+	t.Run("embedded", func(t *testing.T) {
+		r := gsmock.NewManagerT(t)
+		gsmock.MustLoad(r, specs, "testdata/mocks.spec")
+		c := NewMockClient(r)
+
+		resp, err := c.Query(&Request{Value: 1})
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "one")
+
+		resp, err = c.Query(&Request{Value: 2})
+		gsmockassert.Nil(t, resp)
+		gsmockassert.Equal(t, err.Error(), "not found")
+
+		resp, _ = c.Query(&Request{Value: 3})
+		gsmockassert.Equal(t, resp.Message, `any, "quoted"`)
+	})
+
+	t.Run("registered first", func(t *testing.T) {
+		r := gsmock.NewManagerT(t)
+		gsmock.MustLoad(r, specs, "testdata/mocks.spec")
+		c := NewMockClient(r)
+		c.MockQuery().When(valueIs(1)).ReturnValue(&Response{Message: "registered"}, nil)
+
+		resp, _ := c.Query(&Request{Value: 1})
+		gsmockassert.Equal(t, resp.Message, "registered")
+		resp, _ = c.Query(&Request{Value: 3})
+		gsmockassert.Equal(t, resp.Message, `any, "quoted"`)
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		r := gsmock.NewManager()
+		gsmock.MustLoad(r, specs, "testdata/mocks.spec")
+		child := r.Freeze().Child(t)
+		c := NewMockClient(child)
+
+		resp, _ := c.Query(&Request{Value: 1})
+		gsmockassert.Equal(t, resp.Message, "one")
+
+		// The spec mocks are inherited through the snapshots of children
+		resp, _ = NewMockClient(child.Freeze().Child(t)).Query(&Request{Value: 1})
+		gsmockassert.Equal(t, resp.Message, "one")
+
+		// and survive the Reset of a child, like the inherited mockers
+		child.Reset()
+		resp, _ = c.Query(&Request{Value: 1})
+		gsmockassert.Equal(t, resp.Message, "one")
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		r := gsmock.NewManagerT(t)
+		gsmock.MustLoad(r, specs, "testdata/mocks.spec")
+		c := NewMockClient(r)
+		_, _ = c.Query(&Request{Value: 1})
+
+		r.Reset()
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Query(&Request{Value: 1})
+		}, "no mock code matched for MockClient.Query")
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		r := gsmock.NewManagerT(t)
+		gsmock.MustLoad(r, fstest.MapFS{"echo.spec": {Data: []byte(`Echoer.Echo(_) => $1.Value, null`)}}, "echo.spec")
+		e := &MockEchoer{r}
+
+		n, err := e.Echo(&Request{Value: 7})
+		gsmockassert.Equal(t, n, 7)
+		gsmockassert.Nil(t, err)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, c := range []struct {
+			spec string
+			err  string
+		}{
+			{"ClientInterface.Query", `^gsmock: mocks.spec:1: expected Interface\.Method\(params\) => results$`},
+			{"ClientInterface.Query(_)", `^gsmock: mocks.spec:1: expected => after the parameters$`},
+			{"Query(_) => null, null", `^gsmock: mocks.spec:1: expected Interface\.Method, got "Query"$`},
+			{"Unknown.Query(_) => null, null", `^gsmock: mocks.spec:1: no mock registered for interface Unknown$`},
+			{"ClientInterface.Get(_) => null, null", `^gsmock: mocks.spec:1: interface gsmock_test.ClientInterface has no method Get$`},
+			{"ClientInterface.Query(_, _) => null, null", `^gsmock: mocks.spec:1: ClientInterface.Query has 1 parameters, got 2$`},
+			{"ClientInterface.Query(_) => null", `^gsmock: mocks.spec:1: ClientInterface.Query has 2 results, got 1$`},
+			{"\n# comment\nClientInterface.Query(1) => null, null", `^gsmock: mocks.spec:3: parameter 1 of ClientInterface.Query: json: cannot unmarshal number`},
+			{"ClientInterface.Query(_) => null, 1", `^gsmock: mocks.spec:1: result 2 of ClientInterface.Query: expected null or the message of the error, got 1$`},
+			{"ClientInterface.Query(_) => $x, null", `^gsmock: mocks.spec:1: result 1 of ClientInterface.Query: expected \$N or \$N.Path, got \$x$`},
+			{"ClientInterface.Query(_) => $1, null", `^gsmock: mocks.spec:1: result 1 of ClientInterface.Query: cannot return \*gsmock_test.Request as result 1 of type \*gsmock_test.Response$`},
+			{"ClientInterface.Query(_) => null, $1.Name", `^gsmock: mocks.spec:1: result 2 of ClientInterface.Query: invalid path "Name": gsmock_test.Request has no field Name$`},
+		} {
+			r := gsmock.NewManager()
+			err := gsmock.Load(r, fstest.MapFS{"mocks.spec": {Data: []byte(c.spec)}}, "mocks.spec")
+			gsmockassert.Match(t, err.Error(), c.err)
+		}
+
+		r := gsmock.NewManager()
+		gsmockassert.Panic(t, func() {
+			gsmock.MustLoad(r, fstest.MapFS{}, "mocks.spec")
+		}, `gsmock: open mocks.spec: file does not exist`)
+	})
+}
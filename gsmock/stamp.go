@@ -0,0 +1,67 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	stampMux sync.Mutex
+	stamps   = make(map[string][]string) // stamps of the generated mocks by interface
+)
+
+// stampKey identifies the interface I, all instantiations of a generic
+// interface sharing the same key.
+func stampKey[I any]() string {
+	t := reflect.TypeFor[I]()
+	name, _, _ := strings.Cut(t.String(), "[")
+	return t.PkgPath() + " " + name
+}
+
+// RegisterStamp is called by generated mock constructors with the stamp
+// of the interface I they were generated from, a hash of its signature.
+// Mocks of I generated from different versions of it register different
+// stamps, which Unmatched reports.
+func RegisterStamp[I any](stamp string) {
+	k := stampKey[I]()
+	stampMux.Lock()
+	defer stampMux.Unlock()
+	if !slices.Contains(stamps[k], stamp) {
+		stamps[k] = append(stamps[k], stamp)
+	}
+}
+
+// Unmatched returns the message generated mocks of I panic with when no
+// registered mock matches a call of method, given the stamp of I they
+// were generated from. If mocks of I were generated from other versions
+// of it, the message advises regenerating them.
+func Unmatched[I any](method string, stamp string) string {
+	msg := fmt.Sprintf("no mock code matched for %s (interface stamp %s)", method, stamp)
+	stampMux.Lock()
+	others := slices.DeleteFunc(slices.Clone(stamps[stampKey[I]()]), func(s string) bool { return s == stamp })
+	stampMux.Unlock()
+	if len(others) > 0 {
+		msg += fmt.Sprintf("; mocks of %s were also generated from versions of it stamped %s: mocks may be stale; run go generate",
+			reflect.TypeFor[I](), strings.Join(others, ", "))
+	}
+	return msg
+}
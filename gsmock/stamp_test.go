@@ -0,0 +1,51 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+type Stamped interface {
+	Do()
+}
+
+type GenericStamped[T any] interface {
+	Do(T)
+}
+
+func TestUnmatched(t *testing.T) {
+
+	gsmock.RegisterStamp[Stamped]("0000aaaa")
+	gsmockassert.Equal(t, gsmock.Unmatched[Stamped]("StampedMockImpl.Do", "0000aaaa"),
+		"no mock code matched for StampedMockImpl.Do (interface stamp 0000aaaa)")
+
+	gsmock.RegisterStamp[Stamped]("0000bbbb")
+	gsmockassert.Equal(t, gsmock.Unmatched[Stamped]("StampedMockImpl.Do", "0000aaaa"),
+		"no mock code matched for StampedMockImpl.Do (interface stamp 0000aaaa); "+
+			"mocks of gsmock_test.Stamped were also generated from versions of it stamped 0000bbbb: "+
+			"mocks may be stale; run go generate")
+
+	// Instantiations of a generic interface share their stamps
+	gsmock.RegisterStamp[GenericStamped[int]]("0000cccc")
+	gsmock.RegisterStamp[GenericStamped[string]]("0000dddd")
+	gsmockassert.Match(t, gsmock.Unmatched[GenericStamped[int]]("GenericStampedMockImpl.Do", "0000cccc"),
+		"stamped 0000dddd: mocks may be stale")
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdmatch
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+// RequestBody returns a predicate accepting the requests whose body is
+// accepted by fn. A body that can't be rewound is read and replaced by a
+// reader of its content, closing the original one, so that the handler of
+// the matched mock reads it from the request as usual.
+func RequestBody(fn func(body []byte) bool) func(*http.Request) bool {
+	return func(req *http.Request) bool {
+		if req == nil || req.Body == nil || req.Body == http.NoBody {
+			return fn(nil)
+		}
+		if _, ok := req.Body.(io.Seeker); !ok {
+			b, err := io.ReadAll(req.Body)
+			req.Body = readCloser{Reader: bytes.NewReader(b), Closer: req.Body}
+			if err != nil {
+				return false
+			}
+		}
+		b, err := Peek(req.Body)
+		return err == nil && fn(b)
+	}
+}
+
+// readCloser is the body of a request read by RequestBody, closing the
+// original body.
+type readCloser struct {
+	*bytes.Reader
+	io.Closer
+}
+
+// ResponseRecorder records the responses written to the writers returned
+// by Tee, while they are written to the original writers too. It suits
+// the handlers of mocked methods taking an http.ResponseWriter, such as
+// those delegating to real implementations, whose responses the test
+// inspects afterward.
+//
+// ResponseRecorder is safe for concurrent use.
+type ResponseRecorder struct {
+	mux  sync.Mutex
+	recs []*httptest.ResponseRecorder
+}
+
+// Tee returns a writer writing to w, whose response is recorded.
+func (rr *ResponseRecorder) Tee(w http.ResponseWriter) http.ResponseWriter {
+	rec := httptest.NewRecorder()
+	rr.mux.Lock()
+	rr.recs = append(rr.recs, rec)
+	rr.mux.Unlock()
+	return &teeWriter{w: w, rec: rec}
+}
+
+// Responses returns the responses recorded so far, in the order of the
+// calls to Tee.
+func (rr *ResponseRecorder) Responses() []*http.Response {
+	rr.mux.Lock()
+	defer rr.mux.Unlock()
+	ret := make([]*http.Response, len(rr.recs))
+	for k, rec := range rr.recs {
+		ret[k] = rec.Result()
+	}
+	return ret
+}
+
+// Last returns the response recorded by the last call to Tee,
+// or nil if Tee wasn't called.
+func (rr *ResponseRecorder) Last() *http.Response {
+	rr.mux.Lock()
+	defer rr.mux.Unlock()
+	if n := len(rr.recs); n > 0 {
+		return rr.recs[n-1].Result()
+	}
+	return nil
+}
+
+// teeWriter writes a response to w and records it into rec. The header is
+// that of w, copied to rec when the status code is written.
+type teeWriter struct {
+	w           http.ResponseWriter
+	rec         *httptest.ResponseRecorder
+	wroteHeader bool
+}
+
+func (t *teeWriter) Header() http.Header {
+	return t.w.Header()
+}
+
+func (t *teeWriter) WriteHeader(code int) {
+	if !t.wroteHeader {
+		t.wroteHeader = true
+		h := t.rec.Header()
+		for k, v := range t.w.Header() {
+			h[k] = append([]string(nil), v...)
+		}
+	}
+	t.rec.WriteHeader(code)
+	t.w.WriteHeader(code)
+}
+
+func (t *teeWriter) Write(b []byte) (int, error) {
+	if !t.wroteHeader {
+		t.WriteHeader(http.StatusOK)
+	}
+	_, _ = t.rec.Write(b)
+	return t.w.Write(b)
+}
+
+// Flush flushes w if it supports it.
+func (t *teeWriter) Flush() {
+	if f, ok := t.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns w, for http.ResponseController.
+func (t *teeWriter) Unwrap() http.ResponseWriter {
+	return t.w
+}
@@ -0,0 +1,207 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package stdmatch provides predicates and capture helpers for the
+// parameters of mocked methods whose types are common interfaces of the
+// standard library, such as io.Reader and http.ResponseWriter. Predicates
+// inspect the content of readers without consuming it, so that the mocks
+// evaluated next, and the handler of the matched one, still read it.
+package stdmatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/go-spring/gs-mock/gsmock"
+)
+
+// drainedReader is the content of a reader drained by Peek, with the
+// number of the scopes it was drained in which are still active.
+type drainedReader struct {
+	content []byte
+	scopes  int
+}
+
+// scope lists the readers drained by Peek while a test scoped by Scope runs.
+type scope struct {
+	readers []io.Reader
+}
+
+var (
+	drainedMux sync.Mutex
+	drained    = make(map[io.Reader]*drainedReader) // readers drained by Peek which can't be rewound
+	scopes     = make(map[*scope]struct{})          // scopes of the running tests
+)
+
+// Scope makes Peek forget the content of the readers drained while the
+// test t runs, once t and its subtests complete, instead of remembering it
+// until the process exits. Readers drained while several tests are scoped,
+// e.g. running in parallel, are forgotten once all of them complete.
+func Scope(t gsmock.TB) {
+	s := &scope{}
+	drainedMux.Lock()
+	scopes[s] = struct{}{}
+	drainedMux.Unlock()
+	t.Cleanup(func() {
+		drainedMux.Lock()
+		defer drainedMux.Unlock()
+		delete(scopes, s)
+		for _, r := range s.readers {
+			if d := drained[r]; d != nil {
+				if d.scopes--; d.scopes == 0 {
+					delete(drained, r)
+				}
+			}
+		}
+	})
+}
+
+// loadDrained returns the content of r remembered by Peek, if any.
+func loadDrained(r io.Reader) ([]byte, bool) {
+	drainedMux.Lock()
+	defer drainedMux.Unlock()
+	if d, ok := drained[r]; ok {
+		return d.content, true
+	}
+	return nil, false
+}
+
+// storeDrained remembers b as the content of r, drained by Peek, in the
+// active scopes, and returns the content remembered for r.
+func storeDrained(r io.Reader, b []byte) []byte {
+	drainedMux.Lock()
+	defer drainedMux.Unlock()
+	if d, ok := drained[r]; ok {
+		return d.content // drained concurrently
+	}
+	drained[r] = &drainedReader{content: b, scopes: len(scopes)}
+	for s := range scopes {
+		s.readers = append(s.readers, r)
+	}
+	return b
+}
+
+// Peek returns the unread content of r without consuming it, so that the
+// predicates of several mocks and the handler of the matched one can all
+// inspect it. Readers that can be rewound, i.e. *bytes.Buffer and the
+// io.Seeker implementations such as *bytes.Reader, *strings.Reader and
+// *os.File, are left unchanged. Other readers are drained by the first
+// Peek, which remembers their content for the next ones: their consumers
+// must then read it from Peek or Reopen instead of r. The content is
+// remembered until the process exits, unless the test is scoped by Scope.
+func Peek(r io.Reader) ([]byte, error) {
+	switch x := r.(type) {
+	case nil:
+		return nil, nil
+	case *bytes.Buffer:
+		return bytes.Clone(x.Bytes()), nil
+	case io.Seeker:
+		offset, err := x.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		b, err := io.ReadAll(r)
+		if _, err2 := x.Seek(offset, io.SeekStart); err == nil {
+			err = err2
+		}
+		return b, err
+	}
+	if !reflect.TypeOf(r).Comparable() {
+		return io.ReadAll(r) // can't be remembered
+	}
+	if b, ok := loadDrained(r); ok {
+		return b, nil
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return b, err
+	}
+	return storeDrained(r, b), nil
+}
+
+// Reopen returns a reader of the content returned by Peek for r, to be
+// read instead of r when r can't be rewound. It returns a reader failing
+// with the error of Peek, if any.
+func Reopen(r io.Reader) io.Reader {
+	b, err := Peek(r)
+	if err != nil {
+		return io.MultiReader(bytes.NewReader(b), errReader{err})
+	}
+	return bytes.NewReader(b)
+}
+
+// errReader is a reader failing with err.
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// Body returns a predicate accepting the readers whose content, peeked
+// by Peek, is accepted by fn. Readers failing to be read are rejected.
+// R is the type of the parameter, e.g. io.Reader or io.ReadCloser.
+func Body[R io.Reader](fn func(body []byte) bool) func(R) bool {
+	return func(r R) bool {
+		b, err := Peek(r)
+		return err == nil && fn(b)
+	}
+}
+
+// BodyEq returns a predicate accepting the readers whose content is s.
+func BodyEq[R io.Reader](s string) func(R) bool {
+	return Body[R](func(body []byte) bool {
+		return string(body) == s
+	})
+}
+
+// BodyContains returns a predicate accepting the readers whose content
+// contains s.
+func BodyContains[R io.Reader](s string) func(R) bool {
+	return Body[R](func(body []byte) bool {
+		return bytes.Contains(body, []byte(s))
+	})
+}
+
+// BodyJSON returns a predicate accepting the readers whose content is a
+// JSON document equal to the JSON encoding of v, whatever the formatting
+// and the order of object keys.
+func BodyJSON[R io.Reader](v any) func(R) bool {
+	expect := normalizeJSON(v)
+	return Body[R](func(body []byte) bool {
+		var got any
+		if err := json.Unmarshal(body, &got); err != nil {
+			return false
+		}
+		return reflect.DeepEqual(got, expect)
+	})
+}
+
+// normalizeJSON returns the generic form of the JSON encoding of v.
+// It panics if v can't be encoded.
+func normalizeJSON(v any) any {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic("stdmatch: cannot encode JSON: " + err.Error())
+	}
+	var ret any
+	_ = json.Unmarshal(b, &ret)
+	return ret
+}
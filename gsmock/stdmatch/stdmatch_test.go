@@ -0,0 +1,203 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdmatch_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+	"github.com/go-spring/gs-mock/gsmock/stdmatch"
+)
+
+type Store interface {
+	Put(r io.Reader) (int, error)
+	Serve(w http.ResponseWriter, req *http.Request)
+}
+
+type StoreMock struct {
+	r *gsmock.Manager
+}
+
+func (m *StoreMock) Put(r io.Reader) (int, error) {
+	if ret, ok := gsmock.Invoke(m.r, m, m.Put, r); ok {
+		return gsmock.Unbox2[int, error](ret)
+	}
+	panic("no mock code matched for StoreMock.Put")
+}
+
+func (m *StoreMock) MockPut() *gsmock.Mocker12[io.Reader, int, error] {
+	return gsmock.Method12(m, m.Put, m.r)
+}
+
+func (m *StoreMock) Serve(w http.ResponseWriter, req *http.Request) {
+	if _, ok := gsmock.Invoke(m.r, m, m.Serve, w, req); ok {
+		return
+	}
+	panic("no mock code matched for StoreMock.Serve")
+}
+
+func (m *StoreMock) MockServe() *gsmock.Mocker20[http.ResponseWriter, *http.Request] {
+	return gsmock.Method20(m, m.Serve, m.r)
+}
+
+func TestPeek(t *testing.T) {
+
+	// Rewindable readers are left unchanged
+	br := bytes.NewReader([]byte("abc"))
+	_, _ = br.ReadByte()
+	b, err := stdmatch.Peek(br)
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, string(b), "bc")
+	rest, _ := io.ReadAll(br)
+	gsmockassert.Equal(t, string(rest), "bc")
+
+	buf := bytes.NewBufferString("xyz")
+	b, err = stdmatch.Peek(buf)
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, string(b), "xyz")
+	gsmockassert.Equal(t, buf.String(), "xyz")
+
+	// Other readers are drained once, and remembered
+	r := io.MultiReader(strings.NewReader("hello"))
+	b, err = stdmatch.Peek(r)
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, string(b), "hello")
+	b, err = stdmatch.Peek(r)
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, string(b), "hello")
+	rest, _ = io.ReadAll(stdmatch.Reopen(r))
+	gsmockassert.Equal(t, string(rest), "hello")
+
+	b, err = stdmatch.Peek(nil)
+	gsmockassert.Nil(t, err)
+	gsmockassert.Nil(t, b)
+}
+
+func TestScope(t *testing.T) {
+	r := io.MultiReader(strings.NewReader("hello"))
+
+	t.Run("outer", func(t *testing.T) {
+		stdmatch.Scope(t)
+		t.Run("inner", func(t *testing.T) {
+			stdmatch.Scope(t)
+			b, err := stdmatch.Peek(r)
+			gsmockassert.Nil(t, err)
+			gsmockassert.Equal(t, string(b), "hello")
+		})
+
+		// The reader is remembered until all the scopes it was drained in end
+		b, err := stdmatch.Peek(r)
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, string(b), "hello")
+	})
+
+	// The drained reader is forgotten, and peeked as empty
+	b, err := stdmatch.Peek(r)
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, string(b), "")
+}
+
+func TestBody(t *testing.T) {
+	r := gsmock.NewManager()
+	m := &StoreMock{r: r}
+
+	// The predicates evaluated before the matched mock don't consume the body
+	m.MockPut().
+		When(stdmatch.BodyEq[io.Reader]("ping")).
+		ReturnValue(0, nil)
+	m.MockPut().
+		When(stdmatch.BodyJSON[io.Reader](map[string]int{"a": 1, "b": 2})).
+		Handle(func(r io.Reader) (int, error) {
+			b, err := io.ReadAll(stdmatch.Reopen(r))
+			return len(b), err
+		})
+	m.MockPut().
+		When(stdmatch.BodyContains[io.Reader]("hello")).
+		Handle(func(r io.Reader) (int, error) {
+			b, err := io.ReadAll(stdmatch.Reopen(r))
+			return len(b), err
+		})
+
+	n, err := m.Put(io.MultiReader(strings.NewReader("say hello")))
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, n, 9)
+
+	n, err = m.Put(io.MultiReader(strings.NewReader(`{ "b": 2, "a": 1 }`)))
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, n, 18)
+
+	br := strings.NewReader("ping")
+	n, err = m.Put(br)
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, n, 0)
+	gsmockassert.Equal(t, br.Len(), 4)
+
+	gsmockassert.Panic(t, func() {
+		_, _ = m.Put(strings.NewReader("pong"))
+	}, "no mock code matched for StoreMock.Put")
+}
+
+func TestRequestBody(t *testing.T) {
+	r := gsmock.NewManager()
+	m := &StoreMock{r: r}
+
+	var rr stdmatch.ResponseRecorder
+	m.MockServe().
+		When(func(w http.ResponseWriter, req *http.Request) bool {
+			return stdmatch.RequestBody(func(body []byte) bool {
+				return string(body) == "ignored"
+			})(req)
+		}).
+		Handle(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+	m.MockServe().
+		When(func(w http.ResponseWriter, req *http.Request) bool {
+			return stdmatch.RequestBody(func(body []byte) bool {
+				return bytes.HasPrefix(body, []byte("echo "))
+			})(req)
+		}).
+		Handle(func(w http.ResponseWriter, req *http.Request) {
+			// The handler reads the body from the request as usual
+			w = rr.Tee(w)
+			b, _ := io.ReadAll(req.Body)
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write(b[len("echo "):])
+		})
+
+	gsmockassert.Nil(t, rr.Last())
+
+	body := io.NopCloser(io.MultiReader(strings.NewReader("echo hi")))
+	w := httptest.NewRecorder()
+	m.Serve(w, httptest.NewRequest(http.MethodPost, "/", body))
+	gsmockassert.Equal(t, w.Code, http.StatusOK)
+	gsmockassert.Equal(t, w.Body.String(), "hi")
+
+	// The response is recorded, and written to the original writer too
+	resps := rr.Responses()
+	gsmockassert.Equal(t, len(resps), 1)
+	gsmockassert.Equal(t, resps[0].StatusCode, http.StatusOK)
+	gsmockassert.Equal(t, resps[0].Header.Get("Content-Type"), "text/plain")
+	b, _ := io.ReadAll(rr.Last().Body)
+	gsmockassert.Equal(t, string(b), "hi")
+}
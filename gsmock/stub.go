@@ -0,0 +1,106 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Stub registers the responses of a method shaped like
+// Do(ctx, req Req) (Resp, error), the common pattern of clients, to the
+// calls whose request matches a given one.
+type Stub[Req, Resp any] struct {
+	m *Mocker22[context.Context, Req, Resp, error]
+}
+
+// NewStub returns a Stub of the method mocked by m, for the calls whose
+// request matches req. The requests match if they are equal, honoring
+// comparers registered via RegisterComparer, unless Req is a struct, or a
+// pointer to one, whose fields are tagged with `gsmock:"match"`: only
+// those fields are compared then, e.g. to ignore request IDs and
+// timestamps. It panics if a tagged field is not exported.
+func NewStub[Req, Resp any](m *Mocker22[context.Context, Req, Resp, error], req Req) *Stub[Req, Resp] {
+	m.WhenReq(matchRequest(req))
+	return &Stub[Req, Resp]{m: m}
+}
+
+// Respond makes the matched calls return resp and a nil error.
+func (s *Stub[Req, Resp]) Respond(resp Resp) {
+	s.m.ReturnValue(resp, nil)
+}
+
+// Fail makes the matched calls return a zero response and err.
+func (s *Stub[Req, Resp]) Fail(err error) {
+	var zero Resp
+	s.m.ReturnValue(zero, err)
+}
+
+// matchRequest returns the predicate accepting the requests matching req.
+func matchRequest[Req any](req Req) func(Req) bool {
+	fields := matchedFields(reflect.TypeFor[Req]())
+	if fields == nil {
+		return Eq(req)
+	}
+	want := reflect.ValueOf(&req).Elem()
+	return func(got Req) bool {
+		return fieldsEqual(want, reflect.ValueOf(&got).Elem(), fields)
+	}
+}
+
+// matchedFields returns the indexes of the fields of t, a struct or a
+// pointer to one, tagged with `gsmock:"match"`, or nil if there are none.
+func matchedFields(t reflect.Type) []int {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	var ret []int
+	for k := 0; k < t.NumField(); k++ {
+		f := t.Field(k)
+		if !slices.Contains(strings.Split(f.Tag.Get("gsmock"), ","), "match") {
+			continue
+		}
+		if !f.IsExported() {
+			panic(fmt.Sprintf("gsmock: field %s of %s is tagged match but not exported", f.Name, t))
+		}
+		ret = append(ret, k)
+	}
+	return ret
+}
+
+// fieldsEqual reports whether the fields of the structs, or pointers to
+// structs, want and got are equal. Nil pointers only equal nil pointers.
+func fieldsEqual(want, got reflect.Value, fields []int) bool {
+	if want.Kind() == reflect.Pointer {
+		if want.IsNil() || got.IsNil() {
+			return want.IsNil() && got.IsNil()
+		}
+		want, got = want.Elem(), got.Elem()
+	}
+	for _, k := range fields {
+		if !isEqual(want.Field(k).Interface(), got.Field(k).Interface()) {
+			return false
+		}
+	}
+	return true
+}
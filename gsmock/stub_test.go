@@ -0,0 +1,92 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+type SearchRequest struct {
+	RequestID string
+	Query     string `gsmock:"match"`
+	Page      int    `gsmock:"match"`
+}
+
+type SearchResponse struct {
+	Hits []string
+}
+
+type SearchClientMock struct {
+	r *gsmock.Manager
+}
+
+func (m *SearchClientMock) Search(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
+	if ret, ok := gsmock.Invoke(m.r, m, m.Search, ctx, req); ok {
+		return gsmock.Unbox2[*SearchResponse, error](ret)
+	}
+	panic("no mock code matched for SearchClientMock.Search")
+}
+
+func (m *SearchClientMock) MockSearch() *gsmock.Mocker22[context.Context, *SearchRequest, *SearchResponse, error] {
+	return gsmock.Method22(m, m.Search, m.r)
+}
+
+func (m *SearchClientMock) StubSearch(req *SearchRequest) *gsmock.Stub[*SearchRequest, *SearchResponse] {
+	return gsmock.NewStub(m.MockSearch(), req)
+}
+
+func TestStub(t *testing.T) {
+
+	t.Run("tagged fields", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := &SearchClientMock{r: r}
+		errNotFound := errors.New("not found")
+		c.StubSearch(&SearchRequest{Query: "go", Page: 1}).Respond(&SearchResponse{Hits: []string{"gs-mock"}})
+		c.StubSearch(&SearchRequest{Query: "go", Page: 2}).Fail(errNotFound)
+
+		// The untagged fields are ignored
+		resp, err := c.Search(t.Context(), &SearchRequest{RequestID: "r1", Query: "go", Page: 1})
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Hits, []string{"gs-mock"})
+
+		resp, err = c.Search(t.Context(), &SearchRequest{RequestID: "r2", Query: "go", Page: 2})
+		gsmockassert.ErrorIs(t, err, errNotFound)
+		gsmockassert.Nil(t, resp)
+
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Search(t.Context(), &SearchRequest{Query: "rust", Page: 1})
+		}, "no mock code matched for SearchClientMock.Search")
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Search(t.Context(), nil)
+		}, "no mock code matched for SearchClientMock.Search")
+	})
+
+	t.Run("unexported field", func(t *testing.T) {
+		type request struct {
+			id string `gsmock:"match"`
+		}
+		var m *gsmock.Mocker22[context.Context, request, int, error]
+		gsmockassert.Panic(t, func() {
+			gsmock.NewStub(m, request{id: "a"})
+		}, "gsmock: field id of .*request is tagged match but not exported")
+	})
+}
@@ -0,0 +1,30 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package gsmock
+
+// Swap sets *p to v until the end of the test t, then restores its
+// original value. The generated mocks of function variables use it to
+// substitute a mock for the function, e.g. for "var now = time.Now":
+//
+//	mockNow(t, r).ReturnValue(fixedTime)
+//
+// Like mock registration, it must not happen concurrently with reads of
+// *p, so tests swapping package-level variables can't run in parallel.
+func Swap[T any](t TB, p *T, v T) {
+	orig := *p
+	*p = v
+	t.Cleanup(func() { *p = orig })
+}
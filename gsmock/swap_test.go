@@ -0,0 +1,34 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestSwap(t *testing.T) {
+	v := 1
+	ft := &fakeT{}
+	gsmock.Swap(ft, &v, 2)
+	gsmockassert.Equal(t, v, 2)
+	gsmock.Swap(ft, &v, 3)
+	gsmockassert.Equal(t, v, 3)
+	ft.finish()
+	gsmockassert.Equal(t, v, 1)
+}
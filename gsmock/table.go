@@ -0,0 +1,311 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// tableTag is the struct tag mapping the fields of the rows of a table
+// to its columns and to the parameters or results of a mocked function,
+// e.g. `gsmock:"from,arg2"` or `gsmock:"rate,result1"`.
+const tableTag = "gsmock"
+
+// tableField is a field of a row mapped to a column and to a parameter
+// or a result.
+type tableField struct {
+	index  int    // index of the field in the row
+	column string // name of the column
+	result bool   // whether the field is a result, or else a parameter
+	n      int    // index of the parameter or result
+}
+
+// tableFields returns the fields of the rows of type t mapped by tableTag.
+func tableFields(t reflect.Type) ([]tableField, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("gsmock: table rows must be structs, not %s", t)
+	}
+	var ret []tableField
+	for i := range t.NumField() {
+		tag, ok := t.Field(i).Tag.Lookup(tableTag)
+		if !ok {
+			continue
+		}
+		column, role, _ := strings.Cut(tag, ",")
+		f := tableField{index: i, column: column}
+		var num string
+		if num, ok = strings.CutPrefix(role, "arg"); !ok {
+			num, f.result = strings.CutPrefix(role, "result")
+		}
+		n, err := strconv.Atoi(num)
+		if column == "" || err != nil || n < 1 {
+			return nil, fmt.Errorf("gsmock: field %s of %s has tag %q, not \"column,argN\" or \"column,resultN\"",
+				t.Field(i).Name, t, tag)
+		}
+		f.n = n - 1
+		ret = append(ret, f)
+	}
+	return ret, nil
+}
+
+// ReadTable reads the rows of a table with a header line naming its
+// columns, such as a lookup table of currency rates maintained as a
+// spreadsheet, to be registered as mocks by ReturnRows. The columns are
+// separated by comma, e.g. ',' for CSV or '\t' for TSV files.
+//
+// The fields of Row tagged with `gsmock:"column,argN"` or
+// `gsmock:"column,resultN"` are read from the named columns, and the
+// other columns are ignored. Strings, booleans, numbers, durations and
+// the types implementing encoding.TextUnmarshaler are supported, and
+// empty cells are read as zero values.
+func ReadTable[Row any](r io.Reader, comma rune) ([]Row, error) {
+	t := reflect.TypeFor[Row]()
+	fields, err := tableFields(t)
+	if err != nil {
+		return nil, err
+	}
+	cr := csv.NewReader(r)
+	cr.Comma = comma
+	cr.LazyQuotes = comma == '\t'
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("gsmock: error reading table header: %w", err)
+	}
+	columns := make([]int, len(fields))
+	for i, f := range fields {
+		if columns[i] = indexOf(header, f.column); columns[i] < 0 {
+			return nil, fmt.Errorf("gsmock: table has no column %q", f.column)
+		}
+	}
+	var rows []Row
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("gsmock: error reading table: %w", err)
+		}
+		var row Row
+		v := reflect.ValueOf(&row).Elem()
+		for i, f := range fields {
+			if err = parseCell(v.Field(f.index), record[columns[i]]); err != nil {
+				line, _ := cr.FieldPos(columns[i])
+				return nil, fmt.Errorf("gsmock: table line %d, column %q: %w", line, f.column, err)
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+// indexOf returns the index of s in ss, or -1 if absent.
+func indexOf(ss []string, s string) int {
+	for i, x := range ss {
+		if strings.TrimSpace(x) == s {
+			return i
+		}
+	}
+	return -1
+}
+
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// parseCell parses the text of a cell into v.
+func parseCell(v reflect.Value, s string) error {
+	if s = strings.TrimSpace(s); s == "" {
+		return nil
+	}
+	if v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	if v.Type() == reflect.TypeFor[time.Duration]() {
+		d, err := time.ParseDuration(s)
+		v.SetInt(int64(d))
+		return err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 0, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 0, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// ReturnRows registers the rows of a table, such as those read by
+// ReadTable, as the mock of the function of m: a call whose parameters
+// mapped by the rows equal those of a row returns the results of the
+// row, and the results not mapped are zero values. Other calls are not
+// matched by m. For instance, for a mocked method
+// Rate(ctx context.Context, from, to string) (float64, error):
+//
+//	type rateRow struct {
+//		From string  `gsmock:"from,arg2"`
+//		To   string  `gsmock:"to,arg3"`
+//		Rate float64 `gsmock:"rate,result1"`
+//	}
+//	rows, err := gsmock.ReadTable[rateRow](f, ',')
+//	...
+//	gsmock.ReturnRows(rates.MockRate(), rows)
+//
+// Unlike as many WhenArgs mocks, the rows are indexed by their parameters,
+// so that tables with thousands of rows are matched at once. Parameters
+// are compared with ==, and their fields must have the types of the
+// parameters, or be assignable to interface parameters.
+//
+// It panics if the fields don't fit the function, e.g. if the types
+// differ, or if two rows have the same parameters. m must not be
+// configured otherwise, and mockers returned by Bind are not supported.
+func ReturnRows[Row any](m Mocker, rows []Row) {
+	b := m.base()
+	if b.fn == nil {
+		panic("gsmock: ReturnRows doesn't support the mockers returned by Bind")
+	}
+	ft := reflect.TypeOf(b.fn)
+	rt := reflect.TypeFor[Row]()
+	fields, err := tableFields(rt)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	var args, results []tableField
+	for _, f := range fields {
+		field := rt.Field(f.index)
+		var (
+			count = ft.NumIn()
+			want  reflect.Type
+		)
+		if f.result {
+			count = ft.NumOut()
+		}
+		if f.n >= count {
+			panic(fmt.Sprintf("gsmock: field %s of %s maps %s %d of %s, which has %d",
+				field.Name, rt, roleName(f.result), f.n+1, ft, count))
+		}
+		if f.result {
+			want = ft.Out(f.n)
+			results = append(results, f)
+		} else {
+			want = ft.In(f.n)
+			args = append(args, f)
+		}
+		if field.Type != want && (want.Kind() != reflect.Interface || !field.Type.AssignableTo(want)) {
+			panic(fmt.Sprintf("gsmock: field %s of %s has type %s, but %s %d of %s has type %s",
+				field.Name, rt, field.Type, roleName(f.result), f.n+1, ft, want))
+		}
+		if !f.result && !field.Type.Comparable() {
+			panic(fmt.Sprintf("gsmock: field %s of %s has type %s, which is not comparable",
+				field.Name, rt, field.Type))
+		}
+	}
+	if len(args) == 0 {
+		panic(fmt.Sprintf("gsmock: %s maps no parameter of %s", rt, ft))
+	}
+
+	// The rows are indexed by their parameters, as a single value or an
+	// array of values, which are comparable.
+	keyType := reflect.ArrayOf(len(args), reflect.TypeFor[any]())
+	key := func(value func(f tableField) any) any {
+		if len(args) == 1 {
+			return value(args[0])
+		}
+		a := reflect.New(keyType).Elem()
+		for i, f := range args {
+			if x := value(f); x != nil {
+				a.Index(i).Set(reflect.ValueOf(x))
+			}
+		}
+		return a.Interface()
+	}
+
+	index := make(map[any][]any, len(rows))
+	lines := make(map[any]int, len(rows))
+	for i, row := range rows {
+		v := reflect.ValueOf(row)
+		k := key(func(f tableField) any { return v.Field(f.index).Interface() })
+		if j, ok := lines[k]; ok {
+			panic(fmt.Sprintf("gsmock: rows %d and %d of %s have the same parameters", j+1, i+1, rt))
+		}
+		lines[k] = i
+		ret := make([]any, ft.NumOut())
+		for _, f := range results {
+			ret[f.n] = v.Field(f.index).Interface()
+		}
+		index[k] = ret
+	}
+
+	lookup := func(params []any) ([]any, bool) {
+		for _, f := range args {
+			if x := params[f.n]; x != nil && !reflect.TypeOf(x).Comparable() {
+				return nil, false // passed to an interface parameter
+			}
+		}
+		ret, ok := index[key(func(f tableField) any { return params[f.n] })]
+		return ret, ok
+	}
+	match := func(params []any) bool {
+		_, ok := lookup(params)
+		return ok
+	}
+	call := func(params []any) []any {
+		ret, _ := lookup(params)
+		s := newBox(len(ret))
+		copy(s, ret)
+		return s
+	}
+	b.r.addInvoker(b.k.receiver, b.fn, b.invoker(match, call))
+}
+
+// roleName returns the name of the role of a table field.
+func roleName(result bool) string {
+	if result {
+		return "result"
+	}
+	return "parameter"
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// Rate is a sample function returning the exchange rate of two currencies.
+func Rate(ctx context.Context, from, to string) (float64, error) {
+	return 0, nil
+}
+
+type rateRow struct {
+	From string  `gsmock:"from,arg2"`
+	To   string  `gsmock:"to,arg3"`
+	Rate float64 `gsmock:"rate,result1"`
+	Note string  // not read
+}
+
+const rateTable = `from,to,rate,source
+USD,EUR,0.92,ecb
+EUR,USD,1.09,ecb
+USD,JPY,,
+`
+
+func TestReadTable(t *testing.T) {
+	rows, err := gsmock.ReadTable[rateRow](strings.NewReader(rateTable), ',')
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, rows, []rateRow{
+		{From: "USD", To: "EUR", Rate: 0.92},
+		{From: "EUR", To: "USD", Rate: 1.09},
+		{From: "USD", To: "JPY"},
+	})
+
+	type flagRow struct {
+		Name    string        `gsmock:"name,arg1"`
+		Enabled bool          `gsmock:"enabled,result1"`
+		Percent uint8         `gsmock:"percent,result2"`
+		TTL     time.Duration `gsmock:"ttl,result3"`
+		Since   time.Time     `gsmock:"since,result4"`
+	}
+	flags, err := gsmock.ReadTable[flagRow](strings.NewReader(
+		"name\tenabled\tpercent\tttl\tsince\n"+
+			"dark_mode\ttrue\t50\t1m30s\t2025-01-01T00:00:00Z\n"), '\t')
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, flags, []flagRow{{
+		Name:    "dark_mode",
+		Enabled: true,
+		Percent: 50,
+		TTL:     90 * time.Second,
+		Since:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}})
+
+	_, err = gsmock.ReadTable[flagRow](strings.NewReader("name,enabled,percent,ttl,since\nx,yes,,,\n"), ',')
+	gsmockassert.Match(t, err.Error(), `gsmock: table line 2, column "enabled": strconv.ParseBool: parsing "yes": invalid syntax`)
+	_, err = gsmock.ReadTable[flagRow](strings.NewReader("name,enabled,percent,ttl,since\nx,,300,,\n"), ',')
+	gsmockassert.Match(t, err.Error(), `gsmock: table line 2, column "percent": .* value out of range`)
+	_, err = gsmock.ReadTable[rateRow](strings.NewReader("from,to\n"), ',')
+	gsmockassert.Match(t, err.Error(), `gsmock: table has no column "rate"`)
+	_, err = gsmock.ReadTable[rateRow](strings.NewReader(""), ',')
+	gsmockassert.Match(t, err.Error(), `gsmock: error reading table header: EOF`)
+
+	type badRow struct {
+		From string `gsmock:"from,param1"`
+	}
+	_, err = gsmock.ReadTable[badRow](strings.NewReader(rateTable), ',')
+	gsmockassert.Match(t, err.Error(), `gsmock: field From of gsmock_test.badRow has tag "from,param1", not "column,argN" or "column,resultN"`)
+	_, err = gsmock.ReadTable[string](strings.NewReader(rateTable), ',')
+	gsmockassert.Match(t, err.Error(), `gsmock: table rows must be structs, not string`)
+}
+
+func TestReturnRows(t *testing.T) {
+	rows, err := gsmock.ReadTable[rateRow](strings.NewReader(rateTable), ',')
+	gsmockassert.Nil(t, err)
+
+	r := gsmock.NewManager()
+	m := gsmock.Method32(nil, Rate, r)
+	gsmock.ReturnRows(m, rows)
+	c := m.CaptureArg2()
+
+	ret, ok := gsmock.Invoke(r, nil, Rate, t.Context(), "EUR", "USD")
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1.09, nil})
+	ret, ok = gsmock.Invoke(r, nil, Rate, context.TODO(), "USD", "JPY")
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{0.0, nil})
+	_, ok = gsmock.Invoke(r, nil, Rate, t.Context(), "JPY", "USD")
+	gsmockassert.Equal(t, ok, false)
+	gsmockassert.Equal(t, c.Values(), []string{"EUR", "USD"})
+
+	// Mocks registered afterward apply to the other calls
+	gsmock.Method32(nil, Rate, r).ReturnValue(1, nil)
+	ret, ok = gsmock.Invoke(r, nil, Rate, t.Context(), "JPY", "USD")
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{1.0, nil})
+
+	// Rows indexed by a single interface parameter
+	type valueRow struct {
+		Key   string `gsmock:"key,arg1"`
+		Value string `gsmock:"value,result1"`
+	}
+	lookup := func(key any) any { return nil }
+	gsmock.ReturnRows(gsmock.Method11(nil, lookup, r), []valueRow{{Key: "a", Value: "A"}})
+	ret, ok = gsmock.Invoke(r, nil, lookup, "a")
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{"A"})
+	_, ok = gsmock.Invoke(r, nil, lookup, []string{"a"})
+	gsmockassert.Equal(t, ok, false)
+}
+
+func TestReturnRowsPanics(t *testing.T) {
+	r := gsmock.NewManager()
+
+	type typeRow struct {
+		From []byte `gsmock:"from,arg2"`
+	}
+	gsmockassert.Panic(t, func() {
+		gsmock.ReturnRows(gsmock.Method32(nil, Rate, r), []typeRow{})
+	}, `gsmock: field From of gsmock_test.typeRow has type \[\]uint8, but parameter 2 of func\(context.Context, string, string\) \(float64, error\) has type string`)
+
+	type rangeRow struct {
+		Rate float64 `gsmock:"rate,result3"`
+	}
+	gsmockassert.Panic(t, func() {
+		gsmock.ReturnRows(gsmock.Method32(nil, Rate, r), []rangeRow{})
+	}, `gsmock: field Rate of gsmock_test.rangeRow maps result 3 of .*, which has 2`)
+
+	type resultRow struct {
+		Rate float64 `gsmock:"rate,result1"`
+	}
+	gsmockassert.Panic(t, func() {
+		gsmock.ReturnRows(gsmock.Method32(nil, Rate, r), []resultRow{})
+	}, `gsmock: gsmock_test.resultRow maps no parameter of .*`)
+
+	gsmockassert.Panic(t, func() {
+		gsmock.ReturnRows(gsmock.Method32(nil, Rate, r), []rateRow{
+			{From: "USD", To: "EUR", Rate: 0.92},
+			{From: "USD", To: "EUR", Rate: 0.93},
+		})
+	}, `gsmock: rows 1 and 2 of gsmock_test.rateRow have the same parameters`)
+
+	gsmockassert.Panic(t, func() {
+		gsmock.ReturnRows(gsmock.Method32(nil, Rate, r).Bind(t.Context()), []rateRow{})
+	}, `gsmock: ReturnRows doesn't support the mockers returned by Bind`)
+}
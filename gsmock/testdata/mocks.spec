@@ -0,0 +1,4 @@
+# Canned responses of ClientInterface, loaded by TestLoad.
+ClientInterface.Query({"Value": 1}) => {"Message": "one"}, null
+gsmock_test.ClientInterface.Query({"Value": 2}) => null, "not found"
+ClientInterface.Query(_) => {"Message": "any, \"quoted\""}, null
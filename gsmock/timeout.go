@@ -0,0 +1,92 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// timedOutPrefix starts the reports of calls blocked longer than the
+// Timeout of their mocker.
+const timedOutPrefix = "gsmock: timed out call to "
+
+// setTimeout sets the timeout of the matched calls, see Mocker00.Timeout.
+func (m *mockerBase) setTimeout(d time.Duration) {
+	if d <= 0 {
+		panic(fmt.Sprintf("gsmock: timeout of %s", d))
+	}
+	m.timeout = d
+}
+
+// callTimeout runs the handler or return function of a matched call of r.
+// If the mocker has a timeout, they run in their own goroutine, and a call
+// blocking longer than the timeout is reported to r by timedOut instead of
+// hanging the test. A panic of these functions is re-raised by the caller,
+// with the stack of their goroutine.
+func (i *invoker) callTimeout(r *Manager, params []any) []any {
+	if i.timeout <= 0 {
+		return i.call(params)
+	}
+	done := make(chan []any, 1)
+	panicked := make(chan any, 1)
+	go func() {
+		defer func() {
+			if v := recover(); v != nil {
+				panicked <- i.panicError(v, params)
+			}
+		}()
+		done <- i.call(params)
+	}()
+	timer := time.NewTimer(i.timeout)
+	defer timer.Stop()
+	select {
+	case ret := <-done:
+		return ret
+	case v := <-panicked:
+		panic(v)
+	case <-timer.C:
+		r.timedOut(i.k, i.timeout, params)
+		return nil
+	}
+}
+
+// timedOut reports a call of k blocked longer than timeout d, along with
+// the stacks of all goroutines, showing what the handler waits for. The
+// bound test fails if there is one, and timedOut panics in any case, so
+// that the blocked call returns.
+func (r *Manager) timedOut(k funcKey, d time.Duration, params []any) {
+	msg := fmt.Sprintf(timedOutPrefix+"%s with params %s: blocked for more than %s\n%s",
+		funcName(k), formatParams(params), d, allStacks())
+	if r.t != nil {
+		r.t.Errorf("%s", msg)
+	}
+	panic(msg)
+}
+
+// allStacks returns the stacks of all goroutines.
+func allStacks() []byte {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
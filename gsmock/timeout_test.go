@@ -0,0 +1,79 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestTimeout(t *testing.T) {
+
+	t.Run("blocked", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		ft := &fakeT{}
+		r := gsmock.NewManagerT(ft)
+		c := NewMockClient(r)
+		c.MockQuery().Timeout(20 * time.Millisecond).Handle(func(req *Request) (*Response, error) {
+			<-release
+			return &Response{}, nil
+		})
+
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Query(&Request{Value: 1})
+		}, `timed out call to .*\(\*MockClient\)\.Query with params \(&\{Value:1\}\): blocked for more than 20ms`)
+		gsmockassert.Equal(t, len(ft.errors), 1)
+		gsmockassert.Match(t, ft.errors[0], `blocked for more than 20ms\ngoroutine \d+ `)
+	})
+
+	t.Run("returned", func(t *testing.T) {
+		ft := &fakeT{}
+		r := gsmock.NewManagerT(ft)
+		c := NewMockClient(r)
+		c.MockQuery().Timeout(time.Second).ReturnValue(&Response{Message: "ok"}, nil)
+
+		resp, err := c.Query(&Request{})
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, resp.Message, "ok")
+		gsmockassert.Equal(t, len(ft.errors), 0)
+	})
+
+	t.Run("panicked", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		c.MockQuery().Timeout(time.Second).Handle(func(req *Request) (*Response, error) {
+			panic("boom")
+		})
+
+		gsmockassert.Panic(t, func() {
+			_, _ = c.Query(&Request{Value: 2})
+		}, `Query registered at .* panicked with params \(&\{Value:2\}\): boom\n(?s).*timeout_test.go`)
+	})
+
+	t.Run("error_timeout", func(t *testing.T) {
+		r := gsmock.NewManager()
+		c := NewMockClient(r)
+		gsmockassert.Panic(t, func() {
+			c.MockQuery().Timeout(0)
+		}, "gsmock: timeout of 0s")
+	})
+}
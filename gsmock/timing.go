@@ -0,0 +1,79 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"time"
+)
+
+// now returns the current time of the clock timing the mocked calls,
+// replaced by tests.
+var now = time.Now
+
+// startBusy marks the start of a call, with inflightMux held.
+func (r *Manager) startBusy() {
+	if r.busy++; r.busy == 1 {
+		r.busySince = now()
+	}
+}
+
+// endBusy marks the end of a call, with inflightMux held.
+func (r *Manager) endBusy() {
+	if r.busy--; r.busy == 0 {
+		r.mockTime += now().Sub(r.busySince)
+	}
+}
+
+// TotalMockTime returns the time spent inside the mocked calls of r, such
+// as the latency injected by handlers or by ApplyChaos, since recording
+// was enabled or r was last reset. Calls in progress at the same time
+// count once, so that the total is the wall-clock time during which at
+// least one mocked call was in progress. It is zero if call recording is
+// disabled.
+func (r *Manager) TotalMockTime() time.Duration {
+	r.inflightMux.Lock()
+	defer r.inflightMux.Unlock()
+	d := r.mockTime
+	if r.busy > 0 {
+		d += now().Sub(r.busySince)
+	}
+	return d
+}
+
+// AssertTotalMockTimeBelow fails the test if the total time spent inside
+// mocked calls, as returned by TotalMockTime, is not below d. With each
+// dependency delaying its results, it verifies that the code under test
+// issues in parallel the calls it should not serialize:
+//
+//	r.EnableRecording(gsmock.RetentionPolicy{CountOnly: true})
+//	users.MockGet().Handle(func(id string) (*User, error) {
+//		time.Sleep(100 * time.Millisecond)
+//		return &User{ID: id}, nil
+//	})
+//	orders.MockList().Handle(...) // also sleeping 100ms
+//	loadDashboard(users, orders)
+//	r.AssertTotalMockTimeBelow(t, 150*time.Millisecond)
+func (r *Manager) AssertTotalMockTimeBelow(t TB, d time.Duration) {
+	t.Helper()
+	if r.retention == nil {
+		t.Errorf("gsmock: mocked calls are only timed once EnableRecording is called")
+		return
+	}
+	if total := r.TotalMockTime(); total >= d {
+		t.Errorf("gsmock: mocked calls took %v, not below %v: are dependency calls serialized?", total, d)
+	}
+}
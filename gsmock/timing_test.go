@@ -0,0 +1,121 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// fakeClock is a clock only advanced by Advance.
+type fakeClock struct {
+	mux sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestAssertTotalMockTimeBelow(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	gsmock.SetClock(t, clock.Now)
+
+	r := gsmock.NewManager()
+	next := func() {
+		_, _ = gsmock.Invoke(r, nil, NextID)
+	}
+	var step func()
+	gsmock.Method02(nil, NextID, r).Handle(func() (string, error) {
+		step()
+		return "id", nil
+	})
+
+	ft := &fakeT{}
+	r.AssertTotalMockTimeBelow(ft, time.Second)
+	gsmockassert.Equal(t, ft.errors, []string{"gsmock: mocked calls are only timed once EnableRecording is called"})
+
+	r.EnableRecording(gsmock.RetentionPolicy{CountOnly: true})
+	gsmockassert.Equal(t, r.TotalMockTime(), time.Duration(0))
+
+	// parallel calls overlap: the clock advances once all are in progress
+	var started sync.WaitGroup
+	started.Add(4)
+	release := make(chan struct{})
+	step = func() {
+		started.Done()
+		<-release
+	}
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(next)
+	}
+	started.Wait()
+	clock.Advance(50 * time.Millisecond)
+	gsmockassert.Equal(t, r.TotalMockTime(), 50*time.Millisecond)
+	close(release)
+	wg.Wait()
+	gsmockassert.Equal(t, r.TotalMockTime(), 50*time.Millisecond)
+	ft = &fakeT{}
+	r.AssertTotalMockTimeBelow(ft, 150*time.Millisecond)
+	gsmockassert.Equal(t, len(ft.errors), 0)
+
+	// serialized calls add up
+	step = func() { clock.Advance(50 * time.Millisecond) }
+	for range 4 {
+		next()
+	}
+	gsmockassert.Equal(t, r.TotalMockTime(), 250*time.Millisecond)
+	r.AssertTotalMockTimeBelow(ft, 150*time.Millisecond)
+	gsmockassert.Equal(t, ft.errors, []string{"gsmock: mocked calls took 250ms, not below 150ms: are dependency calls serialized?"})
+
+	r.Reset()
+	gsmockassert.Equal(t, r.TotalMockTime(), time.Duration(0))
+}
+
+func TestTotalMockTimeEnabledMidCall(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	gsmock.SetClock(t, clock.Now)
+
+	r := gsmock.NewManager()
+	var step func()
+	gsmock.Method02(nil, NextID, r).Handle(func() (string, error) {
+		step()
+		return "id", nil
+	})
+
+	// the call started before recording is not timed, nor miscounted
+	step = func() { r.EnableRecording(gsmock.RetentionPolicy{CountOnly: true}) }
+	_, _ = gsmock.Invoke(r, nil, NextID)
+	gsmockassert.Equal(t, r.TotalMockTime(), time.Duration(0))
+
+	step = func() { clock.Advance(50 * time.Millisecond) }
+	_, _ = gsmock.Invoke(r, nil, NextID)
+	gsmockassert.Equal(t, r.TotalMockTime(), 50*time.Millisecond)
+}
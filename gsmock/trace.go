@@ -0,0 +1,51 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"context"
+)
+
+// Tracer traces mocked calls, e.g. as the OpenTelemetry spans of package
+// gsmock/gsmockotel, so that the interactions between the code under test
+// and its mocked dependencies show up in existing tracing UIs. gsmockotel
+// is a module of its own, so that gsmock doesn't depend on OpenTelemetry.
+type Tracer interface {
+	// StartCall is called before a call of the named function is
+	// dispatched. ctx is the first context.Context parameter of the call,
+	// or context.Background() if there is none. The returned function is
+	// called once the call completes, with whether a mock handled it.
+	StartCall(ctx context.Context, name string) (end func(matched bool))
+}
+
+// AttachTracer traces every mocked call through t, in place of the Tracer
+// attached before, if any.
+func (r *Manager) AttachTracer(t Tracer) {
+	r.tracer = t
+}
+
+// startCall starts tracing a call of k with params.
+func (r *Manager) startCall(k funcKey, params []any) func(matched bool) {
+	ctx := context.Background()
+	for _, p := range params {
+		if c, ok := p.(context.Context); ok && c != nil {
+			ctx = c
+			break
+		}
+	}
+	return r.tracer.StartCall(ctx, funcName(k))
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+)
+
+// TranscriptEntry is a recorded call written by WriteTranscript.
+// Its parameters and results are formatted as Go expressions, so that
+// the tool can turn a transcript into mock setup code (--setup-from).
+type TranscriptEntry struct {
+	Func    string   `json:"func"`              // full name of the mocked function
+	Params  []string `json:"params"`            // parameters of the call
+	Results []string `json:"results,omitempty"` // results of the call, if matched
+	Matched bool     `json:"matched"`           // whether a registered mock handled the call
+}
+
+// WriteTranscript writes the retained calls of every mocked function
+// to w as JSON lines of TranscriptEntry, ordered by function name, or as
+// set by EnableDeterministicOrder, and then by call order. Recording must
+// be enabled with EnableRecording.
+//
+// A transcript of calls handled by the real implementations, e.g. through
+// Handle, bootstraps mock setups: `gs-mock --setup-from transcript.jsonl`
+// generates the MockX().WhenArgs(...).ReturnValue(...) code reproducing it.
+func (r *Manager) WriteTranscript(w io.Writer) error {
+	return jsonSerializer{}.WriteTranscript(w, r.transcript())
+}
+
+// transcript returns the retained calls of every mocked function,
+// ordered like WriteTranscript.
+func (r *Manager) transcript() []TranscriptEntry {
+	r.recordMux.Lock()
+	defer r.recordMux.Unlock()
+	keys := slices.Collect(maps.Keys(r.records))
+	r.sortKeys(keys)
+	var entries []TranscriptEntry
+	for _, k := range keys {
+		name := funcName(k)
+		for _, call := range r.records[k].retained() {
+			e := TranscriptEntry{
+				Func:    name,
+				Params:  goExprs(call.Params),
+				Matched: call.Matched,
+			}
+			if call.Matched {
+				e.Results = goExprs(call.Results)
+			}
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// goExprs formats values as Go expressions. Contexts are formatted as
+// "ctx" and errors as errors.New calls, as their fields are unexported.
+func goExprs(values []any) []string {
+	ret := make([]string, len(values))
+	for i, v := range values {
+		switch x := v.(type) {
+		case nil:
+			ret[i] = "nil"
+		case context.Context:
+			ret[i] = "ctx"
+		case error:
+			ret[i] = fmt.Sprintf("errors.New(%q)", x.Error())
+		default:
+			ret[i] = fmt.Sprintf("%#v", v)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestWriteTranscript(t *testing.T) {
+	r := gsmock.NewManager()
+	r.EnableRecording(gsmock.RetentionPolicy{})
+
+	c := NewMockClient(r)
+	c.MockQuery().WhenArgs(&Request{Value: 1}).ReturnValue(&Response{Message: "ok"}, nil)
+	c.MockQuery().WhenArgs(&Request{Value: 2}).ReturnValue(nil, errors.New("not found"))
+
+	_, _ = c.Query(&Request{Value: 1})
+	_, _ = c.Query(&Request{Value: 2})
+	gsmockassert.Panic(t, func() {
+		_, _ = c.Query(&Request{Value: 3})
+	}, "no mock code matched")
+
+	var buf bytes.Buffer
+	gsmockassert.Nil(t, r.WriteTranscript(&buf))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	gsmockassert.Equal(t, len(lines), 3)
+
+	const name = `"github.com/go-spring/gs-mock/gsmock_test.(*MockClient).Query"`
+	gsmockassert.Equal(t, lines[0], `{"func":`+name+`,"params":["&gsmock_test.Request{Value:1}"],"results":["&gsmock_test.Response{Message:\"ok\"}","nil"],"matched":true}`)
+	gsmockassert.Equal(t, lines[1], `{"func":`+name+`,"params":["&gsmock_test.Request{Value:2}"],"results":["(*gsmock_test.Response)(nil)","errors.New(\"not found\")"],"matched":true}`)
+	gsmockassert.Equal(t, lines[2], `{"func":`+name+`,"params":["&gsmock_test.Request{Value:3}"],"matched":false}`)
+}
@@ -0,0 +1,265 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+/******************************** MockerT ***********************************/
+
+// MockerT is a mocker of functions of any arity, whose parameters and
+// results are the exported fields of the tuple structs Args and Results,
+// in order, e.g. for func(ctx context.Context, id int) (*User, error):
+//
+//	type GetArgs struct {
+//		Ctx context.Context
+//		ID  int
+//	}
+//
+//	type GetResults struct {
+//		User *User
+//		Err  error
+//	}
+//
+// Unlike the numbered mockers, such as Mocker12, it has no limit on the
+// number of parameters and results, at the cost of converting them through
+// reflection on every call: the generated mocks use it for the methods
+// exceeding MaxParamCount or MaxResultCount.
+type MockerT[Args, Results any] struct {
+	mockerBase
+	fnHandle func(Args) Results
+	fnWhen   func(Args) bool
+	fnReturn func() Results
+}
+
+// Handle sets a custom handler function for intercepted calls.
+// If a predicate is set via When, the handler only applies to matching calls.
+// It panics if fn is nil.
+func (m *MockerT[Args, Results]) Handle(fn func(Args) Results) {
+	if fn == nil {
+		m.rejectNil("Handle")
+	}
+	m.fnHandle = fn
+	m.defaults = nil
+}
+
+// When sets a predicate function that determines whether the mock applies.
+func (m *MockerT[Args, Results]) When(fn func(Args) bool) *MockerT[Args, Results] {
+	m.fnWhen = fn
+	return m
+}
+
+// Except excludes the calls accepted by fn from the calls the mock applies
+// to, so that they are left to other mocks. Unlike When, it doesn't replace
+// the current predicate, but narrows it: call it after When, WhenArgs, etc.
+func (m *MockerT[Args, Results]) Except(fn func(Args) bool) *MockerT[Args, Results] {
+	when := m.fnWhen
+	return m.When(func(args Args) bool {
+		return (when == nil || when(args)) && !fn(args)
+	})
+}
+
+// WhenArgs sets a predicate that matches when all arguments equal the given values.
+// Equality honors comparers registered via RegisterComparer.
+func (m *MockerT[Args, Results]) WhenArgs(args Args) *MockerT[Args, Results] {
+	return m.When(func(a Args) bool {
+		return isEqual(a, args)
+	})
+}
+
+// Return sets a function that produces return values when the mock is matched.
+// It panics if fn is nil.
+func (m *MockerT[Args, Results]) Return(fn func() Results) {
+	if fn == nil {
+		m.rejectNil("Return")
+	}
+	m.fnReturn = fn
+	m.defaults = nil
+}
+
+// ReturnValue is a convenience wrapper around Return that uses fixed values.
+func (m *MockerT[Args, Results]) ReturnValue(ret Results) {
+	m.Return(func() Results { return ret })
+}
+
+// ReturnDefault configures the mock to return default values for all return
+// types: the values registered with RegisterDefaultFor or RegisterDefault,
+// or else zero values.
+func (m *MockerT[Args, Results]) ReturnDefault() {
+	m.Return(func() (ret Results) { return })
+	t := reflect.TypeFor[Results]()
+	m.defaults = make([]reflect.Type, t.NumField())
+	for i := range m.defaults {
+		m.defaults[i] = t.Field(i).Type
+	}
+}
+
+// Freeze checksums the pointers, slices and maps returned by matched calls,
+// and makes the Manager report on Close those mutated afterward, e.g. by
+// code under test modifying canned data it shares with other callers.
+func (m *MockerT[Args, Results]) Freeze() *MockerT[Args, Results] {
+	m.freeze = freezeUnchanged
+	return m
+}
+
+// Barrier makes matched calls wait until n of them are in progress at once,
+// then releases them together, in groups of n, before their handler or
+// return function runs. It panics if n is not positive.
+func (m *MockerT[Args, Results]) Barrier(n int) *MockerT[Args, Results] {
+	m.barrier = newBarrier(n)
+	return m
+}
+
+// Timeout fails the matched calls whose handler or return function blocks
+// longer than d, like the Timeout of the numbered mockers. It panics if d
+// is not positive.
+func (m *MockerT[Args, Results]) Timeout(d time.Duration) *MockerT[Args, Results] {
+	m.setTimeout(d)
+	return m
+}
+
+// OnCall restricts the mock to the call n of the function, 1-based, like
+// the OnCall of the numbered mockers. It panics if n is not positive.
+func (m *MockerT[Args, Results]) OnCall(n int) *MockerT[Args, Results] {
+	m.setOnCall(n)
+	return m
+}
+
+// Never forbids the calls matched by this mocker. If such a call occurs,
+// the test fails immediately with the call's parameters and stack.
+// Combine it with When to forbid only specific calls.
+func (m *MockerT[Args, Results]) Never() {
+	m.never = true
+	m.ReturnDefault()
+}
+
+// match reports whether the mocker applies to a call with params.
+func (m *MockerT[Args, Results]) match(params []any) bool {
+	if m.fnWhen != nil && !m.fnWhen(tupleOf[Args](params)) {
+		return false
+	}
+	return m.fnHandle != nil || m.fnReturn != nil
+}
+
+// call runs the handler or return function of a matched call with params
+// and returns its results.
+func (m *MockerT[Args, Results]) call(params []any) []any {
+	if m.fnHandle != nil {
+		return tupleValues(m.fnHandle(tupleOf[Args](params)))
+	}
+	return tupleValues(m.fnReturn())
+}
+
+// FuncT creates a new MockerT for the function f and registers it with
+// the Manager. It panics if Args and Results don't match the parameters
+// and results of f.
+func FuncT[Args, Results any](f any, r *Manager) *MockerT[Args, Results] {
+	checkTuples[Args, Results](f)
+	PatchOnce(f)
+	m := &MockerT[Args, Results]{}
+	m.register(r, nil, f, m.invoker(m.match, m.call))
+	return m
+}
+
+// MethodT creates a new MockerT for mocking a method on a receiver.
+// It panics if Args and Results don't match the parameters and results
+// of f.
+func MethodT[Args, Results any](receiver any, f any, r *Manager) *MockerT[Args, Results] {
+	checkTuples[Args, Results](f)
+	m := &MockerT[Args, Results]{}
+	m.register(r, receiver, f, m.invoker(m.match, m.call))
+	return m
+}
+
+// UnboxT extracts the return values of a mock result slice into the tuple
+// struct Results, for the mocks using MockerT.
+func UnboxT[Results any](ret []any) Results {
+	t := reflect.TypeFor[Results]()
+	if len(ret) != t.NumField() {
+		panic(fmt.Sprintf("expected %d return values, but got %d", t.NumField(), len(ret)))
+	}
+	return tupleOf[Results](ret)
+}
+
+// tupleOf returns the tuple struct T whose fields are values.
+func tupleOf[T any](values []any) (t T) {
+	v := reflect.ValueOf(&t).Elem()
+	for i, x := range values {
+		if x != nil {
+			v.Field(i).Set(reflect.ValueOf(x))
+		}
+	}
+	return
+}
+
+// tupleValues returns the fields of the tuple struct t.
+func tupleValues(t any) []any {
+	v := reflect.ValueOf(t)
+	ret := make([]any, v.NumField())
+	for i := range ret {
+		ret[i] = v.Field(i).Interface()
+	}
+	return ret
+}
+
+// checkTuples panics if the fields of the tuple structs Args and Results
+// are not exported or don't have the types of the parameters and results
+// of the function f, in order. The variadic parameter of f is a slice.
+func checkTuples[Args, Results any](f any) {
+	ft := reflect.TypeOf(f)
+	if ft == nil || ft.Kind() != reflect.Func {
+		panic(fmt.Sprintf("gsmock: mock target %s is not a function", typeString(ft)))
+	}
+	in := make([]reflect.Type, ft.NumIn())
+	for i := range in {
+		in[i] = ft.In(i)
+	}
+	out := make([]reflect.Type, ft.NumOut())
+	for i := range out {
+		out[i] = ft.Out(i)
+	}
+	if err := checkTuple(reflect.TypeFor[Args](), in, "parameter"); err != "" {
+		panic(fmt.Sprintf("gsmock: %s does not match %s: %s", reflect.TypeFor[Args](), ft, err))
+	}
+	if err := checkTuple(reflect.TypeFor[Results](), out, "result"); err != "" {
+		panic(fmt.Sprintf("gsmock: %s does not match %s: %s", reflect.TypeFor[Results](), ft, err))
+	}
+}
+
+// checkTuple describes how the tuple struct t doesn't hold values of the
+// given types, or returns "" if it does.
+func checkTuple(t reflect.Type, types []reflect.Type, what string) string {
+	if t.Kind() != reflect.Struct {
+		return "not a struct"
+	}
+	if t.NumField() != len(types) {
+		return countMismatch(t.NumField(), len(types), "field")
+	}
+	for i, typ := range types {
+		f := t.Field(i)
+		if !f.IsExported() {
+			return fmt.Sprintf("field %s is not exported", f.Name)
+		}
+		if f.Type != typ {
+			return fmt.Sprintf("field %s is %s instead of %s %d %s", f.Name, f.Type, what, i+1, typ)
+		}
+	}
+	return ""
+}
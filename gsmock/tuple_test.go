@@ -0,0 +1,124 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+// Wide is a hand-written mock of a method exceeding the numbered mockers.
+type Wide struct {
+	r *gsmock.Manager
+}
+
+type WideArgs struct {
+	A, B, C, D, E, F, G int
+	Rest                []string
+}
+
+type WideResults struct {
+	R1, R2, R3, R4 int
+	Err            error
+}
+
+func (w *Wide) Sum(a, b, c, d, e, f, g int, rest ...string) (int, int, int, int, error) {
+	if ret, ok := gsmock.Invoke(w.r, w, w.Sum, a, b, c, d, e, f, g, rest); ok {
+		res := gsmock.UnboxT[WideResults](ret)
+		return res.R1, res.R2, res.R3, res.R4, res.Err
+	}
+	panic("no mock code matched for Wide.Sum")
+}
+
+func (w *Wide) MockSum() *gsmock.MockerT[WideArgs, WideResults] {
+	return gsmock.MethodT[WideArgs, WideResults](w, w.Sum, w.r)
+}
+
+func TestMockerT(t *testing.T) {
+
+	t.Run("handle", func(t *testing.T) {
+		w := &Wide{r: gsmock.NewManagerT(t)}
+		w.MockSum().When(func(args WideArgs) bool {
+			return len(args.Rest) > 0
+		}).Handle(func(args WideArgs) WideResults {
+			return WideResults{R1: args.A + args.G, R4: len(args.Rest)}
+		})
+		w.MockSum().ReturnValue(WideResults{Err: errors.New("no rest")})
+
+		r1, _, _, r4, err := w.Sum(1, 2, 3, 4, 5, 6, 7, "x", "y")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, r1, 8)
+		gsmockassert.Equal(t, r4, 2)
+
+		_, _, _, _, err = w.Sum(1, 2, 3, 4, 5, 6, 7)
+		gsmockassert.Equal(t, err.Error(), "no rest")
+	})
+
+	t.Run("when args", func(t *testing.T) {
+		w := &Wide{r: gsmock.NewManagerT(t)}
+		w.MockSum().WhenArgs(WideArgs{A: 1, Rest: []string{"x"}}).ReturnValue(WideResults{R2: 2})
+		w.MockSum().Except(func(args WideArgs) bool { return args.A == 2 }).ReturnValue(WideResults{R3: 3})
+
+		_, r2, _, _, _ := w.Sum(1, 0, 0, 0, 0, 0, 0, "x")
+		gsmockassert.Equal(t, r2, 2)
+		_, _, r3, _, _ := w.Sum(1, 0, 0, 0, 0, 0, 0)
+		gsmockassert.Equal(t, r3, 3)
+		gsmockassert.Panic(t, func() {
+			_, _, _, _, _ = w.Sum(2, 0, 0, 0, 0, 0, 0)
+		}, "no mock code matched for Wide.Sum")
+	})
+
+	t.Run("on call", func(t *testing.T) {
+		w := &Wide{r: gsmock.NewManagerT(t)}
+		w.MockSum().OnCall(2).ReturnValue(WideResults{Err: errors.New("second")})
+		w.MockSum().ReturnValue(WideResults{R1: 1})
+
+		r1, _, _, _, err := w.Sum(0, 0, 0, 0, 0, 0, 0)
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, r1, 1)
+		_, _, _, _, err = w.Sum(0, 0, 0, 0, 0, 0, 0)
+		gsmockassert.Equal(t, err.Error(), "second")
+	})
+
+	t.Run("return default", func(t *testing.T) {
+		r := gsmock.NewManagerT(t)
+		gsmock.RegisterDefaultFor(r, func() int { return 42 })
+		w := &Wide{r: r}
+		w.MockSum().ReturnDefault()
+
+		r1, _, _, r4, err := w.Sum(0, 0, 0, 0, 0, 0, 0)
+		gsmockassert.Equal(t, r1, 42)
+		gsmockassert.Equal(t, r4, 42)
+		gsmockassert.Nil(t, err)
+	})
+
+	t.Run("mismatched tuples", func(t *testing.T) {
+		w := &Wide{r: gsmock.NewManager()}
+		gsmockassert.Panic(t, func() {
+			gsmock.MethodT[struct{ A int }, WideResults](w, w.Sum, w.r)
+		}, `gsmock: struct \{ A int \} does not match func\(int, int, int, int, int, int, int, \.\.\.string\) \(int, int, int, int, error\): 1 field instead of 8`)
+		gsmockassert.Panic(t, func() {
+			gsmock.MethodT[WideArgs, struct{ R1, R2, R3, R4, err any }](w, w.Sum, w.r)
+		}, `field R1 is interface \{\} instead of result 1 int`)
+		gsmockassert.Panic(t, func() {
+			gsmock.MethodT[WideArgs, int](w, w.Sum, w.r)
+		}, `gsmock: int does not match .*: not a struct`)
+	})
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// RuntimeVersion is the version of the gsmock runtime library. Mocks
+	// generated by a newer gs-mock tool may rely on features it lacks.
+	RuntimeVersion = "v0.0.9"
+
+	// MinToolVersion is the oldest gs-mock tool version whose generated
+	// mocks are still compatible with this runtime library.
+	MinToolVersion = "v0.0.9"
+)
+
+// CheckVersion reports whether mocks generated by the given version of
+// the gs-mock tool are compatible with this runtime library. It returns
+// an error telling how to fix the mismatch if they are not.
+func CheckVersion(toolVersion string) error {
+	if compareVersion(toolVersion, RuntimeVersion) > 0 {
+		return fmt.Errorf("gsmock: mocks generated by gs-mock %s need a newer runtime: "+
+			"upgrade github.com/go-spring/gs-mock to %s+ or regenerate them with gs-mock %s",
+			toolVersion, toolVersion, RuntimeVersion)
+	}
+	if compareVersion(toolVersion, MinToolVersion) < 0 {
+		return fmt.Errorf("gsmock: mocks generated by gs-mock %s are no longer supported: "+
+			"regenerate them with gs-mock %s+", toolVersion, MinToolVersion)
+	}
+	return nil
+}
+
+// RequireVersion is called by generated mock constructors with the version
+// of the gs-mock tool that generated them. If the mocks are incompatible
+// with this runtime library, the bound test fails; otherwise it panics.
+func (r *Manager) RequireVersion(toolVersion string) {
+	err := CheckVersion(toolVersion)
+	if err == nil {
+		return
+	}
+	if r.t == nil {
+		panic(err)
+	}
+	r.t.Helper()
+	r.t.Errorf("%v", err)
+}
+
+// compareVersion compares two versions of the form "vMAJOR.MINOR.PATCH",
+// ignoring any pre-release or build suffix. Missing or malformed numbers
+// count as zero.
+func compareVersion(a, b string) int {
+	x, y := parseVersion(a), parseVersion(b)
+	for i := range x {
+		if x[i] != y[i] {
+			if x[i] < y[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseVersion returns the major, minor and patch numbers of a version.
+func parseVersion(v string) [3]int {
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	var ret [3]int
+	for i, s := range strings.SplitN(v, ".", 3) {
+		ret[i], _ = strconv.Atoi(s)
+	}
+	return ret
+}
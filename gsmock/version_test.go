@@ -0,0 +1,65 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestCheckVersion(t *testing.T) {
+	gsmockassert.Nil(t, gsmock.CheckVersion(gsmock.RuntimeVersion))
+	gsmockassert.Nil(t, gsmock.CheckVersion(gsmock.MinToolVersion))
+	gsmockassert.Nil(t, gsmock.CheckVersion(gsmock.RuntimeVersion+"-rc.1"))
+
+	// Test case: generated by a newer tool
+	for _, v := range []string{"v99.0.0", "v0.1.0", "v0.0.10"} {
+		err := gsmock.CheckVersion(v)
+		gsmockassert.Panic(t, func() { panic(err) },
+			`gs-mock `+v+` need a newer runtime: upgrade github.com/go-spring/gs-mock to `+v+`\+`)
+	}
+
+	// Test case: generated by a tool older than supported, such as the
+	// previous release
+	for _, v := range []string{"v0.0.1", "v0.0.8"} {
+		err := gsmock.CheckVersion(v)
+		gsmockassert.Panic(t, func() { panic(err) },
+			`gs-mock `+v+` are no longer supported: regenerate them with gs-mock v0\.0\.9\+`)
+	}
+}
+
+func TestRequireVersion(t *testing.T) {
+
+	// Test case: without a bound test, incompatible mocks panic
+	{
+		r := gsmock.NewManager()
+		r.RequireVersion(gsmock.RuntimeVersion)
+		gsmockassert.Panic(t, func() {
+			r.RequireVersion("v99.0.0")
+		}, "need a newer runtime")
+	}
+
+	// Test case: with a bound test, incompatible mocks fail the test
+	{
+		ft := &fakeT{}
+		r := gsmock.NewManagerT(ft)
+		r.RequireVersion("v99.0.0")
+		gsmockassert.Equal(t, len(ft.errors), 1)
+	}
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock
+
+import (
+	"context"
+	"fmt"
+)
+
+// WaitForCall blocks until the mocked function has been called since the
+// Manager was created or reset, whichever mock handled the call, or until
+// ctx is done. It replaces sleep-and-poll loops in tests of asynchronous
+// code. It returns an error wrapping ctx.Err() if ctx is done first.
+//
+// Creating a mocker registers it, which must not happen concurrently with
+// calls, so wait on a mocker created during the setup:
+//
+//	notify := mock.MockNotify()
+//	notify.ReturnValue(nil)
+//	go pipeline.Run()
+//	err := notify.WaitForCall(ctx)
+func (m *mockerBase) WaitForCall(ctx context.Context) error {
+	return m.WaitForCalls(ctx, 1)
+}
+
+// WaitForCalls is like WaitForCall, but waits for at least n calls.
+func (m *mockerBase) WaitForCalls(ctx context.Context, n int) error {
+	return m.r.waitForCalls(ctx, m.k, n)
+}
+
+// countCall counts a completed call of k and wakes up the waiters.
+func (r *Manager) countCall(k funcKey) {
+	r.callMux.Lock()
+	defer r.callMux.Unlock()
+	r.callCounts[k]++
+	if r.callWaiters > 0 {
+		r.callCond.Broadcast()
+	}
+}
+
+// waitForCalls blocks until k has been called at least n times or ctx is done.
+func (r *Manager) waitForCalls(ctx context.Context, k funcKey, n int) error {
+	stop := context.AfterFunc(ctx, func() {
+		r.callMux.Lock()
+		defer r.callMux.Unlock()
+		r.callCond.Broadcast()
+	})
+	defer stop()
+
+	r.callMux.Lock()
+	defer r.callMux.Unlock()
+	r.callWaiters++
+	defer func() { r.callWaiters-- }()
+	for r.callCounts[k] < n {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("gsmock: %s called %d times, want %d: %w", funcName(k), r.callCounts[k], n, err)
+		}
+		r.callCond.Wait()
+	}
+	return nil
+}
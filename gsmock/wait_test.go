@@ -0,0 +1,61 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gsmock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-spring/gs-mock/gsmock"
+
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
+)
+
+func TestWaitForCall(t *testing.T) {
+	r := gsmock.NewManager()
+	c := NewMockClient(r)
+	q := c.MockQuery()
+	q.ReturnValue(&Response{Message: "ok"}, nil)
+
+	go func() {
+		for i := range 3 {
+			time.Sleep(10 * time.Millisecond)
+			_, _ = c.Query(&Request{Value: i})
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	gsmockassert.Nil(t, q.WaitForCall(ctx))
+	gsmockassert.Nil(t, q.WaitForCalls(ctx, 3))
+
+	// The calls already made are counted
+	gsmockassert.Nil(t, q.WaitForCalls(ctx, 2))
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := q.WaitForCalls(ctx, 4)
+	gsmockassert.ErrorIs(t, err, context.DeadlineExceeded)
+	gsmockassert.Match(t, err.Error(), `\(\*MockClient\)\.Query called 3 times, want 4`)
+
+	// Reset clears the counts
+	r.Reset()
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	gsmockassert.ErrorIs(t, q.WaitForCall(ctx), context.Canceled)
+}
@@ -0,0 +1,221 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// gsmockPath is the import path of the gsmock runtime package.
+const gsmockPath = "github.com/go-spring/gs-mock/gsmock"
+
+// importAliases holds the --import-alias flag values.
+type importAliases []string
+
+// String implements flag.Value.
+func (a *importAliases) String() string {
+	return strings.Join(*a, ",")
+}
+
+// Set implements flag.Value.
+func (a *importAliases) Set(s string) error {
+	*a = append(*a, s)
+	return nil
+}
+
+// aliasRule maps import paths matching a pattern to an alias.
+type aliasRule struct {
+	pattern *regexp.Regexp
+	alias   string // template expanded with the pattern's submatches
+}
+
+// parseAliasRules parses rules of the form "pattern=alias", where pattern
+// is a regular expression matched against import paths and alias may
+// reference its submatches (e.g. `^(.*/)?(\w+)/v(\d+)$=${2}v${3}`).
+func parseAliasRules(rules []string) []aliasRule {
+	var ret []aliasRule
+	for _, s := range rules {
+		i := strings.LastIndex(s, "=")
+		if i <= 0 || i == len(s)-1 {
+			panic(fmt.Sprintf("invalid import alias rule: %s", s))
+		}
+		re, err := regexp.Compile(s[:i])
+		if err != nil {
+			panic(fmt.Errorf("invalid import alias rule(%s): %w", s, err))
+		}
+		ret = append(ret, aliasRule{pattern: re, alias: s[i+1:]})
+	}
+	return ret
+}
+
+// match returns the alias the rule assigns to pkgPath, or "" if it doesn't match.
+func (r aliasRule) match(pkgPath string) string {
+	m := r.pattern.FindStringSubmatchIndex(pkgPath)
+	if m == nil {
+		return ""
+	}
+	return string(r.pattern.ExpandString(nil, r.alias, pkgPath, m))
+}
+
+// resolveImports assigns a unique alias to every import path required by
+// the interfaces, and rewrites the package qualifiers in their type texts
+// accordingly. It returns the imports of the generated file as a map of
+// alias => import path.
+//
+// The preferred alias of a path is the one given by the first matching
+// rule, otherwise the package name used in the source files if they all
+// agree, otherwise the last element of the path. When the preferred alias
+// is taken, a deterministic alias is derived from the path instead.
+func resolveImports(interfaces []Interface, rules []aliasRule) map[string]string {
+	localNames := make(map[string][]string) // import path => local names
+	for _, i := range interfaces {
+		for name, pkgPath := range i.Imports {
+			if !slices.Contains(localNames[pkgPath], name) {
+				localNames[pkgPath] = append(localNames[pkgPath], name)
+			}
+		}
+	}
+
+	imports := map[string]string{"gsmock": gsmockPath}
+	aliases := map[string]string{gsmockPath: "gsmock"}
+	for _, pkgPath := range slices.Sorted(maps.Keys(localNames)) {
+		if pkgPath == gsmockPath {
+			continue
+		}
+		alias := preferredAlias(pkgPath, localNames[pkgPath], rules)
+		if _, ok := imports[alias]; ok {
+			alias = uniqueAlias(pkgPath, imports)
+		}
+		imports[alias] = pkgPath
+		aliases[pkgPath] = alias
+	}
+
+	for k := range interfaces {
+		rename := make(map[string]string)
+		for name, pkgPath := range interfaces[k].Imports {
+			if alias := aliases[pkgPath]; alias != name {
+				rename[name] = alias
+			}
+		}
+		if len(rename) > 0 {
+			interfaces[k].renameQualifiers(rename)
+		}
+	}
+	return imports
+}
+
+// preferredAlias returns the alias pkgPath should be imported with
+// if there is no conflict.
+func preferredAlias(pkgPath string, localNames []string, rules []aliasRule) string {
+	for _, r := range rules {
+		if alias := r.match(pkgPath); alias != "" {
+			return alias
+		}
+	}
+	if len(localNames) == 1 {
+		return localNames[0]
+	}
+	return assumedPkgName(pkgPath)
+}
+
+// uniqueAlias derives an alias for pkgPath that is not taken yet.
+// Versioned paths such as "foo/v2" become "foov2"; other paths are
+// prefixed with their parent element, e.g. "text/template" becomes
+// "texttemplate". A numeric suffix is appended if that is taken too.
+func uniqueAlias(pkgPath string, imports map[string]string) string {
+	ss := strings.Split(pkgPath, "/")
+	alias := ss[len(ss)-1]
+	if n := len(ss); n >= 2 {
+		alias = ss[n-2] + ss[n-1]
+	}
+	alias = sanitizeIdent(alias)
+	if _, ok := imports[alias]; !ok {
+		return alias
+	}
+	for k := 2; ; k++ {
+		if s := alias + strconv.Itoa(k); imports[s] == "" {
+			return s
+		}
+	}
+}
+
+// sanitizeIdent removes the characters that are not allowed in Go identifiers.
+func sanitizeIdent(s string) string {
+	var sb strings.Builder
+	for _, c := range s {
+		if c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' {
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
+// pkgNameSelector matches the package qualifiers of the type texts of
+// generated code, whose AST is no longer at hand.
+var pkgNameSelector = regexp.MustCompile(`([a-zA-Z0-9_]+\.)`)
+
+// renameQualifiers rewrites the package qualifiers of all type texts
+// of the interface according to rename (old name => new name).
+func (i *Interface) renameQualifiers(rename map[string]string) {
+	fn := func(s string) string {
+		return pkgNameSelector.ReplaceAllStringFunc(s, func(m string) string {
+			if alias, ok := rename[m[:len(m)-1]]; ok {
+				return alias + "."
+			}
+			return m
+		})
+	}
+	if alias, ok := rename[i.MockPackage]; ok {
+		i.MockPackage = alias
+	}
+	for k := range i.Instances {
+		i.Instances[k].TypeArgs = fn(i.Instances[k].TypeArgs)
+		i.Instances[k].SelfType = fn(i.Instances[k].SelfType)
+	}
+	i.SelfType = fn(i.SelfType)
+	i.SubsetOf = fn(i.SubsetOf)
+	i.TypeParams = fn(i.TypeParams)
+	i.EmbedInterfaces = fn(i.EmbedInterfaces)
+	for k := range i.Delegates {
+		d := &i.Delegates[k]
+		d.Type = fn(d.Type)
+		for j := range d.Methods {
+			d.Methods[j].Params = fn(d.Methods[j].Params)
+			d.Methods[j].Results = fn(d.Methods[j].Results)
+		}
+	}
+	for k := range i.Methods {
+		m := &i.Methods[k]
+		m.Params = fn(m.Params)
+		m.VarParams = fn(m.VarParams)
+		m.ResultTypes = fn(m.ResultTypes)
+		m.Results = fn(m.Results)
+		m.ResultTmplTypes = fn(m.ResultTmplTypes)
+		m.MockerTmplTypes = fn(m.MockerTmplTypes)
+		m.Fallback = fn(m.Fallback)
+		m.ReturnsParams = fn(m.ReturnsParams)
+		m.PagesTypes = fn(m.PagesTypes)
+		m.PagesElem = fn(m.PagesElem)
+		m.StubTypes = fn(m.StubTypes)
+		m.StubReq = fn(m.StubReq)
+	}
+}
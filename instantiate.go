@@ -0,0 +1,145 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Instance is a common instantiation of a generic interface, whose mock
+// gets a named alias with a non-generic constructor.
+type Instance struct {
+	Name        string // Name of the alias, e.g. "UserRepositoryMock"
+	Constructor string // Name of its constructor, e.g. "NewUserRepositoryMock"
+	TypeArgs    string // Type arguments, e.g. "[User]"
+	SelfType    string // Instantiated interface type, e.g. "Repository[User]"
+}
+
+// applyInstances parses the comma-separated instantiations s, such as
+// "Repository[User],Cache[string, int]", and adds them to the generic
+// interfaces they instantiate. Type arguments may be qualified by the
+// packages imported by the file declaring the interface.
+func applyInstances(interfaces []Interface, s string) {
+	expr, err := parser.ParseExpr("[]any{" + s + "}")
+	if err != nil {
+		panic(fmt.Errorf("error parsing instantiations(%s): %w", s, err))
+	}
+	names := make(map[string]bool)
+	for _, elt := range expr.(*ast.CompositeLit).Elts {
+		var (
+			x    ast.Expr
+			args []ast.Expr
+		)
+		switch e := elt.(type) {
+		case *ast.IndexExpr:
+			x, args = e.X, []ast.Expr{e.Index}
+		case *ast.IndexListExpr:
+			x, args = e.X, e.Indices
+		}
+		id, ok := x.(*ast.Ident)
+		if !ok {
+			text, _ := getTypeText(elt)
+			panic(fmt.Sprintf("invalid instantiation %s", text))
+		}
+
+		k := slices.IndexFunc(interfaces, func(i Interface) bool { return i.Name == id.Name })
+		if k < 0 {
+			panic(fmt.Sprintf("instantiated interface %s not found", id.Name))
+		}
+		i := &interfaces[k]
+		if n := strings.Count(i.TypeParamNames, ",") + 1; i.TypeParamNames == "" || n != len(args) {
+			panic(fmt.Sprintf("interface %s%s instantiated with %d type arguments", i.Name, i.TypeParamNames, len(args)))
+		}
+
+		var (
+			argTexts []string
+			prefix   strings.Builder
+		)
+		for _, arg := range args {
+			typeText, pkgNames := getTypeText(arg)
+			for _, pkgName := range pkgNames {
+				putInstanceImport(i, pkgName)
+			}
+			argTexts = append(argTexts, typeText)
+			prefix.WriteString(instanceName(arg))
+		}
+
+		name := prefix.String() + i.Name + "Mock"
+		if names[name] {
+			panic(fmt.Sprintf("duplicate instantiation %s", name))
+		}
+		names[name] = true
+		typeArgs := "[" + strings.Join(argTexts, ", ") + "]"
+		i.Instances = append(i.Instances, Instance{
+			Name:        name,
+			Constructor: "New" + name,
+			TypeArgs:    typeArgs,
+			SelfType:    strings.TrimSuffix(i.SelfType, i.TypeParamNames) + typeArgs,
+		})
+	}
+}
+
+// putInstanceImport adds the import of pkgName, as known by the file
+// declaring i, to the imports of i. It panics if the file doesn't import it.
+func putInstanceImport(i *Interface, pkgName string) {
+	if _, ok := i.Imports[pkgName]; ok {
+		return
+	}
+	node, err := parser.ParseFile(token.NewFileSet(), i.File, nil, parser.ImportsOnly)
+	if err != nil {
+		panic(fmt.Errorf("error parsing file(%s): %w", i.File, err))
+	}
+	pkgPath, ok := importNames(node)[pkgName]
+	if !ok {
+		panic(fmt.Sprintf("package %s of the type arguments of %s is not imported by %s", pkgName, i.Name, i.File))
+	}
+	if i.Imports == nil {
+		i.Imports = make(map[string]string)
+	}
+	i.Imports[pkgName] = pkgPath
+}
+
+// instanceName returns the part of the alias name derived from a type
+// argument: its identifiers, except package qualifiers, capitalized and
+// concatenated, e.g. "map[string]*model.User" gives "StringUser".
+func instanceName(arg ast.Expr) string {
+	var sb strings.Builder
+	ast.Inspect(arg, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.SelectorExpr:
+			sb.WriteString(capitalize(x.Sel.Name))
+			return false
+		case *ast.Ident:
+			sb.WriteString(capitalize(x.Name))
+		}
+		return true
+	})
+	return sb.String()
+}
+
+// capitalize upper-cases the first letter of s.
+func capitalize(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
@@ -18,11 +18,13 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"go/format"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 )
 
@@ -46,20 +48,66 @@ func init() {
 	fmt.Println("working directory:", workDir)
 }
 
+// The arities of the generated mockers, which the generated mocks use for
+// the methods that fit them, and gsmock.MockerT beyond. Wider arities suit
+// projects whose signatures are mostly wide, at the cost of a larger gsmock
+// package, e.g.: go run ./internal/mocker -max-params 8 -max-results 5
+var (
+	maxParams  = flag.Int("max-params", 7, "maximum number of parameters of the generated mockers")
+	maxResults = flag.Int("max-results", 4, "maximum number of results of the generated mockers")
+)
+
 func main() {
+	flag.Parse()
+	generate("../../gsmock", *maxParams, *maxResults)
+}
+
+// unboxedResults is the number of results up to which gsmock declares the
+// UnboxN functions by hand. Those of wider arities are generated.
+const unboxedResults = 5
+
+// generate writes mocker.go and mocker_grid_test.go into dir, the directory
+// of the gsmock package, for mockers of up to MaxParamCount parameters and
+// MaxResultCount results.
+func generate(dir string, MaxParamCount, MaxResultCount int) {
+	if MaxParamCount < 1 || MaxResultCount < 1 {
+		panic(fmt.Sprintf("invalid arity %d params, %d results: both must be at least 1", MaxParamCount, MaxResultCount))
+	}
+
 	s := bytes.NewBuffer(nil)
 
-	// Write the file header.
-	s.WriteString(`
+	// Write the file header, which imports fmt for the generated UnboxN functions.
+	fmtImport := ""
+	if MaxResultCount > unboxedResults {
+		fmtImport = `"fmt"`
+	}
+	_, _ = fmt.Fprintf(s, `
 	// Code generated by internal/mocker. DO NOT EDIT.
 
 	package gsmock
-	`)
 
-	const (
-		MaxParamCount  = 7
-		MaxResultCount = 4
+	import (
+		%s
+		"reflect"
+		"sync"
+		"time"
+	)
+	`, fmtImport)
+
+	// Write the header of the test file.
+	t := bytes.NewBuffer(nil)
+	t.WriteString(`
+	// Code generated by internal/mocker. DO NOT EDIT.
+
+	package gsmock_test
+
+	import (
+		"testing"
+
+		"github.com/go-spring/gs-mock/gsmock"
+		"github.com/go-spring/gs-mock/gsmock/gsmockassert"
 	)
+	`)
 
 	// Write these constants into the generated file.
 	_, _ = fmt.Fprintf(s, `
@@ -69,6 +117,11 @@ func main() {
 	)
 	`, MaxParamCount, MaxResultCount)
 
+	// Write the UnboxN functions of the generated mocks wider than those of gsmock.
+	for j := unboxedResults + 1; j <= MaxResultCount; j++ {
+		writeUnbox(s, j)
+	}
+
 	for i := 0; i <= MaxParamCount; i++ {
 		for j := 0; j <= MaxResultCount; j++ {
 			mockerName := fmt.Sprintf("Mocker%d%d", i, j)
@@ -100,10 +153,18 @@ func main() {
 			respArray := make([]string, j)
 			respVars := make([]string, j)
 			respParams := make([]string, j)
+			respFixtures := make([]string, j)
+			genParams := make([]string, j)
+			genNils := make([]string, j)
+			genCalls := make([]string, j)
 			for k := 0; k < j; k++ {
 				respArray[k] = fmt.Sprintf("R%d", k+1)
 				respVars[k] = fmt.Sprintf("r%d", k+1)
 				respParams[k] = respVars[k] + " " + respArray[k]
+				respFixtures[k] = fmt.Sprintf("fixtureOf[%s]()", respArray[k])
+				genParams[k] = fmt.Sprintf("g%d Gen[%s]", k+1, respArray[k])
+				genNils[k] = fmt.Sprintf("g%d == nil", k+1)
+				genCalls[k] = fmt.Sprintf("g%d.Generate()", k+1)
 			}
 
 			typeArgs := ""
@@ -127,15 +188,87 @@ func main() {
 				typeParams = "[" + typeParams + "]"
 			}
 
+			// Build parameter lists and comparisons for WhenArgs.
+			argParams := make([]string, i)
+			varArgParams := make([]string, i)
+			whenParams := make([]string, i)
+			varWhenParams := make([]string, i)
+			whenArgs := make([]string, i)
+			for k := 0; k < i; k++ {
+				argParams[k] = fmt.Sprintf("t%d %s", k+1, reqArray[k])
+				varArgParams[k] = fmt.Sprintf("t%d %s", k+1, varReqArray[k])
+				whenParams[k] = fmt.Sprintf("a%d %s", k+1, reqArray[k])
+				varWhenParams[k] = fmt.Sprintf("a%d %s", k+1, varReqArray[k])
+				whenArgs[k] = fmt.Sprintf("isEqual(a%d, t%d)", k+1, k+1)
+			}
+
+			// Build the type lists checked by ReturnFieldOfArg.
+			paramTypes := make([]string, i)
+			varParamTypes := make([]string, i)
+			for k := 0; k < i; k++ {
+				paramTypes[k] = fmt.Sprintf("reflect.TypeFor[%s]()", reqArray[k])
+				varParamTypes[k] = fmt.Sprintf("reflect.TypeFor[%s]()", varReqArray[k])
+			}
+			resultTypes := make([]string, j)
+			for k := 0; k < j; k++ {
+				resultTypes[k] = fmt.Sprintf("reflect.TypeFor[%s]()", respArray[k])
+			}
+
+			// Build the argument list passing the parameters of When predicates on.
+			callArgs := make([]string, i)
+			for k := 0; k < i; k++ {
+				callArgs[k] = fmt.Sprintf("a%d", k+1)
+			}
+
+			// Build the parameter list without the first parameter for WhenReq.
+			var reqTail, varReqTail, tailArgs []string
+			for k := 1; k < i; k++ {
+				reqTail = append(reqTail, reqArray[k])
+				varReqTail = append(varReqTail, varReqArray[k])
+				tailArgs = append(tailArgs, fmt.Sprintf("a%d", k+1))
+			}
+
+			// Build the argument captors.
+			type capture struct {
+				Pos   int    // 0-based position in params
+				Index int    // 1-based argument number
+				Type  string // argument type
+			}
+			captures := make([]capture, i)
+			varCaptures := make([]capture, i)
+			for k := 0; k < i; k++ {
+				captures[k] = capture{Pos: k, Index: k + 1, Type: reqArray[k]}
+				varCaptures[k] = capture{Pos: k, Index: k + 1, Type: varReqArray[k]}
+			}
+
+			// Build the result captors.
+			resultCaptures := make([]capture, j)
+			for k := 0; k < j; k++ {
+				resultCaptures[k] = capture{Pos: k, Index: k + 1, Type: respArray[k]}
+			}
+
+			// Build the mockers returned by Bind, which take all but the first argument.
+			var bindMocker, varBindMocker string
+			if i >= 1 {
+				bindMocker = fmt.Sprintf("Mocker%d%d", i-1, j)
+				if args := append(slices.Clone(reqArray[1:]), respArray...); len(args) > 0 {
+					bindMocker += "[" + strings.Join(args, ", ") + "]"
+				}
+			}
+			if i >= 2 {
+				varBindMocker = fmt.Sprintf("VarMocker%d%d", i-1, j)
+				varBindMocker += "[" + strings.Join(append(slices.Clone(reqArray[1:]), respArray...), ", ") + "]"
+			}
+
 			// Build type assertions for converting []any to typed arguments.
 			invokerArgs := make([]string, i)
 			varInvokerArgs := make([]string, i)
 			for k := 0; k < i; k++ {
-				invokerArgs[k] = fmt.Sprintf("params[%d].(T%d)", k, k+1)
+				invokerArgs[k] = fmt.Sprintf("param[T%d](params[%d])", k+1, k)
 				if k < i-1 {
-					varInvokerArgs[k] = fmt.Sprintf("params[%d].(T%d)", k, k+1)
+					varInvokerArgs[k] = fmt.Sprintf("param[T%d](params[%d])", k+1, k)
 				} else {
-					varInvokerArgs[k] = fmt.Sprintf("params[%d].([]T%d)", k, k+1)
+					varInvokerArgs[k] = fmt.Sprintf("param[[]T%d](params[%d])", k+1, k)
 				}
 			}
 
@@ -164,7 +297,23 @@ func main() {
 				"resp":           resp,
 				"respVars":       strings.Join(respVars, ", "),
 				"respParams":     strings.Join(respParams, ", "),
+				"fixtures":       strings.Join(respFixtures, ", "),
+				"genParams":      strings.Join(genParams, ", "),
+				"genNils":        strings.Join(genNils, " || "),
+				"genCalls":       strings.Join(genCalls, ", "),
 				"invokerArgs":    strings.Join(invokerArgs, ", "),
+				"argParams":      strings.Join(argParams, ", "),
+				"whenParams":     strings.Join(whenParams, ", "),
+				"whenArgs":       strings.Join(whenArgs, " && "),
+				"reqTail":        strings.Join(reqTail, ", "),
+				"tailArgs":       strings.Join(tailArgs, ", "),
+				"callArgs":       strings.Join(callArgs, ", "),
+				"paramTypes":     strings.Join(paramTypes, ", "),
+				"resultTypes":    strings.Join(resultTypes, ", "),
+				"captures":       captures,
+				"bindMocker":     bindMocker,
+				"paramCount":     i,
+				"resultCaptures": resultCaptures,
 			}
 
 			// Execute the appropriate template for this (i, j).
@@ -185,26 +334,183 @@ func main() {
 				"resp":           varResp,
 				"respVars":       strings.Join(respVars, ", "),
 				"respParams":     strings.Join(respParams, ", "),
+				"fixtures":       strings.Join(respFixtures, ", "),
+				"genParams":      strings.Join(genParams, ", "),
+				"genNils":        strings.Join(genNils, " || "),
+				"genCalls":       strings.Join(genCalls, ", "),
 				"invokerArgs":    strings.Join(varInvokerArgs, ", "),
+				"argParams":      strings.Join(varArgParams, ", "),
+				"whenParams":     strings.Join(varWhenParams, ", "),
+				"whenArgs":       strings.Join(whenArgs, " && "),
+				"reqTail":        strings.Join(varReqTail, ", "),
+				"tailArgs":       strings.Join(tailArgs, ", "),
+				"callArgs":       strings.Join(callArgs, ", "),
+				"paramTypes":     strings.Join(varParamTypes, ", "),
+				"resultTypes":    strings.Join(resultTypes, ", "),
+				"captures":       varCaptures,
+				"bindMocker":     varBindMocker,
+				"paramCount":     i,
+				"resultCaptures": resultCaptures,
 			}
 
 			// Execute the appropriate template for this (i, j).
 			if err := tmplMock.Execute(s, data); err != nil {
 				panic(fmt.Errorf("error executing template(%s): %w", varMockerName, err))
 			}
+
+			// Generate the tests of both mockers.
+			for _, variadic := range []bool{false, true} {
+				data = testData(i, j, variadic)
+				if err := tmplTest.Execute(t, data); err != nil {
+					panic(fmt.Errorf("error executing test template(%s): %w", data["mockerName"], err))
+				}
+			}
+		}
+	}
+
+	writeSource(filepath.Join(dir, "mocker.go"), s)
+	writeSource(filepath.Join(dir, "mocker_grid_test.go"), t)
+}
+
+// writeUnbox writes the function UnboxN extracting n return values from a
+// mock result slice, like the Unbox1 to Unbox5 functions of gsmock.
+func writeUnbox(s *bytes.Buffer, n int) {
+	var typeParams, results, asserts []string
+	for k := 1; k <= n; k++ {
+		typeParams = append(typeParams, fmt.Sprintf("R%d", k))
+		results = append(results, fmt.Sprintf("r%d R%d", k, k))
+		asserts = append(asserts, fmt.Sprintf("r%d, _ = ret[%d].(R%d)", k, k-1, k))
+	}
+	_, _ = fmt.Fprintf(s, `
+	// Unbox%[1]d extracts %[1]d return values from a mock result slice.
+	//
+	// It panics if the number of return values is not exactly %[1]d.
+	// Type assertion failures result in the zero value of the target type.
+	func Unbox%[1]d[%[2]s any](ret []any) (%[3]s) {
+		if len(ret) == %[1]d {
+			%[4]s
+		} else {
+			panic(fmt.Sprintf("expected %[1]d return values, but got %%d", len(ret)))
 		}
+		return
 	}
+	`, n, strings.Join(typeParams, ", "), strings.Join(results, ", "), strings.Join(asserts, "\n"))
+}
 
-	// Format the generated code using go/format.
+// writeSource formats the generated code and writes it to fileName.
+func writeSource(fileName string, s *bytes.Buffer) {
 	b, err := format.Source(s.Bytes())
 	if err != nil {
-		panic(fmt.Errorf("error formatting source code: %w", err))
+		panic(fmt.Errorf("error formatting source code(%s): %w", fileName, err))
 	}
-
-	// Write the formatted code to the output file.
-	const fileName = "../../gsmock/mocker.go"
 	err = os.WriteFile(fileName, b, os.ModePerm)
 	if err != nil {
 		panic(fmt.Errorf("error writing file(%s): %w", fileName, err))
 	}
 }
+
+// testData prepares the template data of the tests of Mocker{i}{j}, or of
+// VarMocker{i}{j} if variadic is true. All parameters and results are ints,
+// the calls are made with the arguments 1, 2, ..., and the handlers return
+// the sum of their arguments plus 1, 2, ...
+func testData(i, j int, variadic bool) map[string]any {
+	mockerName := fmt.Sprintf("Mocker%d%d", i, j)
+	methodMockName := fmt.Sprintf("Method%d%d", i, j)
+	if variadic {
+		mockerName = "Var" + mockerName
+		methodMockName = "Var" + methodMockName
+	}
+
+	type capture struct {
+		Index int    // 1-based argument number
+		Type  string // argument type
+		Elem  string // argument value as an element of a []Type literal
+	}
+
+	var (
+		funcParams, whenParams []string
+		args, otherArgs        []string
+		terms                  []string
+		captures               []capture
+	)
+	pred := "true"
+	for k := 1; k <= i; k++ {
+		typ, funcTyp, arg, term := "int", "int", fmt.Sprint(k), fmt.Sprintf("a%d", k)
+		if variadic && k == i {
+			typ, funcTyp = "[]int", "...int"
+			arg, term = "[]int{"+arg+"}", term+"[0]"
+		}
+		funcParams = append(funcParams, fmt.Sprintf("a%d %s", k, funcTyp))
+		whenParams = append(whenParams, fmt.Sprintf("a%d %s", k, typ))
+		args = append(args, arg)
+		terms = append(terms, term)
+		captures = append(captures, capture{Index: k, Type: typ, Elem: strings.TrimPrefix(arg, typ)})
+		if k == 1 {
+			pred = term + " == 1"
+			otherArgs = append(otherArgs, strings.Replace(arg, "1", "0", 1))
+		} else {
+			otherArgs = append(otherArgs, arg)
+		}
+	}
+
+	sum := "0"
+	if len(terms) > 0 {
+		sum = strings.Join(terms, " + ")
+	}
+	bindSum := strings.Join(append([]string{"1"}, terms[min(1, i):]...), " + ")
+
+	type result struct {
+		Index int    // 1-based result number
+		Value string // result value of the handler
+	}
+
+	var resp, handled, handledValues, values, zeros []string
+	var results []result
+	for k := 1; k <= j; k++ {
+		resp = append(resp, "int")
+		handled = append(handled, fmt.Sprintf("s + %d", k))
+		handledValues = append(handledValues, fmt.Sprint(i*(i+1)/2+k))
+		results = append(results, result{Index: k, Value: handledValues[k-1]})
+		values = append(values, fmt.Sprint(k))
+		zeros = append(zeros, "0")
+	}
+
+	// ReturnFieldOfArg returns the first argument, 1, as the last result,
+	// unless it is the variadic []int.
+	var fieldValues []string
+	if j > 0 && i > 0 && (!variadic || i >= 2) {
+		fieldValues = append(slices.Clone(zeros[:j-1]), "1")
+	}
+
+	respList := ""
+	if len(resp) > 0 {
+		respList = "(" + strings.Join(resp, ", ") + ")"
+	}
+
+	var tailParams []string
+	if i > 0 {
+		tailParams = whenParams[1:]
+	}
+
+	return map[string]any{
+		"mockerName":     mockerName,
+		"methodMockName": methodMockName,
+		"funcParams":     strings.Join(funcParams, ", "),
+		"whenParams":     strings.Join(whenParams, ", "),
+		"tailParams":     strings.Join(tailParams, ", "),
+		"resp":           respList,
+		"args":           strings.Join(args, ", "),
+		"otherArgs":      strings.Join(otherArgs, ", "),
+		"pred":           pred,
+		"sum":            sum,
+		"bindSum":        bindSum,
+		"handled":        strings.Join(handled, ", "),
+		"handledValues":  strings.Join(handledValues, ", "),
+		"values":         strings.Join(values, ", "),
+		"zeros":          strings.Join(zeros, ", "),
+		"captures":       captures,
+		"results":        results,
+		"fieldValues":    strings.Join(fieldValues, ", "),
+		"bind":           i >= 1 && !variadic || i >= 2,
+	}
+}
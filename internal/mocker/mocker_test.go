@@ -0,0 +1,136 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// wideSource declares an interface whose methods exceed the default
+// arities of the mockers. Method mocks keep one parameter for the
+// receiver, so Seven needs mockers of 8 parameters.
+const wideSource = `package wide
+
+type Wide interface {
+	Six() (int, int, int, int, int, error)
+	Seven(a, b, c, d, e, f, g int) error
+}
+`
+
+// TestGenerateWide regenerates the mockers of a copy of the module with
+// wider arities, and checks that the gs-mock tool built against them
+// generates numbered mocks of the wide methods, which compile.
+func TestGenerateWide(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a copy of the module")
+	}
+	tmp := t.TempDir()
+	copyModule(t, "../..", tmp)
+	generate(filepath.Join(tmp, "gsmock"), 8, 6)
+
+	dir := filepath.Join(tmp, "wide")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "src.go"), []byte(wideSource), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	goCmd(t, tmp, "build", "-o", filepath.Join(tmp, "gs-mock"), ".")
+	cmd := exec.Command(filepath.Join(tmp, "gs-mock"), "-o", "src_mock.go")
+	cmd.Dir = dir
+	if b, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("gs-mock: %v\n%s", err, b)
+	}
+	b, err := os.ReadFile(filepath.Join(dir, "src_mock.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"gsmock.Mocker06[int, int, int, int, int, error]", "gsmock.Unbox6[", "gsmock.Mocker71["} {
+		if !strings.Contains(string(b), s) {
+			t.Errorf("generated mock doesn't use %s", s)
+		}
+	}
+
+	goCmd(t, tmp, "vet", "./gsmock", "./wide")
+}
+
+// copyModule copies the Go files of the module at src needed to build the
+// gs-mock tool and the gsmock package into dst, without their tests and
+// the nested modules.
+func copyModule(t *testing.T, src, dst string) {
+	t.Helper()
+	for _, f := range []string{"go.mod", "go.sum"} {
+		copyFile(t, filepath.Join(src, f), filepath.Join(dst, f))
+	}
+	err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(src, path)
+		if d.IsDir() {
+			if rel == "." {
+				return nil
+			}
+			if rel != "gsmock" && !strings.HasPrefix(rel, "gsmock"+string(filepath.Separator)) {
+				return filepath.SkipDir
+			}
+			if _, err = os.Stat(filepath.Join(path, "go.mod")); err == nil || d.Name() == "testdata" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		copyFile(t, path, filepath.Join(dst, rel))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// copyFile copies the file src to dst, creating its directory.
+func copyFile(t *testing.T, src, dst string) {
+	t.Helper()
+	b, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(dst, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// goCmd runs the go command with args in dir.
+func goCmd(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	if b, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go %s: %v\n%s", strings.Join(args, " "), err, b)
+	}
+}
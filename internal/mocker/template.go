@@ -25,14 +25,24 @@ var tmplMock = template.Must(template.New("").Parse(`
 
 // {{.mockerName}} provides a configurable mock for the target function.
 type {{.mockerName}}{{.typeParams}} struct {
+	mockerBase
 	fnHandle func({{.req}}) {{.resp}}
 	fnWhen   func({{.req}}) bool
 	fnReturn func() {{.resp}}
+	{{- if .argParams}}
+	fnKey    func({{.req}}) any
+	{{- end}}
 }
 
 // Handle sets a custom handler function for intercepted calls.
+// If a predicate is set via When, the handler only applies to matching calls.
+// It panics if fn is nil.
 func (m *{{.mockerName}}{{.typeArgs}}) Handle(fn func({{.req}}) {{.resp}}) {
+	if fn == nil {
+		m.rejectNil("Handle")
+	}
 	m.fnHandle = fn
+	m.defaults, m.bind = nil, nil
 }
 
 // When sets a predicate function that determines whether the mock applies.
@@ -41,58 +51,468 @@ func (m *{{.mockerName}}{{.typeArgs}}) When(fn func({{.req}}) bool) *{{.mockerNa
 	return m
 }
 
+
+// WhenNot sets a predicate that determines whether the mock does NOT apply.
+func (m *{{.mockerName}}{{.typeArgs}}) WhenNot(fn func({{.req}}) bool) *{{.mockerName}}{{.typeArgs}} {
+	return m.When(func({{.whenParams}}) bool {
+		return !fn({{.callArgs}})
+	})
+}
+
+// Except excludes the calls accepted by fn from the calls the mock applies
+// to, so that they are left to other mocks. Unlike When, it doesn't replace
+// the current predicate, but narrows it: call it after When, WhenArgs, etc.
+// It can be chained to exclude several kinds of calls.
+func (m *{{.mockerName}}{{.typeArgs}}) Except(fn func({{.req}}) bool) *{{.mockerName}}{{.typeArgs}} {
+	when := m.fnWhen
+	return m.When(func({{.whenParams}}) bool {
+		return (when == nil || when({{.callArgs}})) && !fn({{.callArgs}})
+	})
+}
+
+{{- if .argParams}}
+
+// WhenArgs sets a predicate that matches when all arguments equal the given values.
+// Equality honors comparers registered via RegisterComparer.
+func (m *{{.mockerName}}{{.typeArgs}}) WhenArgs({{.argParams}}) *{{.mockerName}}{{.typeArgs}} {
+	return m.When(func({{.whenParams}}) bool {
+		return {{.whenArgs}}
+	})
+}
+{{- end}}
+{{- if .argParams}}
+
+// WhenReq is like When, but the predicate omits the first parameter.
+// It is meant for context-first functions, whose context.Context
+// predicates rarely need to inspect.
+func (m *{{.mockerName}}{{.typeArgs}}) WhenReq(fn func({{.reqTail}}) bool) *{{.mockerName}}{{.typeArgs}} {
+	return m.When(func({{.whenParams}}) bool {
+		return fn({{.tailArgs}})
+	})
+}
+
+// WhenKey sets the function computing the key of every call, by which
+// ReturnForKey looks the results up in a hash map. Unlike as many When
+// mocks, scanned in turn, table-style data with hundreds of entries is
+// then dispatched in constant time. It panics if fn is nil.
+func (m *{{.mockerName}}{{.typeArgs}}) WhenKey(fn func({{.req}}) any) *{{.mockerName}}{{.typeArgs}} {
+	if fn == nil {
+		m.rejectNil("WhenKey")
+	}
+	m.fnKey = fn
+	return m
+}
+
+// ReturnForKey registers the results by key: a call whose key, computed
+// by the function set with WhenKey, is in returns returns the results of
+// its function, and calls with other keys are not matched by the mock.
+// A predicate set via When applies first. It panics if WhenKey was not
+// called.
+func (m *{{.mockerName}}{{.typeArgs}}) ReturnForKey(returns map[any]func() {{.resp}}) {
+	key := m.fnKey
+	if key == nil {
+		panic("gsmock: ReturnForKey called without WhenKey")
+	}
+	when := m.fnWhen
+	m.When(func({{.whenParams}}) bool {
+		if when != nil && !when({{.callArgs}}) {
+			return false
+		}
+		_, ok := returns[key({{.callArgs}})]
+		return ok
+	})
+	m.Handle(func({{.whenParams}}) {{.resp}} {
+		{{if .respVars}}return {{end}}returns[key({{.callArgs}})]()
+	})
+}
+{{- end}}
+
+{{- range .captures}}
+
+// WhenArg{{.Index}} sets a predicate that matches when argument {{.Index}} equals v,
+// whatever the other arguments are.
+// Equality honors comparers registered via RegisterComparer.
+func (m *{{$.mockerName}}{{$.typeArgs}}) WhenArg{{.Index}}(v {{.Type}}) *{{$.mockerName}}{{$.typeArgs}} {
+	return m.When(func({{$.whenParams}}) bool {
+		return isEqual(a{{.Index}}, v)
+	})
+}
+{{- end}}
+
+{{- if .bindMocker}}
+
+// Bind returns a mocker of the calls whose first argument equals t1, whose
+// predicates and handlers only take the other arguments. It suits leading
+// arguments that are the same throughout a test, such as contexts or tenant
+// IDs, and can be chained to bind the next arguments too.
+// Equality honors comparers registered via RegisterComparer.
+func (m *{{.mockerName}}{{.typeArgs}}) Bind(t1 T1) *{{.bindMocker}} {
+	b := &{{.bindMocker}}{mockerBase: m.bound()}
+	m.When(func({{.whenParams}}) bool {
+		if !isEqual(a1, t1) || b.fnHandle == nil && b.fnReturn == nil {
+			return false
+		}
+		return b.fnWhen == nil || b.fnWhen({{.tailArgs}})
+	})
+	m.Handle(func({{.whenParams}}) {{.resp}} {
+		if b.fnHandle != nil {
+			{{if .respVars}} return {{end}} b.fnHandle({{.tailArgs}})
+		} else {
+			{{if .respVars}} return {{end}} b.fnReturn()
+		}
+	})
+	m.bind = &b.mockerBase // b is reported the calls and defaults their results
+	return b
+}
+{{- end}}
+
+{{- if .argParams}}
+
+// SetArg makes the matched calls store value into the pointer passed as
+// argument n, 1-based, before returning, like the out parameter v of
+// "Decode(v any) error". value may also be a pointer to the value to store.
+// The call panics if the argument isn't a pointer value can be stored into.
+func (m *{{.mockerName}}{{.typeArgs}}) SetArg(n int, value any) *{{.mockerName}}{{.typeArgs}} {
+	m.setArg({{.paramCount}}, n, value)
+	return m
+}
+{{- end}}
+
 // Return sets a function that produces return values when the mock is matched.
+// It panics if fn is nil.
 func (m *{{.mockerName}}{{.typeArgs}}) Return(fn func() {{.resp}}) {
+	if fn == nil {
+		m.rejectNil("Return")
+	}
 	if m.fnWhen == nil {
 		m.fnWhen = func({{.req}}) bool { return true }
 	}
 	m.fnReturn = fn
+	m.defaults = nil
+}
+
+// ReturnFrom sets a provider that produces return values when the mock is
+// matched. The provider is evaluated at call time, on every matched call.
+// It panics if provider is nil.
+func (m *{{.mockerName}}{{.typeArgs}}) ReturnFrom(provider func() {{.resp}}) {
+	if provider == nil {
+		m.rejectNil("ReturnFrom")
+	}
+	m.Return(provider)
 }
 
+// ReturnLazy is like ReturnFrom, but the provider runs at most once, on the
+// first matched call, and its results are returned by every matched call.
+// It suits return values that are expensive to build and may not be needed.
+// It panics if provider is nil.
+func (m *{{.mockerName}}{{.typeArgs}}) ReturnLazy(provider func() {{.resp}}) {
+	if provider == nil {
+		m.rejectNil("ReturnLazy")
+	}
+	var (
+		once sync.Once
+		ret  func() {{.resp}}
+	)
+	m.Return(func() {{.resp}} {
+		once.Do(func() {
+			{{if .respVars}} {{.respVars}} := {{end}} provider()
+			ret = func() {{.resp}} { {{if .respVars}} return {{.respVars}} {{end}} }
+		})
+		{{if .respVars}} return {{end}} ret()
+	})
+}
+
+{{- if .respVars}}
+
+// ReturnGen sets one generator per result, drawn in order on every matched
+// call to produce the return values. Adapters such as FromDrawer let the
+// generators of property-based testing frameworks drive them.
+// It panics if a generator is nil.
+func (m *{{.mockerName}}{{.typeArgs}}) ReturnGen({{.genParams}}) {
+	if {{.genNils}} {
+		m.rejectNil("ReturnGen")
+	}
+	m.Return(func() {{.resp}} {
+		return {{.genCalls}}
+	})
+}
+
+// ReturnFixture configures the mock to return the fixtures registered with
+// RegisterFixture for its result types, nil for errors without fixture.
+// A matched call panics if a fixture is missing.
+func (m *{{.mockerName}}{{.typeArgs}}) ReturnFixture() {
+	m.Return(func() {{.resp}} {
+		return {{.fixtures}}
+	})
+}
+{{- end}}
+
 // ReturnValue is a convenience wrapper around Return that uses fixed values.
 func (m *{{.mockerName}}{{.typeArgs}}) ReturnValue({{.respParams}}) {
 	m.Return(func() {{.resp}} { {{if .respVars}} return {{.respVars}} {{end}} })
 }
 
-// ReturnDefault configures the mock to return zero values for all return types.
+// ReturnDefault configures the mock to return default values for all return
+// types: the values registered with RegisterDefaultFor or RegisterDefault,
+// or else zero values. They are those of the Manager dispatching the call,
+// which is a child Manager for the mocks inherited from its snapshot.
 func (m *{{.mockerName}}{{.typeArgs}}) ReturnDefault() {
-	m.Return(func() ({{.respParams}}) { {{if .respVars}} return {{.respVars}} {{end}} })
+	m.Return(func() {{if .respParams}}({{.respParams}}){{end}} { {{if .respVars}} return {{end}} })
+	{{- if .respVars}}
+	m.defaults = []reflect.Type{ {{.resultTypes}} }
+	{{- end}}
+}
+{{- if and .argParams .respVars}}
+
+// ReturnFieldOfArg configures the mock to return the field at path of its
+// argument arg as its result into, both 1-based, and default values for
+// the other results, as in ReturnDefault. path is a dot-separated list of
+// field names, followed through pointers, e.g. "User.ID", or empty for the
+// argument itself. It panics if arg, path or into don't fit the signature.
+func (m *{{.mockerName}}{{.typeArgs}}) ReturnFieldOfArg(arg int, path string, into int) {
+	f := m.fieldOfArg([]reflect.Type{ {{.paramTypes}} }, arg, path, []reflect.Type{ {{.resultTypes}} }, into)
+	m.Handle(func({{.whenParams}}) ({{.respParams}}) {
+		switch v := f.get([]any{ {{.callArgs}} }); into {
+		{{- range .resultCaptures}}
+		case {{.Index}}:
+			r{{.Index}}, _ = v.({{.Type}})
+		{{- end}}
+		}
+		return
+	})
+	m.defaults = []reflect.Type{ {{.resultTypes}} }
+	m.defaults[into-1] = nil
+}
+{{- end}}
+
+// Freeze checksums the pointers, slices and maps returned by matched calls,
+// and makes the Manager report on Close those mutated afterward, e.g. by
+// code under test modifying canned data it shares with other callers.
+func (m *{{.mockerName}}{{.typeArgs}}) Freeze() *{{.mockerName}}{{.typeArgs}} {
+	m.freeze = freezeUnchanged
+	return m
+}
+
+// ExpectMutation is the opposite of Freeze: the Manager reports on Close
+// the returned pointers, slices and maps that were NOT mutated afterward.
+func (m *{{.mockerName}}{{.typeArgs}}) ExpectMutation() *{{.mockerName}}{{.typeArgs}} {
+	m.freeze = freezeMutated
+	return m
+}
+
+// Barrier makes matched calls wait until n of them are in progress at once,
+// then releases them together, in groups of n, before their handler or
+// return function runs. It reproduces race-prone interleavings of the code
+// under test deterministically. It panics if n is not positive.
+func (m *{{.mockerName}}{{.typeArgs}}) Barrier(n int) *{{.mockerName}}{{.typeArgs}} {
+	m.barrier = newBarrier(n)
+	return m
+}
+
+// Timeout fails the matched calls whose handler or return function blocks
+// longer than d, e.g. waiting on a channel that never fires, with the
+// stacks of all goroutines, instead of hanging until the test binary times
+// out. The bound test fails if there is one, and the blocked call panics.
+// The functions then run in their own goroutine. It panics if d is not
+// positive.
+func (m *{{.mockerName}}{{.typeArgs}}) Timeout(d time.Duration) *{{.mockerName}}{{.typeArgs}} {
+	m.setTimeout(d)
+	return m
+}
+
+// OnCall restricts the mock to the call n of the function, 1-based, whatever
+// its arguments and whichever mocks matched the previous calls, e.g. to make
+// the third call fail: register it before the mocks of the other calls.
+// Predicates set via When still apply. Calls are numbered from the creation
+// of the Manager or its last Reset. It panics if n is not positive.
+func (m *{{.mockerName}}{{.typeArgs}}) OnCall(n int) *{{.mockerName}}{{.typeArgs}} {
+	m.setOnCall(n)
+	return m
+}
+
+// Never forbids the calls matched by this mocker. If such a call occurs,
+// the test fails immediately with the call's parameters and stack.
+// Combine it with When to forbid only specific calls.
+func (m *{{.mockerName}}{{.typeArgs}}) Never() {
+	m.never = true
+	m.ReturnDefault()
+}
+{{- range .captures}}
+
+// CaptureArg{{.Index}} returns a Captor collecting argument {{.Index}} of every matched call.
+func (m *{{$.mockerName}}{{$.typeArgs}}) CaptureArg{{.Index}}() *Captor[{{.Type}}] {
+	c := &Captor[{{.Type}}]{}
+	m.captures = append(m.captures, func(params []any) {
+		c.capture(param[{{.Type}}](params[{{.Pos}}]))
+	})
+	return c
+}
+{{- end}}
+
+{{- range .resultCaptures}}
+
+// CaptureResult{{.Index}} returns a Captor collecting result {{.Index}} of every matched call.
+func (m *{{$.mockerName}}{{$.typeArgs}}) CaptureResult{{.Index}}() *Captor[{{.Type}}] {
+	c := &Captor[{{.Type}}]{}
+	m.resultCaptures = append(m.resultCaptures, func(ret []any) {
+		v, _ := ret[{{.Pos}}].({{.Type}}) // the zero value if nil
+		c.capture(v)
+	})
+	return c
+}
+{{- end}}
+
+// match reports whether the mocker applies to a call with params.
+func (m *{{.mockerName}}{{.typeArgs}}) match(params []any) bool {
+	if m.fnWhen != nil && !m.fnWhen({{.invokerArgs}}) {
+		return false
+	}
+	return m.fnHandle != nil || m.fnReturn != nil
+}
+
+// call runs the handler or return function of a matched call with params
+// and returns its boxed results.
+func (m *{{.mockerName}}{{.typeArgs}}) call(params []any) []any {
+	if m.fnHandle != nil {
+		{{if .respVars}} {{.respVars}} := {{end}} m.fnHandle({{.invokerArgs}})
+		return {{if .respVars}} Box({{.respVars}}) {{else}} []any{} {{end}}
+	}
+	{{if .respVars}} {{.respVars}} := {{end}} m.fnReturn()
+	return {{if .respVars}} Box({{.respVars}}) {{else}} []any{} {{end}}
 }
 
 // {{.invokerName}} implements Invoker for {{.mockerName}}.
+//
+// Deprecated: the constructors of {{.mockerName}} register an Invoker
+// shared by all the mockers. {{.invokerName}} dispatches the calls the
+// same way, and is only kept for compatibility.
 type {{.invokerName}}{{.typeParams}} struct {
 	*{{.mockerName}}{{.typeArgs}}
 }
 
 // Invoke dispatches the call to the configured handler or return function.
+// A panic in the mocker's functions is re-raised as a *PanicError.
 func (m *{{.invokerName}}{{.typeArgs}}) Invoke(params []any) ([]any, bool) {
-	if m.fnHandle != nil {
-		{{if .respVars}} {{.respVars}} := {{end}} m.fnHandle({{.invokerArgs}})
-		return []any{ {{if .respVars}} {{.respVars}} {{end}} }, true
-	}
-	if m.fnWhen != nil {
-		if ok := m.fnWhen({{.invokerArgs}}); ok {
-			{{if .respVars}} {{.respVars}} := {{end}} m.fnReturn()
-			return []any{ {{if .respVars}} {{.respVars}} {{end}} }, true
-		}
-	}
-	return nil, false
+	return m.invoker(m.match, m.call).Invoke(params)
 }
 
 // {{.funcMockName}} creates a new {{.mockerName}} and registers it with the Manager.
 func {{.funcMockName}}{{.typeParams}}(f func({{.funcReq}}) {{.resp}}, r *Manager) *{{.mockerName}}{{.typeArgs}} {
 	PatchOnce(f)
 	m := &{{.mockerName}}{{.typeArgs}}{}
-	i := &{{.invokerName}}{{.typeArgs}}{ {{.mockerName}}: m}
-	r.addInvoker(nil, f, i)
+	m.register(r, nil, f, m.invoker(m.match, m.call))
 	return m
 }
 
 // {{.methodMockName}} creates a new {{.mockerName}} for mocking a method on a receiver.
 func {{.methodMockName}}{{.typeParams}}(receiver any, f func({{.funcReq}}) {{.resp}}, r *Manager) *{{.mockerName}}{{.typeArgs}} {
 	m := &{{.mockerName}}{{.typeArgs}}{}
-	i := &{{.invokerName}}{{.typeArgs}}{ {{.mockerName}}: m}
-	r.addInvoker(receiver, f, i)
+	m.register(r, receiver, f, m.invoker(m.match, m.call))
 	return m
 }
 `))
+
+var tmplTest = template.Must(template.New("").Parse(`
+func Test{{.mockerName}}(t *testing.T) {
+	fn := func({{.funcParams}}) {{.resp}} { {{if .zeros}} return {{.zeros}} {{end}} }
+	r := gsmock.NewManager()
+
+	// Test case: Unmocked - should not match
+	_, ok := gsmock.Invoke(r, nil, fn, {{.args}})
+	gsmockassert.Equal(t, ok, false)
+
+	// Test case: Handle - should return the handler's results
+	r.Reset()
+	{{if .results}}h := {{end}}gsmock.{{.methodMockName}}(nil, fn, r)
+	{{- range .results}}
+	d{{.Index}} := h.CaptureResult{{.Index}}()
+	{{- end}}
+	{{if .results}}h{{else}}gsmock.{{.methodMockName}}(nil, fn, r){{end}}.Handle(func({{.whenParams}}) {{.resp}} {{if .handled}}{
+		s := {{.sum}}
+		return {{.handled}}
+	}{{else}}{}{{end}})
+	ret, ok := gsmock.Invoke(r, nil, fn, {{.args}})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{ {{.handledValues}} })
+	{{- range .results}}
+	gsmockassert.Equal(t, d{{.Index}}.Values(), []int{ {{.Value}} })
+	{{- end}}
+
+	// Test case: When && Return - should only match the calls accepted by When
+	r.Reset()
+	gsmock.{{.methodMockName}}(nil, fn, r).
+		When(func({{.whenParams}}) bool { return {{.pred}} }).
+		Return(func() {{.resp}} { {{if .values}} return {{.values}} {{end}} })
+	ret, ok = gsmock.Invoke(r, nil, fn, {{.args}})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{ {{.values}} })
+	{{- if .otherArgs}}
+	_, ok = gsmock.Invoke(r, nil, fn, {{.otherArgs}})
+	gsmockassert.Equal(t, ok, false)
+	{{- end}}
+
+	// Test case: {{if .args}}WhenArgs && {{end}}ReturnValue - should return the given values
+	r.Reset()
+	gsmock.{{.methodMockName}}(nil, fn, r){{if .args}}.WhenArgs({{.args}}){{end}}.ReturnValue({{.values}})
+	ret, ok = gsmock.Invoke(r, nil, fn, {{.args}})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{ {{.values}} })
+	{{- if .otherArgs}}
+	_, ok = gsmock.Invoke(r, nil, fn, {{.otherArgs}})
+	gsmockassert.Equal(t, ok, false)
+	{{- end}}
+
+	{{- if .otherArgs}}
+
+	// Test case: WhenKey && ReturnForKey - should return the values of the call's key
+	r.Reset()
+	gsmock.{{.methodMockName}}(nil, fn, r).
+		WhenKey(func({{.whenParams}}) any { return {{.pred}} }).
+		ReturnForKey(map[any]func() {{.resp}}{
+			true: func() {{.resp}} { {{if .values}} return {{.values}} {{end}} },
+		})
+	ret, ok = gsmock.Invoke(r, nil, fn, {{.args}})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{ {{.values}} })
+	_, ok = gsmock.Invoke(r, nil, fn, {{.otherArgs}})
+	gsmockassert.Equal(t, ok, false)
+	{{- end}}
+
+	// Test case: ReturnDefault - should return zero values
+	r.Reset()
+	{{if .captures}}m := {{end}}gsmock.{{.methodMockName}}(nil, fn, r)
+	{{- range .captures}}
+	c{{.Index}} := m.CaptureArg{{.Index}}()
+	{{- end}}
+	{{if .captures}}m{{else}}gsmock.{{.methodMockName}}(nil, fn, r){{end}}.ReturnDefault()
+	ret, ok = gsmock.Invoke(r, nil, fn, {{.args}})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{ {{.zeros}} })
+	{{- range .captures}}
+	gsmockassert.Equal(t, c{{.Index}}.Values(), []{{.Type}}{ {{.Elem}} })
+	{{- end}}
+	{{- if .fieldValues}}
+
+	// Test case: ReturnFieldOfArg - should return the first argument as the last result
+	r.Reset()
+	gsmock.{{.methodMockName}}(nil, fn, r).ReturnFieldOfArg(1, "", {{len .results}})
+	ret, ok = gsmock.Invoke(r, nil, fn, {{.args}})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{ {{.fieldValues}} })
+	{{- end}}
+	{{- if .bind}}
+
+	// Test case: Bind - should only match the calls with the bound argument
+	r.Reset()
+	gsmock.{{.methodMockName}}(nil, fn, r).Bind(1).Handle(func({{.tailParams}}) {{.resp}} {{if .handled}}{
+		s := {{.bindSum}}
+		return {{.handled}}
+	}{{else}}{}{{end}})
+	ret, ok = gsmock.Invoke(r, nil, fn, {{.args}})
+	gsmockassert.Equal(t, ok, true)
+	gsmockassert.Equal(t, ret, []any{ {{.handledValues}} })
+	_, ok = gsmock.Invoke(r, nil, fn, {{.otherArgs}})
+	gsmockassert.Equal(t, ok, false)
+	{{- end}}
+}
+`))
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"go/types"
+	"os"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// packageLoader loads the type-checked packages imported by the scanned
+// sources, located from a directory like the go command does, and caches
+// them by import path. Unlike parsing their files, type-checking resolves
+// aliases, embedded interfaces and packages of other modules.
+type packageLoader struct {
+	dir  string                    // directory the packages are located from
+	pkgs map[string]*types.Package // loaded packages, nil if they can't be
+}
+
+// newPackageLoader returns a packageLoader locating packages from dir.
+func newPackageLoader(dir string) *packageLoader {
+	return &packageLoader{dir: dir, pkgs: make(map[string]*types.Package)}
+}
+
+// load returns the package pkgPath, or nil if it can't be loaded or
+// doesn't type-check.
+func (l *packageLoader) load(pkgPath string) *types.Package {
+	if p, ok := l.pkgs[pkgPath]; ok {
+		return p
+	}
+	var p *types.Package
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes,
+		Dir:  l.dir,
+		Env:  readonlyEnv(),
+	}
+	pkgs, err := packages.Load(cfg, pkgPath)
+	if err == nil && len(pkgs) == 1 && len(pkgs[0].Errors) == 0 {
+		p = pkgs[0].Types
+	}
+	l.pkgs[pkgPath] = p
+	return p
+}
+
+// readonlyEnv returns the environment of the go command run by packages.Load,
+// without the -mod=mod flag which would let it add the modules of the loaded
+// packages to go.mod: the generator never edits go.mod.
+func readonlyEnv() []string {
+	env := os.Environ()
+	for k, v := range env {
+		if flags, ok := strings.CutPrefix(v, "GOFLAGS="); ok {
+			fields := strings.Fields(flags)
+			fields = slices.DeleteFunc(fields, func(f string) bool { return f == "-mod=mod" })
+			env[k] = "GOFLAGS=" + strings.Join(fields, " ")
+		}
+	}
+	return env
+}
@@ -25,11 +25,12 @@ import (
 	"go/parser"
 	"go/printer"
 	"go/token"
+	"hash/fnv"
 	"io"
 	"maps"
 	"os"
 	"path/filepath"
-	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -41,20 +42,63 @@ import (
 // but can be overridden for testing or redirection.
 var stdOut io.Writer = os.Stdout
 
+// stdErr is the writer used for outputting warnings.
+var stdErr io.Writer = os.Stderr
+
 // ToolVersion specifies the version of this mock generation tool.
-const ToolVersion = "v0.0.8"
+const ToolVersion = "v0.0.9"
 
 // flags holds the command-line flag values for output file and interface selection.
 var flags struct {
-	OutputFile     string // Path to the output Go file for generated mocks.
-	MockInterfaces string // Comma-separated list of interface names to mock.
+	OutputFile     string        // Path to the output Go file for generated mocks.
+	DestDir        string        // Directory of the package receiving the mocks.
+	Pkg            string        // Import path of another package whose interfaces to mock.
+	Source         string        // Single file whose interfaces to mock.
+	GoVersion      string        // Go version targeted by the generated code.
+	Config         string        // Project configuration file listing the mocks to generate.
+	MockInterfaces string        // Comma-separated list of interface names to mock.
+	ImportAliases  importAliases // Rules assigning aliases to import paths.
+	ForDeps        string        // Comma-separated list of structs whose dependencies to mock.
+	GRPCServices   bool          // Only mock the gRPC service interfaces.
+	NoCache        bool          // Disable the cache of scanned files.
+	AllowEmpty     bool          // Generate a build-ignored file when no interface matches.
+	SkipBroken     bool          // Skip the files with syntax errors.
+	SetupFrom      string        // Transcript to generate mock setup code from.
+	Registry       string        // Registry file of the mocks generated across packages.
+	Instantiate    string        // Comma-separated instantiations of generic interfaces.
+	Subsets        subsetSpecs   // Narrow interfaces extracted from scanned interfaces.
+	Funcs          string        // Comma-separated list of functions to mock through their context.
+	MockSuffix     string        // Suffix naming the mock types after their interfaces.
 }
 
 func init() {
-	flag.StringVar(&flags.OutputFile, "o", "", "Path to the output Go file. Defaults to stdout if not specified.")
-	flag.StringVar(&flags.OutputFile, "output", "", "Alias for -o. Specifies the output file path for generated mocks.")
-	flag.StringVar(&flags.MockInterfaces, "i", "", "Comma-separated list of interface names to mock (e.g., 'Reader,Writer'). Prefix with '!' to exclude specific interfaces (e.g., '!Logger'). Defaults to mocking all interfaces.")
-	flag.StringVar(&flags.MockInterfaces, "interfaces", "", "Alias for -i. Specifies interfaces to include or exclude for mocking. Use '!' prefix for exclusions.")
+	addGenerationFlags(flag.CommandLine)
+}
+
+// addGenerationFlags defines the flags of the generation in fs, such as
+// those the verify subcommand takes along with its own.
+func addGenerationFlags(fs *flag.FlagSet) {
+	fs.StringVar(&flags.OutputFile, "o", "", "Path to the output Go file. Defaults to stdout if not specified.")
+	fs.StringVar(&flags.OutputFile, "output", "", "Alias for -o. Specifies the output file path for generated mocks.")
+	fs.StringVar(&flags.DestDir, "dest-dir", "", "Directory of another package receiving the generated mocks (e.g. '../mocks'), created if needed. The output file is relative to it, and the scanned package is imported by its path resolved from the go.mod files, honoring replace directives and nested modules.")
+	fs.StringVar(&flags.Pkg, "pkg", "", "Import path of another package whose interfaces to mock (e.g. 'io' or 'net/http'), such as those of the standard library or of other modules, instead of the interfaces of the current package. The mocks are generated into the current package, or the one given by --dest-dir.")
+	fs.StringVar(&flags.Source, "source", "", "Go file of the current package whose interfaces to mock (e.g. 'service.go'), instead of scanning all of its files.")
+	fs.StringVar(&flags.GoVersion, "go-version", "", "Go language version targeted by the generated code (e.g. '1.21'), so that it only uses the language features that version supports, at least 1.21, the oldest one a file can select with a '//go:build go1.N' constraint. It doesn't lower the Go version required by the gsmock runtime, 1.26. Defaults to all features.")
+	fs.StringVar(&flags.Config, "config", "", "Project configuration file (e.g. '"+defaultConfigFile+"' at the root of the repository) listing the packages whose mocks to generate, with their output files, interface filters and options, instead of go:generate lines. Directories are relative to the file.")
+	fs.StringVar(&flags.MockInterfaces, "i", "", "Comma-separated list of interface names to mock (e.g., 'Reader,Writer'). Prefix with '!' to exclude specific interfaces (e.g., '!Logger'). Defaults to mocking all interfaces.")
+	fs.StringVar(&flags.MockInterfaces, "interfaces", "", "Alias for -i. Specifies interfaces to include or exclude for mocking. Use '!' prefix for exclusions.")
+	fs.StringVar(&flags.ForDeps, "for-deps", "", "Comma-separated list of struct names (e.g., 'Server' or 'app.Server'). Mocks the interface types of their fields, including interfaces declared in other packages, instead of the interfaces of the current package.")
+	fs.BoolVar(&flags.GRPCServices, "grpc-services", false, "Only mock the client, server and stream interfaces generated by protoc-gen-go-grpc. Unmatched calls of clients return an Unimplemented status, and those of servers are handled by the embedded UnimplementedXxxServer.")
+	fs.BoolVar(&flags.AllowEmpty, "allow-empty", false, "Generate an empty file excluded by a 'go:build ignore' constraint instead of failing when no interface matches the filters.")
+	fs.BoolVar(&flags.SkipBroken, "skip-broken", false, "Report the syntax errors of broken files as warnings and skip them, instead of failing, e.g. during refactors leaving some files temporarily broken.")
+	fs.StringVar(&flags.SetupFrom, "setup-from", "", "Transcript written by gsmock.Manager.WriteTranscript. Generates a function registering the mocks that reproduce the recorded calls, instead of generating mocks.")
+	fs.StringVar(&flags.Registry, "registry", "", "Registry file shared by the packages of a repository (e.g. '../mocks.json'). Records the package of each generated mock, and aliases the mocks already generated in other packages instead of duplicating them.")
+	fs.StringVar(&flags.Instantiate, "instantiate", "", "Comma-separated instantiations of generic interfaces (e.g. 'Repository[User],Repository[Order]'). Generates named aliases of their mocks (e.g. UserRepositoryMock) with non-generic constructors.")
+	fs.StringVar(&flags.Funcs, "funcs", "", "Comma-separated list of package-level functions taking a context.Context first (e.g. 'Fetch,Publish'). Generates MockXxx(r) helpers returning their FuncNN mockers, dispatched through the Manager bound to the context by gsmock.WithManager, alongside the interface mocks.")
+	fs.StringVar(&flags.MockSuffix, "mock-suffix", defaultMockSuffix, "Suffix naming the mock types after their interfaces. A 'Mock' ending an interface name isn't repeated (e.g. FooMock gets FooMockImpl), and names already taken in the package get a numeric suffix (e.g. FooMockImpl2).")
+	fs.BoolVar(&flags.NoCache, "no-cache", false, "Disable the cache of scanned files kept in the "+defaultCacheDir+" directory.")
+	fs.Var(&flags.Subsets, "subset", "Narrow interface 'Source=Method1,Method2:Name' to generate, made of the listed methods of the Source interface, along with its mock (e.g. 'Service=Process,Convert:LeanService'). May be repeated.")
+	fs.Var(&flags.ImportAliases, "import-alias", "Rule 'pattern=alias' assigning an alias to import paths matching the regular expression pattern; the alias may reference submatches (e.g. '^(.*/)?(\\w+)/v(\\d+)$=${2}v${3}'). May be repeated.")
 }
 
 func main() {
@@ -63,25 +107,127 @@ func main() {
 		fmt.Println(ToolVersion)
 		return
 	}
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "find":
+			findMain(os.Args[2:])
+			return
+		case "graph":
+			graphMain(os.Args[2:])
+			return
+		case "prune":
+			pruneMain(os.Args[2:])
+			return
+		case "serve":
+			serveMain(os.Args[2:])
+			return
+		case "scaffold":
+			scaffoldMain(os.Args[2:])
+			return
+		case "verify":
+			verifyMain(os.Args[2:])
+			return
+		}
+	}
 	flag.Parse()
-	run(runConfig{
+	if len(flags.Config) > 0 {
+		runProject(flags.Config)
+		return
+	}
+	run(flagsConfig())
+}
+
+// flagsConfig returns the generator configuration given by the flags.
+func flagsConfig() runConfig {
+	cacheDir := defaultCacheDir
+	if flags.NoCache {
+		cacheDir = ""
+	}
+	return runConfig{
 		SourceDir:      ".",
 		OutputFile:     flags.OutputFile,
+		DestDir:        flags.DestDir,
+		Pkg:            flags.Pkg,
+		Source:         flags.Source,
+		GoVersion:      flags.GoVersion,
 		MockInterfaces: flags.MockInterfaces,
-	})
+		ImportAliases:  flags.ImportAliases,
+		ForDeps:        flags.ForDeps,
+		GRPCServices:   flags.GRPCServices,
+		CacheDir:       cacheDir,
+		AllowEmpty:     flags.AllowEmpty,
+		SkipBroken:     flags.SkipBroken,
+		SetupFrom:      flags.SetupFrom,
+		Registry:       flags.Registry,
+		Instantiate:    flags.Instantiate,
+		Subsets:        flags.Subsets,
+		Funcs:          flags.Funcs,
+		MockSuffix:     flags.MockSuffix,
+	}
 }
 
 // runConfig holds configuration parameters for the generator.
 type runConfig struct {
-	SourceDir      string // Directory containing source Go files to scan.
-	OutputFile     string // Path to output Go file for generated mocks.
-	MockInterfaces string // Comma-separated interface filter string.
+	SourceDir      string   // Directory containing source Go files to scan.
+	OutputFile     string   // Path to output Go file for generated mocks.
+	DestDir        string   // Directory of the package receiving the mocks, if not SourceDir.
+	Pkg            string   // Import path of the package whose interfaces to mock, if not SourceDir's.
+	Source         string   // File of SourceDir scanned alone, if not all of its files.
+	GoVersion      string   // Go version targeted by the generated code, the latest if empty.
+	MockInterfaces string   // Comma-separated interface filter string.
+	ImportAliases  []string // Rules assigning aliases to import paths.
+	ForDeps        string   // Comma-separated list of structs whose dependencies to mock.
+	GRPCServices   bool     // Only mock the gRPC service interfaces.
+	CacheDir       string   // Directory caching scanned files, disabled if empty.
+	AllowEmpty     bool     // Generate a build-ignored file when no interface matches.
+	SkipBroken     bool     // Skip the files with syntax errors.
+	SetupFrom      string   // Transcript to generate mock setup code from.
+	Registry       string   // Registry file of the mocks generated across packages.
+	Instantiate    string   // Comma-separated instantiations of generic interfaces.
+	Subsets        []string // Narrow interfaces extracted from scanned interfaces.
+	Funcs          string   // Comma-separated functions mocked through their context.
+	MockSuffix     string   // Suffix naming the mock types, defaultMockSuffix if empty.
 }
 
 // run executes the main logic of scanning interfaces and generating mocks.
 func run(param runConfig) {
+	dir := param.SourceDir
+	if len(param.DestDir) > 0 {
+		dir = param.DestDir
+	}
+	writeFile(dir, param.OutputFile, generate(param))
+}
+
+// generate scans the interfaces and returns the formatted code of their mocks.
+func generate(param runConfig) []byte {
+	b, _ := generateInterfaces(param)
+	return b
+}
+
+// generateInterfaces is like generate, but also returns the interfaces
+// whose mocks are generated, none for mock setup code.
+func generateInterfaces(param runConfig) ([]byte, []Interface) {
+	if len(param.SetupFrom) > 0 {
+		s := bytes.NewBuffer(nil)
+		toolCommand := "--setup-from " + param.SetupFrom
+		if len(param.OutputFile) > 0 {
+			toolCommand = "-o " + param.OutputFile + " " + toolCommand
+		}
+		if s := param.MockSuffix; len(s) > 0 && s != defaultMockSuffix {
+			toolCommand += " --mock-suffix " + s
+		}
+		generateSetup(s, param, toolCommand)
+		return formatSource(s.Bytes()), nil
+	}
+
 	ctx := scanContext{
 		OutputFile:        param.OutputFile,
+		GRPCServices:      param.GRPCServices,
+		CacheDir:          param.CacheDir,
+		SkipBroken:        param.SkipBroken,
+		Source:            param.Source,
+		FuncVars:          true,
+		Funcs:             parseFuncs(param.Funcs),
 		IncludeInterfaces: make(map[string]struct{}),
 		ExcludeInterfaces: make(map[string]struct{}),
 	}
@@ -94,28 +240,85 @@ func run(param runConfig) {
 		ctx.parse(param.MockInterfaces)
 	}
 
-	// Map of import path => package name to detect conflicts
-	pkgMap := make(map[string]string)
-	interfaces := scanDir(param.SourceDir, ctx, pkgMap)
+	rules := parseAliasRules(param.ImportAliases)
+	goVer := parseGoVersion(param.GoVersion)
 
-	// Collect necessary imports for generated mocks
-	imports := make(map[string]string)
-	imports["gsmock"] = "github.com/go-spring/gs-mock/gsmock"
-	for _, m := range interfaces {
-		maps.Copy(imports, m.Imports)
+	var subsets []subsetSpec
+	for _, s := range param.Subsets {
+		subsets = append(subsets, parseSubsetSpec(s))
+	}
+	scanCtx := ctx
+	if len(subsets) > 0 {
+		scanCtx = ctx.widen(subsets)
 	}
 
-	s := bytes.NewBuffer(nil)
+	if len(param.Pkg) > 0 && len(param.ForDeps) > 0 {
+		panic("-pkg and --for-deps cannot be used together")
+	}
+	if len(param.Source) > 0 && (len(param.Pkg) > 0 || len(param.ForDeps) > 0) {
+		panic("-source cannot be used with -pkg or --for-deps")
+	}
 
-	// Generate import statements
-	h := bytes.NewBuffer(nil)
-	for pkgName, pkgPath := range imports {
-		ss := strings.Split(pkgPath, "/")
-		if pkgName == ss[len(ss)-1] {
-			_, _ = fmt.Fprintf(h, "\t\"%s\"\n", pkgPath)
-		} else {
-			_, _ = fmt.Fprintf(h, "\t%s \"%s\"\n", pkgName, pkgPath)
+	var interfaces []Interface
+	if len(param.Pkg) > 0 {
+		destDir := param.SourceDir
+		if len(param.DestDir) > 0 {
+			destDir = param.DestDir
+		}
+		interfaces = scanPkg(param.Pkg, destDir, scanCtx)
+	} else if s := strings.Trim(param.ForDeps, `'"`); len(s) > 0 {
+		var structNames []string
+		for name := range strings.SplitSeq(s, ",") {
+			if name = strings.TrimSpace(name); len(name) > 0 {
+				structNames = append(structNames, name)
+			}
 		}
+		interfaces = scanDeps(param.SourceDir, scanCtx, structNames)
+	} else if len(param.DestDir) > 0 {
+		interfaces = scanDest(param.SourceDir, param.DestDir, scanCtx)
+	} else {
+		interfaces = scanDir(param.SourceDir, scanCtx)
+	}
+
+	if len(ctx.Funcs) > 0 {
+		warnUndeclaredFuncs(param.SourceDir, param.OutputFile, ctx.Funcs)
+	}
+
+	if len(subsets) > 0 {
+		interfaces = applySubsets(interfaces, subsets, ctx)
+	}
+
+	resolveDelegates(interfaces, param.SourceDir)
+
+	if s := strings.Trim(param.Instantiate, `'"`); len(s) > 0 {
+		applyInstances(interfaces, s)
+	}
+
+	destDir := param.SourceDir
+	if len(param.DestDir) > 0 {
+		destDir = param.DestDir
+	}
+	assignMockTypes(interfaces, param.MockSuffix, declaredTypes(destDir, param.OutputFile))
+
+	if len(param.Registry) > 0 && len(interfaces) > 0 {
+		registry := loadRegistry(param.Registry)
+		localPath := importPathOf(param.SourceDir)
+		if len(param.DestDir) > 0 {
+			localPath = importPathOf(param.DestDir)
+		}
+		for k := range interfaces {
+			if interfaces[k].FuncVar != "" || interfaces[k].Func != "" || interfaces[k].FuncType {
+				continue // not shared across packages
+			}
+			if interfaces[k].TypeParams != "" && !goVer.genericAliases() {
+				continue // can't be aliased, so generated here
+			}
+			if interfaces[k].PkgPath == "" {
+				interfaces[k].PkgPath = localPath
+			}
+			registry.apply(&interfaces[k], localPath)
+		}
+		registry.save()
 	}
 
 	// Build the command string for documentation
@@ -123,9 +326,117 @@ func run(param runConfig) {
 	if len(param.OutputFile) > 0 {
 		toolCommand += "-o " + param.OutputFile
 	}
+	if len(param.DestDir) > 0 {
+		toolCommand += " --dest-dir " + param.DestDir
+	}
+	if len(param.Pkg) > 0 {
+		toolCommand += " -pkg " + param.Pkg
+	}
+	if len(param.Source) > 0 {
+		toolCommand += " -source " + param.Source
+	}
+	if len(param.GoVersion) > 0 {
+		toolCommand += " --go-version " + param.GoVersion
+	}
 	if len(param.MockInterfaces) > 0 {
 		toolCommand += " -i '" + param.MockInterfaces + "'"
 	}
+	if param.GRPCServices {
+		toolCommand += " --grpc-services"
+	}
+	if param.AllowEmpty {
+		toolCommand += " --allow-empty"
+	}
+	if param.SkipBroken {
+		toolCommand += " --skip-broken"
+	}
+	if len(param.Registry) > 0 {
+		toolCommand += " --registry " + param.Registry
+	}
+	if s := strings.Trim(param.Instantiate, `'"`); len(s) > 0 {
+		toolCommand += " --instantiate '" + s + "'"
+	}
+	if len(param.ForDeps) > 0 {
+		toolCommand += " --for-deps '" + strings.Trim(param.ForDeps, `'"`) + "'"
+	}
+	if s := param.MockSuffix; len(s) > 0 && s != defaultMockSuffix {
+		toolCommand += " --mock-suffix " + s
+	}
+	if len(ctx.Funcs) > 0 {
+		toolCommand += " --funcs '" + strings.Join(slices.Sorted(maps.Keys(ctx.Funcs)), ",") + "'"
+	}
+	for _, s := range param.Subsets {
+		toolCommand += " --subset '" + strings.Trim(s, `'"`) + "'"
+	}
+	for _, s := range param.ImportAliases {
+		toolCommand += " --import-alias '" + s + "'"
+	}
+
+	s := bytes.NewBuffer(nil)
+	if len(interfaces) > 0 {
+		generateMocks(s, interfaces, rules, toolCommand)
+	} else if param.AllowEmpty {
+		pkgName := packageName(param.SourceDir, param.OutputFile)
+		if len(param.DestDir) > 0 {
+			pkgName = destPackageName(param.DestDir, param.OutputFile)
+		}
+		// Keep the output file, but exclude it from builds
+		if err := tmplEmptyFile.Execute(s, map[string]any{
+			"ToolVersion": ToolVersion,
+			"ToolCommand": toolCommand,
+			"Package":     pkgName,
+		}); err != nil {
+			panic(fmt.Errorf("error executing template(empty): %w", err))
+		}
+	} else {
+		panic(fmt.Sprintf("no interfaces matched filter in %s", param.SourceDir))
+	}
+
+	return formatSource(s.Bytes()), interfaces
+}
+
+// formatSource formats the generated source code.
+func formatSource(src []byte) []byte {
+	b, err := format.Source(src)
+	if err != nil {
+		panic(fmt.Errorf("error formatting source code: %w", err))
+	}
+	return b
+}
+
+// writeFile writes b to the output file in dir, or to stdout if there is none.
+func writeFile(dir string, outputFile string, b []byte) {
+	switch outputFile {
+	case "":
+		if _, err := stdOut.Write(b); err != nil {
+			panic(fmt.Errorf("error writing to stdout: %w", err))
+		}
+	default:
+		outputFile = filepath.Join(dir, outputFile)
+		if err := os.MkdirAll(filepath.Dir(outputFile), os.ModePerm); err != nil {
+			panic(fmt.Errorf("error creating directory(%s): %w", filepath.Dir(outputFile), err))
+		}
+		if err := os.WriteFile(outputFile, b, os.ModePerm); err != nil {
+			panic(fmt.Errorf("error writing to file(%s): %w", outputFile, err))
+		}
+	}
+}
+
+// generateMocks writes the mocks of the interfaces to s.
+func generateMocks(s *bytes.Buffer, interfaces []Interface, rules []aliasRule, toolCommand string) {
+	// Collect necessary imports for generated mocks, resolving conflicts
+	imports := resolveImports(interfaces, rules)
+
+	// Generate import statements
+	h := bytes.NewBuffer(nil)
+	for pkgName, pkgPath := range imports {
+		ss := strings.Split(pkgPath, "/")
+		if pkgName == ss[len(ss)-1] {
+			_, _ = fmt.Fprintf(h, "\t\"%s\"\n", pkgPath)
+		} else {
+			_, _ = fmt.Fprintf(h, "\t%s \"%s\"\n", pkgName, pkgPath)
+		}
+	}
 
 	packageName := interfaces[0].Package
 
@@ -141,35 +452,46 @@ func run(param runConfig) {
 
 	// Generate code for each interface and its methods
 	for _, i := range interfaces {
-		if err := tmplInterface.Execute(s, i); err != nil {
-			panic(fmt.Errorf("error executing template(interface#%s): %w", i.Name, err))
-		}
-		for _, m := range i.Methods {
-			if err := tmplMethod.Execute(s, map[string]any{
+		if i.MockPackage != "" {
+			if err := tmplAlias.Execute(s, i); err != nil {
+				panic(fmt.Errorf("error executing template(alias#%s): %w", i.Name, err))
+			}
+		} else if i.FuncVar != "" {
+			if err := tmplFuncVar.Execute(s, map[string]any{
 				"i": i,
-				"m": m,
+				"m": i.Methods[0],
 			}); err != nil {
-				panic(fmt.Errorf("error executing template(method#%s): %w", m.Name, err))
+				panic(fmt.Errorf("error executing template(funcvar#%s): %w", i.Name, err))
+			}
+		} else if i.FuncType {
+			if err := tmplFuncType.Execute(s, map[string]any{
+				"i": i,
+				"m": i.Methods[0],
+			}); err != nil {
+				panic(fmt.Errorf("error executing template(functype#%s): %w", i.Name, err))
+			}
+		} else if i.Func != "" {
+			if err := tmplFunc.Execute(s, map[string]any{
+				"i": i,
+				"m": i.Methods[0],
+			}); err != nil {
+				panic(fmt.Errorf("error executing template(func#%s): %w", i.Name, err))
+			}
+		} else {
+			if err := tmplInterface.Execute(s, i); err != nil {
+				panic(fmt.Errorf("error executing template(interface#%s): %w", i.Name, err))
+			}
+			for _, m := range i.Methods {
+				if err := tmplMethod.Execute(s, map[string]any{
+					"i": i,
+					"m": m,
+				}); err != nil {
+					panic(fmt.Errorf("error executing template(method#%s): %w", m.Name, err))
+				}
 			}
 		}
-	}
-
-	// Format the generated source code
-	b, err := format.Source(s.Bytes())
-	if err != nil {
-		panic(fmt.Errorf("error formatting source code: %w", err))
-	}
-
-	// Output generated code to file or stdout
-	switch param.OutputFile {
-	case "":
-		if _, err = stdOut.Write(b); err != nil {
-			panic(fmt.Errorf("error writing to stdout: %w", err))
-		}
-	default:
-		outputFile := filepath.Join(param.SourceDir, param.OutputFile)
-		if err = os.WriteFile(outputFile, b, os.ModePerm); err != nil {
-			panic(fmt.Errorf("error writing to file(%s): %w", outputFile, err))
+		if err := tmplInstances.Execute(s, i); err != nil {
+			panic(fmt.Errorf("error executing template(instances#%s): %w", i.Name, err))
 		}
 	}
 }
@@ -179,6 +501,14 @@ type scanContext struct {
 	OutputFile        string
 	IncludeInterfaces map[string]struct{}
 	ExcludeInterfaces map[string]struct{}
+	GRPCServices      bool                // Only mock the gRPC service interfaces
+	CacheDir          string              // Directory caching scanned files, disabled if empty
+	SkipBroken        bool                // Skip the files with syntax errors instead of failing
+	Source            string              // File of the directory scanned alone, if not all of its files
+	FuncVars          bool                // Also mock the function variables annotated with funcVarDirective
+	Funcs             map[string]struct{} // Functions mocked through their context, see funcSpecs
+	Qualifier         string              // Name qualifying the types of another package, if scanned
+	QualifierPath     string              // Import path of the package named by Qualifier
 }
 
 // parse converts the comma-separated interface filter string into inclusion/exclusion maps.
@@ -212,92 +542,176 @@ func (ctx *scanContext) mock(name string) bool {
 type Interface struct {
 	Package         string            // Package name where the interface resides
 	Name            string            // Interface name
+	MockType        string            // Name of the generated mock type, see assignMockTypes
+	Constructor     string            // Name of the generated constructor
+	ApplyStubs      string            // Name of the generated method applying stubs
+	SelfType        string            // Interface type as referenced by the generated code
 	TypeParams      string            // Generic type parameters (e.g., "T any")
 	TypeParamNames  string            // Generic type names only (e.g., "T")
 	EmbedInterfaces string            // Embedded interfaces as string
+	Delegates       []Delegate        // Embedded interfaces of other packages delegated to fields
 	Methods         []Method          // Methods in the interface
 	File            string            // Source file path
 	Imports         map[string]string // Required imports for this interface
+	PkgPath         string            // Import path of the package declaring the interface, if needed
+	MockPackage     string            // Qualifier of the package holding the registered mock, if aliased
+	Instances       []Instance        // Common instantiations of the generic interface
+	SubsetOf        string            // Interface the methods are extracted from, if declared as a subset
+	Stamp           string            // Hash of the interface signature, see interfaceStamp
+	FuncVar         string            // Type substituting the function variable declared as the single method, if any
+	Func            string            // Function declared as the single method, if mocked through its context
+	FuncType        bool              // Whether the single method is the function type named like the interface
 }
 
 // Method describes a single method within an interface.
 type Method struct {
-	Name            string // Method name
-	VariadicFlag    string // "Var" if the method has variadic parameters
-	Params          string // Method parameters as string (e.g., "a int, b string")
-	ParamNames      string // Comma-separated parameter names only
-	ParamCount      int    // Number of parameters
-	ResultTypes     string // Return types as a string (e.g., "(int, error)")
-	ResultTmplTypes string // Return types for template generation (e.g., "[int, error]")
-	ResultCount     int    // Number of return values
-	MockerTmplTypes string // Full template type parameters for the mocker
+	Name            string       // Method name
+	MockName        string       // Name of the generated Mock method
+	ExpectNoName    string       // Name of the generated ExpectNo method
+	VariadicFlag    string       // "Var" if the method has variadic parameters
+	Params          string       // Method parameters as string (e.g., "a int, b string")
+	VarParams       string       // Parameters with the variadic one as a slice, if variadic (e.g., "a int, b []string")
+	ParamNames      string       // Comma-separated parameter names only
+	ParamCount      int          // Number of parameters
+	ResultTypes     string       // Return types as a string (e.g., "(int, error)")
+	Results         string       // Results with their names, if named (e.g., "(n int, err error)")
+	ResultTmplTypes string       // Return types for template generation (e.g., "[int, error]")
+	ResultCount     int          // Number of return values
+	MockerTmplTypes string       // Full template type parameters for the mocker
+	Tuple           bool         // Whether the method exceeds the numbered mockers and uses gsmock.MockerT
+	ArgFields       []tupleField // Fields of the tuple struct of the parameters, if Tuple
+	ResultFields    []tupleField // Fields of the tuple struct of the results, if Tuple
+	Fallback        string       // Statement executed when no mock matches, panics if empty
+	ReturnsName     string       // Name of the generated literal Return helper, if any
+	ReturnsParams   string       // Parameters of the literal Return helper
+	ReturnsValue    string       // Arguments passed to ReturnValue by the literal Return helper
+	ReturnsDesc     string       // Description of the values returned by the literal Return helper
+	ReturnSelfName  string       // Name of the generated helper returning the mock itself, if any
+	PagesName       string       // Name of the generated helper serving pages, if any
+	PagesTypes      string       // Type arguments of the gsmock.Pager used by the helper
+	PagesElem       string       // Element type of the pages
+	PagesCursor     string       // Name of the cursor parameter
+	StubName        string       // Name of the generated helper stubbing requests, if any
+	StubTypes       string       // Type arguments of the gsmock.Stub returned by the helper
+	StubReq         string       // Request type of the stubbing helper
+}
+
+// packageName returns the name of the package in dir.
+func packageName(dir string, outputFile string) string {
+	for _, file := range goFiles(dir, outputFile) {
+		node, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.PackageClauseOnly)
+		if err != nil {
+			panic(fmt.Errorf("error parsing file(%s): %w", file, err))
+		}
+		return node.Name.Name
+	}
+	panic(fmt.Sprintf("no Go files in %s", dir))
 }
 
 // scanDir scans the given directory for Go files and returns all interfaces to be mocked.
-func scanDir(dir string, ctx scanContext, pkgs map[string]string) []Interface {
-	entries, err := os.ReadDir(dir)
-	if err != nil {
-		panic(fmt.Errorf("error reading directory: %w", err))
+// Only the file ctx.Source of the directory is scanned if it is set.
+func scanDir(dir string, ctx scanContext) []Interface {
+	files := goFiles(dir, ctx.OutputFile)
+	if ctx.Source != "" {
+		files = []string{sourceFile(dir, ctx.Source)}
 	}
 	var ret []Interface
-	for _, entry := range entries {
-		if entry.IsDir() || filepath.Ext(entry.Name()) != ".go" {
-			continue
-		}
-		if strings.HasSuffix(entry.Name(), "_test.go") {
-			continue
-		}
-		if entry.Name() == ctx.OutputFile {
-			continue
-		}
-		arr := scanFile(ctx, filepath.Join(dir, entry.Name()), pkgs)
+	for _, file := range files {
+		arr := scanFileCached(ctx, file)
 		ret = append(ret, arr...)
 	}
 	return ret
 }
 
-// scanFile parses a Go source file and extracts all mockable interfaces.
-func scanFile(ctx scanContext, file string, pkgs map[string]string) []Interface {
-	mode := parser.AllErrors
+// sourceFile returns the path of the file source of dir, which must be
+// one of its Go files, as the mocks are generated into its package.
+func sourceFile(dir string, source string) string {
+	file := filepath.Join(dir, source)
+	if filepath.Dir(file) != filepath.Clean(dir) || filepath.Ext(file) != ".go" {
+		panic(fmt.Sprintf("source %s is not a Go file of %s", source, dir))
+	}
+	if _, err := os.Stat(file); err != nil {
+		panic(fmt.Errorf("error reading file(%s): %w", file, err))
+	}
+	return file
+}
+
+// parseFile parses a Go source file. A file with syntax errors panics,
+// unless broken files are skipped: the errors are then reported as a
+// warning, and parseFile returns nil.
+func (ctx *scanContext) parseFile(file string, mode parser.Mode) *ast.File {
 	node, err := parser.ParseFile(token.NewFileSet(), file, nil, mode)
-	if err != nil {
+	if err == nil {
+		return node
+	}
+	if !ctx.SkipBroken {
 		panic(fmt.Errorf("error parsing file(%s): %w", file, err))
 	}
+	_, _ = fmt.Fprintf(stdErr, "gs-mock: warning: skipping broken file %s: %v\n", file, err)
+	return nil
+}
+
+// scanFile parses a Go source file and extracts all mockable interfaces.
+// Their methods and type texts are read from the syntax tree, so that
+// files are scanned, and cached, on their own, broken packages included;
+// only the embedded interfaces of other packages are type-checked, see
+// resolveDelegates.
+func scanFile(ctx scanContext, file string) []Interface {
+	node := ctx.parseFile(file, parser.AllErrors|parser.ParseComments)
+	if node == nil {
+		return nil
+	}
 
 	needImports := make(map[string]string) // Imports needed for this file
-	totalImports := make(map[string]string)
+	totalImports := importNames(node)      // Collect package imports
+	if ctx.Qualifier != "" {
+		totalImports[ctx.Qualifier] = ctx.QualifierPath
+	}
 
-	// Collect package imports
-	for _, spec := range node.Imports {
-		pkgPath := strings.Trim(spec.Path.Value, "\"")
+	putImport := func(pkgNames []string) {
+		for _, pkgName := range pkgNames {
+			if pkgPath, ok := totalImports[pkgName]; ok {
+				needImports[pkgName] = pkgPath
+			}
+		}
+	}
 
-		var pkgName string
-		if spec.Name != nil {
-			pkgName = spec.Name.Name
-		} else {
-			ss := strings.Split(pkgPath, "/")
-			pkgName = ss[len(ss)-1]
+	// Function variables are scanned as interfaces declaring them as methods
+	decls := node.Decls
+	funcVars := make(map[*ast.TypeSpec]bool)
+	if ctx.FuncVars && ctx.Qualifier == "" {
+		var specs []ast.Spec
+		for _, s := range funcVarSpecs(node, file, ctx.OutputFile, totalImports) {
+			specs = append(specs, s)
+			funcVars[s] = true
 		}
+		decls = append(slices.Clip(decls), &ast.GenDecl{Tok: token.TYPE, Specs: specs})
+	}
 
-		// Detect import conflicts
-		if v, ok := pkgs[pkgPath]; ok && v != pkgName {
-			panic(fmt.Sprintf("import package name conflict: %s, %s", v, pkgName))
+	// So are the functions mocked through their context
+	funcs := make(map[*ast.TypeSpec]bool)
+	if len(ctx.Funcs) > 0 {
+		var specs []ast.Spec
+		for _, s := range funcSpecs(node, ctx.Funcs, totalImports) {
+			specs = append(specs, s)
+			funcs[s] = true
 		}
-		pkgs[pkgPath] = pkgName
-		totalImports[pkgName] = pkgPath
+		decls = append(slices.Clip(decls), &ast.GenDecl{Tok: token.TYPE, Specs: specs})
 	}
 
-	putImport := func(pkgNames []string) {
-		for _, s := range pkgNames {
-			pkgName := s[:len(s)-1] // Remove trailing dot
-			if pkgPath, ok := totalImports[pkgName]; ok {
-				needImports[pkgName] = pkgPath
-			}
+	// And the function types named by the interface filter
+	funcTypes := make(map[*ast.TypeSpec]bool)
+	if len(ctx.IncludeInterfaces) > 0 {
+		var specs []ast.Spec
+		for _, s := range funcTypeSpecs(node, ctx.IncludeInterfaces) {
+			specs = append(specs, s)
+			funcTypes[s] = true
 		}
+		decls = append(slices.Clip(decls), &ast.GenDecl{Tok: token.TYPE, Specs: specs})
 	}
 
 	var ret []Interface
-	for _, decl := range node.Decls {
+	for _, decl := range decls {
 		d, ok := decl.(*ast.GenDecl)
 		if !ok || d.Tok != token.TYPE {
 			continue
@@ -311,9 +725,23 @@ func scanFile(ctx scanContext, file string, pkgs map[string]string) []Interface
 			}
 
 			name := s.Name.String()
-			if !ctx.mock(name) {
+			if !funcs[s] && !ctx.mock(name) {
 				continue
 			}
+			if ctx.Qualifier != "" && !ast.IsExported(name) {
+				continue // not accessible from another package
+			}
+			stamp := interfaceStamp(s) // before qualification, which changes type texts
+			if ctx.Qualifier != "" {
+				qualifyTypes(s, ctx.Qualifier)
+			}
+
+			kind := grpcNone
+			if ctx.GRPCServices {
+				if kind = grpcKindOf(name, t, totalImports); kind == grpcNone {
+					continue
+				}
+			}
 
 			// Collect type parameters
 			var (
@@ -322,18 +750,69 @@ func scanFile(ctx scanContext, file string, pkgs map[string]string) []Interface
 			)
 			if s.TypeParams != nil {
 				for _, f := range s.TypeParams.List {
-					fName := f.Names[0].Name
+					// The constraint is kept verbatim, and the packages
+					// it refers to are imported like those of methods.
 					typeText, pkgNames := getTypeText(f.Type)
-					typeParamArray = append(typeParamArray, fName+" "+typeText)
-					typeParamNameArray = append(typeParamNameArray, fName)
+					for _, n := range f.Names {
+						typeParamArray = append(typeParamArray, n.Name+" "+typeText)
+						typeParamNameArray = append(typeParamNameArray, n.Name)
+					}
 					putImport(pkgNames)
 				}
 			}
 
+			// Identifiers that parameter names in the generated methods must
+			// not shadow: the names used by the generated method bodies,
+			// the interface's type parameters and the imported packages.
+			reservedNames := make(map[string]struct{})
+			for _, n := range generatedNames {
+				reservedNames[n] = struct{}{}
+			}
+			for _, n := range typeParamNameArray {
+				reservedNames[n] = struct{}{}
+			}
+			for n := range totalImports {
+				reservedNames[n] = struct{}{}
+			}
+
+			// Imports used by the fallbacks of unmatched gRPC calls
+			for n, pkgPath := range grpcImports(kind) {
+				needImports[n] = pkgPath
+				reservedNames[n] = struct{}{}
+			}
+
+			// Methods that can't or shouldn't be mocked are skipped
+			skipped := make(map[string]string)
+			for _, method := range t.Methods.List {
+				if len(method.Names) == 0 {
+					continue
+				}
+				if reason := skipReason(method, totalImports); reason != "" {
+					methodName := method.Names[0].Name
+					skipped[methodName] = reason
+					_, _ = fmt.Fprintf(stdErr, "gs-mock: warning: %s.%s is not mocked: %s\n", name, methodName, reason)
+				}
+			}
+
 			// Collect embedded interfaces
 			var embedInterfaces strings.Builder
+			if kind == grpcServer {
+				// Satisfies mustEmbedUnimplementedXxx and handles unmatched calls
+				embedInterfaces.WriteString("\tUnimplemented" + name + "\n")
+			}
+			selfType := name + typeParamNamesOf(typeParamNameArray)
+			if ctx.Qualifier != "" {
+				selfType = ctx.Qualifier + "." + selfType
+				putImport([]string{ctx.Qualifier}) // referenced by the constructor
+			}
+			if len(skipped) > 0 {
+				// The mock embeds the interface itself, so that it still implements
+				// it: the skipped methods are promoted from the nil interface value,
+				// and panic when called. The embedded interfaces are promoted too.
+				embedInterfaces.WriteString("\t" + selfType + "\n")
+			}
 			for _, method := range t.Methods.List {
-				if len(method.Names) == 0 {
+				if len(method.Names) == 0 && len(skipped) == 0 {
 					embedInterfaces.WriteString("\t")
 					typeText, pkgNames := getTypeText(method.Type)
 					embedInterfaces.WriteString(typeText)
@@ -350,21 +829,28 @@ func scanFile(ctx scanContext, file string, pkgs map[string]string) []Interface
 				}
 				ft := method.Type.(*ast.FuncType)
 				methodName := method.Names[0].Name
+				if kind == grpcServer && methodName == "mustEmbedUnimplemented"+name {
+					continue
+				}
+				if _, ok := skipped[methodName]; ok {
+					continue
+				}
 
 				paramCount := 0
 				resultCount := 0
 
 				var (
-					varText    string
-					params     []string
-					paramNames []string
-					paramTypes []string
+					varText        string
+					params         []string
+					paramNames     []string
+					paramTypes     []string
+					paramTypeTexts []string
 				)
 				if ft.Params != nil {
 					for _, param := range ft.Params.List {
 						var tempNames []string
 						if len(param.Names) == 0 {
-							tempNames = append(tempNames, "r"+strconv.Itoa(paramCount))
+							tempNames = append(tempNames, "")
 						} else {
 							for _, r := range param.Names {
 								tempNames = append(tempNames, r.Name)
@@ -380,18 +866,31 @@ func scanFile(ctx scanContext, file string, pkgs map[string]string) []Interface
 								paramTypes = append(paramTypes, typeText)
 							}
 							paramNames = append(paramNames, paramName)
-							params = append(params, paramName+" "+typeText)
+							paramTypeTexts = append(paramTypeTexts, typeText)
 						}
 						putImport(pkgNames)
 						paramCount += len(tempNames)
 					}
 				}
 
-				if N := gsmock.MaxParamCount - 1; paramCount > N {
-					panic(fmt.Sprintf("have more than %d parameters", N))
+				paramNames = uniqueParamNames(paramNames, reservedNames)
+				var varParams []string
+				for k, paramName := range paramNames {
+					params = append(params, paramName+" "+paramTypeTexts[k])
+					if varText != "" {
+						typeText := paramTypeTexts[k]
+						if k == len(paramNames)-1 {
+							typeText = "[]" + typeText[3:]
+						}
+						varParams = append(varParams, paramName+" "+typeText)
+					}
 				}
 
-				var resultTypeArray []string
+				var (
+					resultTypeArray []string
+					resultExprs     []ast.Expr
+					resultNames     []string
+				)
 				if ft.Results != nil {
 					for _, result := range ft.Results.List {
 						var tempNames []string
@@ -400,21 +899,23 @@ func scanFile(ctx scanContext, file string, pkgs map[string]string) []Interface
 						} else {
 							for _, r := range result.Names {
 								tempNames = append(tempNames, r.Name)
+								resultNames = append(resultNames, r.Name)
 							}
 						}
 
 						typeText, pkgNames := getTypeText(result.Type)
 						for range tempNames {
 							resultTypeArray = append(resultTypeArray, typeText)
+							resultExprs = append(resultExprs, result.Type)
 						}
 						putImport(pkgNames)
 						resultCount += len(tempNames)
 					}
 				}
 
-				if resultCount > gsmock.MaxResultCount {
-					panic(fmt.Sprintf("have more than %d results", gsmock.MaxResultCount))
-				}
+				// Methods exceeding the numbered mockers use gsmock.MockerT,
+				// whose parameters and results are fields of tuple structs.
+				tuple := paramCount > gsmock.MaxParamCount-1 || resultCount > gsmock.MaxResultCount
 
 				mockerTmplTypes := ""
 				if len(paramTypes) > 0 || len(resultTypeArray) > 0 {
@@ -433,17 +934,62 @@ func scanFile(ctx scanContext, file string, pkgs map[string]string) []Interface
 					resultTmplTypes = "[" + strings.Join(resultTypeArray, ", ") + "]"
 				}
 
-				methods = append(methods, Method{
+				// Named results keep their names, renamed like the parameters
+				// when they collide with generated identifiers or parameters.
+				results := resultTypes
+				if len(resultNames) > 0 {
+					resultReserved := maps.Clone(reservedNames)
+					for _, n := range paramNames {
+						resultReserved[n] = struct{}{}
+					}
+					var named []string
+					for k, n := range uniqueParamNames(resultNames, resultReserved) {
+						named = append(named, n+" "+resultTypeArray[k])
+					}
+					results = "(" + strings.Join(named, ", ") + ")"
+				}
+
+				m := Method{
 					Name:            methodName,
+					MockName:        helperName("Mock", methodName),
+					ExpectNoName:    helperName("ExpectNo", methodName),
 					VariadicFlag:    varText,
 					Params:          strings.Join(params, ", "),
+					VarParams:       strings.Join(varParams, ", "),
 					ParamNames:      strings.Join(paramNames, ", "),
 					ParamCount:      paramCount,
 					ResultTypes:     resultTypes,
+					Results:         results,
 					ResultTmplTypes: resultTmplTypes,
 					ResultCount:     resultCount,
 					MockerTmplTypes: mockerTmplTypes,
-				})
+				}
+				m.Fallback = grpcFallback(kind, name, m)
+				if tuple {
+					m.Tuple = true
+					m.ArgFields = tupleFields(paramNames, paramTypeTexts, len(ft.Params.List) > 0 && len(ft.Params.List[0].Names) > 0, "A")
+					m.ResultFields = tupleFields(resultNames, resultTypeArray, len(resultNames) > 0, "R")
+					methods = append(methods, m)
+					continue
+				}
+				if m.ReturnsParams, m.ReturnsValue, m.ReturnsDesc = literalReturns(resultExprs); m.ReturnsParams != "" {
+					m.ReturnsName = m.MockName + "Returns"
+				}
+				if len(resultTypeArray) == 1 && resultTypeArray[0] == selfType {
+					m.ReturnSelfName = m.MockName + "ReturnSelf"
+				}
+				if varText == "" {
+					if m.PagesElem, m.PagesCursor = pagedReturns(paramNames, paramTypeTexts, resultExprs); m.PagesCursor != "" {
+						m.PagesName = m.MockName + "ReturnPages"
+						m.PagesTypes = "[" + m.PagesElem + ", " + resultTypeArray[1] + "]"
+					}
+					if requestResponse(ft, paramCount, resultExprs, totalImports) {
+						m.StubName = helperName("Stub", methodName)
+						m.StubReq = paramTypes[1]
+						m.StubTypes = "[" + m.StubReq + ", " + resultTypeArray[0] + "]"
+					}
+				}
+				methods = append(methods, m)
 			}
 
 			typeParams := ""
@@ -451,38 +997,281 @@ func scanFile(ctx scanContext, file string, pkgs map[string]string) []Interface
 				typeParams = "[" + strings.Join(typeParamArray, ", ") + "]"
 			}
 
-			typeParamNames := ""
-			if len(typeParamNameArray) > 0 {
-				typeParamNames = "[" + strings.Join(typeParamNameArray, ", ") + "]"
+			typeParamNames := typeParamNamesOf(typeParamNameArray)
+
+			var methodNames []string
+			for _, method := range t.Methods.List {
+				for _, n := range method.Names {
+					methodNames = append(methodNames, n.Name)
+				}
+			}
+
+			if (funcVars[s] || funcs[s] || funcTypes[s]) && len(methods) == 0 {
+				continue // the function is skipped
+			}
+
+			var funcVar string
+			if funcVars[s] {
+				funcVar = strings.ToLower(name[:1]) + name[1:] + "FuncVar"
+			}
+			var fn string
+			if funcs[s] {
+				fn = name
+				if ctx.Qualifier != "" {
+					fn = ctx.Qualifier + "." + name
+				}
 			}
 
 			ret = append(ret, Interface{
 				Package:         node.Name.String(),
 				Name:            name,
+				FuncVar:         funcVar,
+				Func:            fn,
+				FuncType:        funcTypes[s],
+				ApplyStubs:      uniqueHelperNames(methods, methodNames),
+				SelfType:        selfType,
 				TypeParams:      typeParams,
 				TypeParamNames:  typeParamNames,
 				EmbedInterfaces: embedInterfaces.String(),
 				Methods:         methods,
 				File:            file,
 				Imports:         needImports,
+				Stamp:           stamp,
 			})
 		}
 	}
 	return ret
 }
 
+// typeParamNamesOf returns the type argument list of a generic type
+// instantiated with its own type parameters, e.g. "[K, V]".
+func typeParamNamesOf(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(names, ", ") + "]"
+}
+
+// skipDirective is the comment that opts an interface method out of mocking.
+const skipDirective = "//gsmock:skip"
+
+// skipReason returns why the given interface method is not mocked,
+// or "" if it is. Methods are skipped when opted out by the skipDirective
+// comment, or when they use cgo types: these are only valid in files
+// whose cgo preamble declares them, which the generated file lacks.
+func skipReason(method *ast.Field, imports map[string]string) string {
+	if hasDirective(skipDirective, method.Doc, method.Comment) {
+		return "opted out by " + skipDirective
+	}
+	for name, pkgPath := range imports {
+		if pkgPath != "C" {
+			continue
+		}
+		typeText, pkgNames := getTypeText(method.Type)
+		if slices.Contains(pkgNames, name) {
+			return "uses cgo types in " + typeText
+		}
+	}
+	return ""
+}
+
+// helperName returns the name of a generated helper for the given
+// method or type name. Helpers of exported names are exported (e.g.
+// "MockGet"), while helpers of unexported names, only usable by white-box
+// tests in the same package, are unexported too (e.g. "mockGet" for "get").
+func helperName(prefix string, name string) string {
+	if ast.IsExported(name) {
+		return prefix + name
+	}
+	return strings.ToLower(prefix[:1]) + prefix[1:] + strings.ToUpper(name[:1]) + name[1:]
+}
+
+// pagedReturns detects paginated methods shaped like
+// "List(ctx context.Context, cursor C) ([]T, C, error)", where exactly one
+// parameter has the type C of the next cursor. It returns the element type
+// of the pages and the name of the cursor parameter, or empty strings.
+func pagedReturns(paramNames []string, paramTypes []string, results []ast.Expr) (elemType, cursor string) {
+	if len(results) != 3 {
+		return "", ""
+	}
+	t, ok := results[0].(*ast.ArrayType)
+	if !ok || t.Len != nil {
+		return "", ""
+	}
+	if id, ok := results[2].(*ast.Ident); !ok || id.Name != "error" {
+		return "", ""
+	}
+	cursorType, _ := getTypeText(results[1])
+	if cursorType == "error" {
+		return "", ""
+	}
+	for k, paramType := range paramTypes {
+		if paramType != cursorType {
+			continue
+		}
+		if cursor != "" {
+			return "", "" // ambiguous cursor parameter
+		}
+		cursor = paramNames[k]
+	}
+	if cursor == "" {
+		return "", ""
+	}
+	elemType, _ = getTypeText(t.Elt)
+	return elemType, cursor
+}
+
+// requestResponse reports whether the method of type ft is shaped like
+// "Do(ctx context.Context, req Req) (Resp, error)", the common pattern of
+// clients, whose requests are stubbed by a generated helper.
+func requestResponse(ft *ast.FuncType, paramCount int, results []ast.Expr, imports map[string]string) bool {
+	if paramCount != 2 || len(results) != 2 || !isContextType(ft.Params.List[0].Type, imports) {
+		return false
+	}
+	if id, ok := results[1].(*ast.Ident); !ok || id.Name != "error" {
+		return false
+	}
+	id, ok := results[0].(*ast.Ident)
+	return !ok || id.Name != "error"
+}
+
+// literalReturns describes the literal Return helper generated for methods
+// returning a slice or a map, optionally followed by an error, such as
+// "List() ([]Item, error)". The helper takes the elements of the slice or
+// the key-value pairs of the map, and returns them with a nil error.
+// It returns empty strings for methods with other results.
+func literalReturns(results []ast.Expr) (params, value, desc string) {
+	if n := len(results); n == 0 || n > 2 {
+		return "", "", ""
+	} else if n == 2 {
+		if id, ok := results[1].(*ast.Ident); !ok || id.Name != "error" {
+			return "", "", ""
+		}
+	}
+	switch t := results[0].(type) {
+	case *ast.ArrayType:
+		elemType, _ := getTypeText(t.Elt)
+		if t.Len != nil || elemType == "byte" { // []byte is data, not a list
+			return "", "", ""
+		}
+		params = "elems ..." + elemType
+		value = "gsmock.Slice(elems...)"
+		desc = "a slice of the given elements"
+	case *ast.MapType:
+		keyType, _ := getTypeText(t.Key)
+		valueType, _ := getTypeText(t.Value)
+		params = "k " + keyType + ", v " + valueType + ", kvs ...any"
+		value = "gsmock.MapOf(k, v, kvs...)"
+		desc = "a map of the given key-value pairs"
+	default:
+		return "", "", ""
+	}
+	if len(results) == 2 {
+		value += ", nil"
+		desc += " and a nil error"
+	}
+	return params, value, desc
+}
+
+// generatedNames lists the identifiers used inside the generated method
+// bodies; parameters with these names are renamed to avoid shadowing.
+var generatedNames = []string{"impl", "gsmock", "ret", "ok", "stubs"}
+
+// uniqueParamNames returns the parameter names to use in the generated code.
+// Unnamed and blank ("_") parameters are named "r<index>", and parameters
+// whose names are reserved get a "_" suffix. A numeric suffix is appended
+// when the chosen name is already taken by another parameter.
+func uniqueParamNames(names []string, reserved map[string]struct{}) []string {
+	used := make(map[string]struct{})
+	for _, n := range names {
+		used[n] = struct{}{}
+	}
+	ret := make([]string, len(names))
+	for i, n := range names {
+		var base string
+		if n == "" || n == "_" {
+			base = "r" + strconv.Itoa(i)
+		} else if _, ok := reserved[n]; ok {
+			base = n + "_"
+		} else {
+			ret[i] = n
+			continue
+		}
+		name := base
+		for k := 1; ; k++ {
+			_, ok1 := used[name]
+			_, ok2 := reserved[name]
+			if !ok1 && !ok2 {
+				break
+			}
+			name = base + "_" + strconv.Itoa(k)
+		}
+		used[name] = struct{}{}
+		ret[i] = name
+	}
+	return ret
+}
+
+// interfaceStamp returns a hash of the signature of the interface declared
+// by s: its type parameters, embedded interfaces and the types of its
+// methods, names of parameters and results aside. Mocks generated from
+// different versions of an interface get different stamps.
+func interfaceStamp(s *ast.TypeSpec) string {
+	var parts []string
+	if s.TypeParams != nil {
+		for _, f := range s.TypeParams.List {
+			typeText, _ := getTypeText(f.Type)
+			parts = append(parts, "["+strconv.Itoa(max(len(f.Names), 1))+" "+typeText+"]")
+		}
+	}
+	fieldTypes := func(l *ast.FieldList) string {
+		var types []string
+		if l != nil {
+			for _, f := range l.List {
+				typeText, _ := getTypeText(f.Type)
+				for range max(len(f.Names), 1) {
+					types = append(types, typeText)
+				}
+			}
+		}
+		return "(" + strings.Join(types, ", ") + ")"
+	}
+	for _, method := range s.Type.(*ast.InterfaceType).Methods.List {
+		if len(method.Names) == 0 {
+			typeText, _ := getTypeText(method.Type)
+			parts = append(parts, typeText)
+			continue
+		}
+		ft := method.Type.(*ast.FuncType)
+		parts = append(parts, method.Names[0].Name+fieldTypes(ft.Params)+fieldTypes(ft.Results))
+	}
+	slices.Sort(parts)
+	h := fnv.New32a()
+	_, _ = io.WriteString(h, strings.Join(parts, ";"))
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
 var (
 	typeTextBuffer  bytes.Buffer
 	typeTextFileSet = token.NewFileSet()
-	pkgNameSelector = regexp.MustCompile(`([a-zA-Z0-9_]+\.)`) // Matches package prefixes in type expressions
 )
 
 // getTypeText converts an AST type expression to its string representation,
-// and returns a slice of package names used in the type.
+// and returns the names of the packages qualifying the types it refers to,
+// i.e. the operands of its selector expressions.
 func getTypeText(t ast.Expr) (typeText string, pkgNames []string) {
 	typeTextBuffer.Reset()
 	_ = printer.Fprint(&typeTextBuffer, typeTextFileSet, t)
 	typeText = typeTextBuffer.String()
-	pkgNames = pkgNameSelector.FindAllString(typeText, -1)
+	ast.Inspect(t, func(n ast.Node) bool {
+		s, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if x, ok := s.X.(*ast.Ident); ok {
+			pkgNames = append(pkgNames, x.Name)
+		}
+		return false
+	})
 	return
 }
@@ -19,9 +19,13 @@ package main
 import (
 	"bytes"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 
-	"github.com/go-spring/gs-mock/internal/assert"
+	"github.com/go-spring/gs-mock/gsmock"
+	"github.com/go-spring/gs-mock/gsmock/gsmockassert"
 )
 
 func TestMockgen(t *testing.T) {
@@ -37,35 +41,729 @@ func TestMockgen(t *testing.T) {
 		})
 
 		b, err := os.ReadFile("./testdata/all_default/output.txt")
-		assert.Nil(t, err)
-		assert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
 	})
 
-	// Test package name conflict scenario
+	// Test delegating the embedded interfaces of other packages
+	t.Run("delegates", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/delegates",
+		})
+
+		b, err := os.ReadFile("./testdata/delegates/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test mocking the annotated function variables
+	t.Run("func_vars", func(t *testing.T) {
+		old, oldErr := stdOut, stdErr
+		stdOut, stdErr = bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+		defer func() { stdOut, stdErr = old, oldErr }()
+
+		run(runConfig{
+			SourceDir: "./testdata/func_vars",
+		})
+
+		b, err := os.ReadFile("./testdata/func_vars/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+		gsmockassert.Equal(t, stdErr.(*bytes.Buffer).String(), ""+
+			"gs-mock: warning: client is not mocked: no function DefaultClient in package net/http\n"+
+			"gs-mock: warning: retries is not mocked: int is not a function type\n")
+	})
+
+	// Test generation of the mocks of named function types
+	t.Run("func_types", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir:      "./testdata/func_types",
+			MockInterfaces: "Handler,Logf,Transform,Server",
+			Instantiate:    "Transform[string]",
+		})
+
+		b, err := os.ReadFile("./testdata/func_types/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test naming of the mocks of interfaces named like generated code
+	t.Run("mock_names", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/mock_names",
+		})
+
+		b, err := os.ReadFile("./testdata/mock_names/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test naming of the mocks with another suffix
+	t.Run("mock_suffix", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir:      "./testdata/mock_names",
+			MockInterfaces: "FooMock,Bar,Baz",
+			MockSuffix:     "Fake",
+		})
+
+		s := stdOut.(*bytes.Buffer).String()
+		gsmockassert.Match(t, s, `--mock-suffix Fake`)
+		gsmockassert.Match(t, s, `type FooMockFake struct`)
+		gsmockassert.Match(t, s, `type BarFake struct`)
+		gsmockassert.Match(t, s, `func NewBazFake\(r \*gsmock.Manager\) \*BazFake`)
+	})
+
+	// Test rejection of a mock suffix that isn't part of an identifier
+	t.Run("error_mock_suffix", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir:  "./testdata/mock_names",
+				MockSuffix: "-mock",
+			})
+		}, `invalid mock suffix "-mock"`)
+	})
+
+	// Test mocking the selected functions along with the interfaces
+	t.Run("funcs", func(t *testing.T) {
+		old, oldErr := stdOut, stdErr
+		stdOut, stdErr = bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+		defer func() { stdOut, stdErr = old, oldErr }()
+
+		run(runConfig{
+			SourceDir: "./testdata/funcs",
+			Funcs:     "Fetch,logf,Sum,Plain,Missing",
+		})
+
+		b, err := os.ReadFile("./testdata/funcs/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+		gsmockassert.Equal(t, stdErr.(*bytes.Buffer).String(), ""+
+			"gs-mock: warning: function Sum is not mocked: generic functions are not supported\n"+
+			"gs-mock: warning: function Plain is not mocked: its first parameter is not a context.Context\n"+
+			"gs-mock: warning: function Missing is not declared in ./testdata/funcs\n")
+	})
+
+	// Test renaming of parameters that collide with generated identifiers
+	t.Run("adversarial_params", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/adversarial_params",
+		})
+
+		b, err := os.ReadFile("./testdata/adversarial_params/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test mocking of unexported interfaces and methods
+	t.Run("unexported", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/unexported",
+		})
+
+		b, err := os.ReadFile("./testdata/unexported/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test type parameters with constraints referring to other packages
+	t.Run("type_param_constraints", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/type_param_constraints",
+		})
+
+		b, err := os.ReadFile("./testdata/type_param_constraints/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test literal Return helpers of methods returning slices and maps
+	t.Run("literal_returns", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/literal_returns",
+		})
+
+		b, err := os.ReadFile("./testdata/literal_returns/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test generation of the page helpers of paginated methods
+	t.Run("paged_list", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/paged_list",
+		})
+
+		b, err := os.ReadFile("./testdata/paged_list/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test unsafe.Pointer types and methods that are not mocked
+	t.Run("unsafe_cgo", func(t *testing.T) {
+		oldOut, oldErr := stdOut, stdErr
+		stdOut, stdErr = bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+		defer func() { stdOut, stdErr = oldOut, oldErr }()
+
+		run(runConfig{
+			SourceDir: "./testdata/unsafe_cgo",
+		})
+
+		b, err := os.ReadFile("./testdata/unsafe_cgo/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+		gsmockassert.Equal(t, stdErr.(*bytes.Buffer).String(), ""+
+			"gs-mock: warning: Buffer.CSize is not mocked: uses cgo types in func() C.size_t\n"+
+			"gs-mock: warning: Buffer.Free is not mocked: uses cgo types in func(p *C.char)\n"+
+			"gs-mock: warning: Buffer.Debug is not mocked: opted out by //gsmock:skip\n")
+	})
+
+	// Test reusing the interfaces cached by a previous run
+	t.Run("cache", func(t *testing.T) {
+		old := stdOut
+		defer func() { stdOut = old }()
+
+		cacheDir := t.TempDir()
+		b, err := os.ReadFile("./testdata/all_default/output.txt")
+		gsmockassert.Nil(t, err)
+
+		for range 2 {
+			stdOut = bytes.NewBuffer(nil)
+			run(runConfig{
+				SourceDir: "./testdata/all_default",
+				CacheDir:  cacheDir,
+			})
+			gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+		}
+
+		entries, err := filepath.Glob(filepath.Join(cacheDir, "*.json"))
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, len(entries), 1)
+
+		// A cache hit doesn't parse the file again
+		e, err := os.ReadFile(entries[0])
+		gsmockassert.Nil(t, err)
+		e = bytes.ReplaceAll(e, []byte(`"Name":"Closer"`), []byte(`"Name":"Cached"`))
+		gsmockassert.Nil(t, os.WriteFile(entries[0], e, 0644))
+
+		stdOut = bytes.NewBuffer(nil)
+		run(runConfig{
+			SourceDir: "./testdata/all_default",
+			CacheDir:  cacheDir,
+		})
+		gsmockassert.Match(t, stdOut.(*bytes.Buffer).String(), "CachedMockImpl")
+
+		// Other scan options don't share the cache entry
+		stdOut = bytes.NewBuffer(nil)
+		run(runConfig{
+			SourceDir:      "./testdata/all_default",
+			MockInterfaces: "Closer",
+			CacheDir:       cacheDir,
+		})
+		gsmockassert.Match(t, stdOut.(*bytes.Buffer).String(), "CloserMockImpl")
+	})
+
+	// Test package name conflict scenario: the same path imported with
+	// different names is imported once
 	t.Run("conflict_pkg_name", func(t *testing.T) {
-		assert.Panic(t, func() {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/conflict_pkg_name",
+		})
+
+		b, err := os.ReadFile("./testdata/conflict_pkg_name/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test import alias rules and aliasing of different paths with the same name
+	t.Run("import_alias", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir:     "./testdata/import_alias",
+			ImportAliases: []string{`^net/(\w+)$=net${1}`},
+		})
+
+		b, err := os.ReadFile("./testdata/import_alias/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test instantiated generic types of packages whose name differs from their path
+	t.Run("external_generics", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/external_generics",
+		})
+
+		b, err := os.ReadFile("./testdata/external_generics/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test invalid import alias rule
+	t.Run("error_import_alias", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir:     "./testdata/import_alias",
+				ImportAliases: []string{"net/http"},
+			})
+		}, "invalid import alias rule: net/http")
+	})
+
+	// Test mocking the interface dependencies of a struct across packages
+	t.Run("for_deps", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/for_deps",
+			ForDeps:   "Server",
+		})
+
+		b, err := os.ReadFile("./testdata/for_deps/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test mocking the interfaces of a single file
+	t.Run("source", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/source",
+			Source:    "service.go",
+		})
+
+		b, err := os.ReadFile("./testdata/source/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test source files that aren't Go files of the scanned directory
+	t.Run("error_source", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir: "./testdata/source",
+				Source:    "../for_deps/src.go",
+			})
+		}, "source ../for_deps/src.go is not a Go file of ./testdata/source")
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir: "./testdata/source",
+				Source:    "missing.go",
+			})
+		}, `error reading file\(testdata/source/missing.go\)`)
+	})
+
+	// Test mocking the interfaces of another package
+	t.Run("external_pkg", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir:      "./testdata/external_pkg",
+			Pkg:            "io",
+			MockInterfaces: "Reader,Writer,ReadWriter",
+		})
+
+		b, err := os.ReadFile("./testdata/external_pkg/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test unknown packages given to -pkg
+	t.Run("error_external_pkg", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir: "./testdata/external_pkg",
+				Pkg:       "example.com/unknown",
+			})
+		}, `error importing package\(example.com/unknown\)`)
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir: "./testdata/for_deps",
+				Pkg:       "io",
+				ForDeps:   "Server",
+			})
+		}, "-pkg and --for-deps cannot be used together")
+	})
+
+	// Test mocking the interfaces generated by protoc-gen-go-grpc
+	t.Run("grpc_services", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir:    "./testdata/grpc_services",
+			GRPCServices: true,
+		})
+
+		b, err := os.ReadFile("./testdata/grpc_services/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test unknown structs given to --for-deps
+	t.Run("error_for_deps", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir: "./testdata/for_deps",
+				ForDeps:   "Client",
+			})
+		}, "struct Client not found")
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir: "./testdata/for_deps",
+				ForDeps:   "app.Server",
+			})
+		}, "struct app.Server not found in package for_deps")
+	})
+
+	// Test generation of a build-ignored file when no interface matches
+	t.Run("allow_empty", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir:  "./testdata/allow_empty",
+			AllowEmpty: true,
+		})
+
+		b, err := os.ReadFile("./testdata/allow_empty/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	// Test skipping files with syntax errors
+	t.Run("skip_broken", func(t *testing.T) {
+		old, oldErr := stdOut, stdErr
+		stdOut, stdErr = bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+		defer func() { stdOut, stdErr = old, oldErr }()
+
+		run(runConfig{
+			SourceDir:  "./testdata/skip_broken",
+			SkipBroken: true,
+		})
+
+		b, err := os.ReadFile("./testdata/skip_broken/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+		gsmockassert.Match(t, stdErr.(*bytes.Buffer).String(),
+			`^gs-mock: warning: skipping broken file testdata/skip_broken/broken.go: .*broken.go:21:33: missing ',' before newline in parameter list`)
+
+		gsmockassert.Panic(t, func() {
 			run(runConfig{
-				SourceDir: "./testdata/conflict_pkg_name",
+				SourceDir: "./testdata/skip_broken",
 			})
-		}, "import package name conflict: stdio, io")
+		}, `error parsing file\(testdata/skip_broken/broken.go\)`)
+	})
+
+	// Test generating the mocks into another package
+	t.Run("dest_dir", func(t *testing.T) {
+		old := stdOut
+		defer func() { stdOut = old }()
+
+		stdOut = bytes.NewBuffer(nil)
+		run(runConfig{
+			SourceDir: "./testdata/dest_dir/lib",
+			DestDir:   "./testdata/dest_dir/app/mocks",
+		})
+		b, err := os.ReadFile("./testdata/dest_dir/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+
+		// the package holding the interfaces doesn't import itself
+		stdOut = bytes.NewBuffer(nil)
+		run(runConfig{
+			SourceDir: "./testdata/dest_dir/lib",
+			DestDir:   "./testdata/dest_dir/lib/../lib",
+		})
+		gsmockassert.Match(t, stdOut.(*bytes.Buffer).String(), `(?s)\npackage lib\n.*\) Store \{`)
+		gsmockassert.Equal(t, strings.Contains(stdOut.(*bytes.Buffer).String(), `"example.com/lib"`), false)
+
+		const app = "./testdata/dest_dir/app"
+		gsmockassert.Equal(t, importPathFrom("./testdata/dest_dir/lib/sub", app), "example.com/lib/sub")
+		gsmockassert.Equal(t, importPathFrom(app+"/nested/api", app), "example.com/nested/api")
+		gsmockassert.Equal(t, importPathFrom(app+"/mocks", app), "example.com/app/mocks")
+		gsmockassert.Equal(t, importPathFrom("./testdata/dest_dir/lib", "."), "example.com/lib")
+		gsmockassert.Equal(t, importPathFrom("./testdata/for_deps", app), "github.com/go-spring/gs-mock/testdata/for_deps")
 	})
 
-	// Test exceeding maximum allowed input parameters
-	t.Run("error_input_params", func(t *testing.T) {
-		assert.Panic(t, func() {
+	// Test error handling when no interface matches the filters
+	t.Run("error_no_interfaces", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
 			run(runConfig{
-				SourceDir: "./testdata/error_input_params",
+				SourceDir: "./testdata/allow_empty",
 			})
-		}, "have more than 6 parameters")
+		}, "no interfaces matched filter in ./testdata/allow_empty")
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir:      "./testdata/all_default",
+				MockInterfaces: "Nothing",
+			})
+		}, "no interfaces matched filter in ./testdata/all_default")
+	})
+
+	// Test aliasing of the mocks recorded in a registry
+	t.Run("registry", func(t *testing.T) {
+		oldOut, oldErr := stdOut, stdErr
+		defer func() { stdOut, stdErr = oldOut, oldErr }()
+
+		const registryFile = "./testdata/registry/registry.json"
+		registry, err := os.ReadFile(registryFile)
+		gsmockassert.Nil(t, err)
+
+		stdOut = bytes.NewBuffer(nil)
+		run(runConfig{
+			SourceDir: "./testdata/registry/dep",
+			Registry:  registryFile,
+		})
+		b, err := os.ReadFile("./testdata/registry/dep/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+
+		stdOut, stdErr = bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+		run(runConfig{
+			SourceDir: "./testdata/registry",
+			ForDeps:   "Server",
+			Registry:  registryFile,
+		})
+		b, err = os.ReadFile("./testdata/registry/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+		gsmockassert.Equal(t, stdErr.(*bytes.Buffer).String(),
+			"gs-mock: warning: the mock of io.Writer registered in example.com/mocks is stale, regenerate it\n")
+
+		b, err = os.ReadFile(registryFile)
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, string(b), string(registry))
+	})
+
+	// Test generating the registered generic mocks for Go versions without generic aliases
+	t.Run("registry_go_version", func(t *testing.T) {
+		oldOut, oldErr := stdOut, stdErr
+		stdOut, stdErr = bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+		defer func() { stdOut, stdErr = oldOut, oldErr }()
+
+		run(runConfig{
+			SourceDir: "./testdata/registry",
+			ForDeps:   "Server",
+			Registry:  "./testdata/registry/registry.json",
+			GoVersion: "1.21",
+		})
+
+		s := stdOut.(*bytes.Buffer).String()
+		gsmockassert.Match(t, s, `--go-version 1.21`)
+		gsmockassert.Match(t, s, `type RepositoryMockImpl = dep.RepositoryMockImpl\n`)
+		gsmockassert.Match(t, s, `type CacheMockImpl\[T any\] struct`)
+		gsmockassert.Equal(t, strings.Contains(s, "type CacheMockImpl[T any] = "), false)
+	})
+
+	// Test rejection of invalid Go versions and of those files can't target
+	t.Run("error_go_version", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir: "./testdata/all_default",
+				GoVersion: "latest",
+			})
+		}, `invalid Go version "latest"`)
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir: "./testdata/all_default",
+				GoVersion: "go1.20",
+			})
+		}, `Go version go1.20 is not supported: files can't target Go versions older than 1.21`)
+	})
+
+	// Test recording of the generated mocks in a registry
+	t.Run("registry_record", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		registryFile := filepath.Join(t.TempDir(), "registry.json")
+		run(runConfig{
+			SourceDir: "./testdata/registry/dep",
+			Registry:  registryFile,
+		})
+
+		got := loadRegistry(registryFile).entries
+		expect := loadRegistry("./testdata/registry/registry.json").entries
+		delete(expect, "io.Writer")
+		gsmockassert.Equal(t, got, expect)
+	})
+
+	// Test generation of named aliases of generic mocks
+	t.Run("instantiate", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir:   "./testdata/instantiate",
+			Instantiate: "Repository[User],Repository[*Order],Cache[string, time.Duration]",
+		})
+
+		b, err := os.ReadFile("./testdata/instantiate/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	t.Run("error_instantiate", func(t *testing.T) {
+		for _, c := range []struct {
+			instantiate string
+			panic       string
+		}{
+			{"Repository[User", "error parsing instantiations"},
+			{"Repository", "invalid instantiation Repository"},
+			{"Store[User]", "instantiated interface Store not found"},
+			{"Clock[User]", `interface Clock instantiated with 1 type arguments`},
+			{"Cache[string]", `interface Cache\[K, V\] instantiated with 1 type arguments`},
+			{"Repository[json.Number]", "package json of the type arguments of Repository is not imported"},
+			{"Repository[User],Repository[User]", "duplicate instantiation UserRepositoryMock"},
+		} {
+			gsmockassert.Panic(t, func() {
+				run(runConfig{
+					SourceDir:   "./testdata/instantiate",
+					Instantiate: c.instantiate,
+				})
+			}, c.panic)
+		}
+	})
+
+	// Test generation of narrow interfaces extracted from others
+	t.Run("subset", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir:      "./testdata/subset",
+			MockInterfaces: "!Service,!Store",
+			Subsets:        []string{"Service=Process,Convert,Clone:LeanService", "'Store=Get:Getter'"},
+		})
+
+		b, err := os.ReadFile("./testdata/subset/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	t.Run("error_subset", func(t *testing.T) {
+		for _, c := range []struct {
+			subset string
+			panic  string
+		}{
+			{"Service=Process", "invalid subset Service=Process"},
+			{"Service=:LeanService", "invalid subset Service=:LeanService"},
+			{"Client=Process:LeanService", "interface Client of subset LeanService not found"},
+			{"Service=Delete:LeanService", "method Delete of subset LeanService not found in Service"},
+			{"Service=Process:Store", "subset Store conflicts with interface Store"},
+		} {
+			gsmockassert.Panic(t, func() {
+				run(runConfig{
+					SourceDir: "./testdata/subset",
+					Subsets:   []string{c.subset},
+				})
+			}, c.panic)
+		}
+	})
+
+	// Test generation of mock setup code from a transcript
+	t.Run("setup_from", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/setup_from",
+			SetupFrom: "./testdata/setup_from/transcript.jsonl",
+		})
+
+		b, err := os.ReadFile("./testdata/setup_from/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
 	})
 
-	// Test exceeding maximum allowed return values
-	t.Run("error_return_params", func(t *testing.T) {
-		assert.Panic(t, func() {
+	t.Run("error_setup_from", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
 			run(runConfig{
-				SourceDir: "./testdata/error_return_params",
+				SourceDir: "./testdata/setup_from",
+				SetupFrom: "./testdata/setup_from/missing.jsonl",
 			})
-		}, "have more than 4 results")
+		}, "error reading transcript")
+		gsmockassert.Panic(t, func() {
+			run(runConfig{
+				SourceDir: "./testdata/setup_from",
+				SetupFrom: "./testdata/setup_from/src.go",
+			})
+		}, `error parsing transcript\(./testdata/setup_from/src.go\) line 1`)
+	})
+
+	// Test the tuple mocks of methods exceeding the numbered mockers
+	t.Run("tuples", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		run(runConfig{
+			SourceDir: "./testdata/tuples",
+			Funcs:     "Fetch",
+		})
+
+		b, err := os.ReadFile("./testdata/tuples/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
 	})
 
 	// Test successful generation with interface filtering
@@ -73,7 +771,316 @@ func TestMockgen(t *testing.T) {
 		run(runConfig{
 			SourceDir:      "example",
 			OutputFile:     "src_mock.go",
-			MockInterfaces: "'!RepositoryV2,,GenericService,Service,,Repository'",
+			MockInterfaces: "'!RepositoryV2,,GenericService,Service,,Repository,Query,now'",
+		})
+	})
+}
+
+func TestGraph(t *testing.T) {
+	for _, format := range []string{"dot", "json"} {
+		t.Run(format, func(t *testing.T) {
+			old := stdOut
+			stdOut = bytes.NewBuffer(nil)
+			defer func() { stdOut = old }()
+
+			runGraph(graphConfig{
+				SourceDir: "./testdata/graph",
+				Format:    format,
+			})
+
+			b, err := os.ReadFile("./testdata/graph/output." + format)
+			gsmockassert.Nil(t, err)
+			gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+		})
+	}
+
+	t.Run("error_format", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
+			runGraph(graphConfig{
+				SourceDir: "./testdata/graph",
+				Format:    "svg",
+			})
+		}, `unknown graph format "svg"`)
+	})
+}
+
+func TestPrune(t *testing.T) {
+	t.Run("reports", func(t *testing.T) {
+		old := stdOut
+		stdOut = bytes.NewBuffer(nil)
+		defer func() { stdOut = old }()
+
+		runPrune(pruneConfig{
+			SourceDir: "./testdata/prune",
+			Reports:   []string{"./testdata/prune/report1.jsonl", "./testdata/prune/report2.jsonl"},
 		})
+
+		b, err := os.ReadFile("./testdata/prune/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+	})
+
+	t.Run("error_reports", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
+			runPrune(pruneConfig{SourceDir: "./testdata/prune"})
+		}, "no report files given")
+		gsmockassert.Panic(t, func() {
+			runPrune(pruneConfig{
+				SourceDir: "./testdata/prune",
+				Reports:   []string{"./testdata/prune/src.go"},
+			})
+		}, `error parsing report\(./testdata/prune/src.go\) line 1`)
 	})
 }
+
+func TestFind(t *testing.T) {
+	t.Run("methods", func(t *testing.T) {
+		oldOut, oldErr := stdOut, stdErr
+		stdOut, stdErr = bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+		defer func() { stdOut, stdErr = oldOut, oldErr }()
+
+		runFind(findConfig{
+			SourceDir: "./testdata/find",
+			Methods:   []string{"Get(ctx context.Context, id string) (*store.User, error)"},
+		})
+
+		b, err := os.ReadFile("./testdata/find/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), string(b))
+		gsmockassert.Match(t, stdErr.(*bytes.Buffer).String(),
+			`^gs-mock: warning: skipping broken file testdata/find/legacy/legacy.go: `)
+	})
+
+	t.Run("exact", func(t *testing.T) {
+		oldOut, oldErr := stdOut, stdErr
+		stdOut, stdErr = bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+		defer func() { stdOut, stdErr = oldOut, oldErr }()
+
+		runFind(findConfig{
+			SourceDir: "./testdata/find",
+			Methods:   []string{"interface{ Get(context.Context, string) (*User, error); Put(context.Context, *User) error }"},
+			Exact:     true,
+		})
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(),
+			"./store/store.go:31: UserStore declares exactly these methods — mock it with: cd ./store && gs-mock -i UserStore\n")
+
+		stdOut = bytes.NewBuffer(nil)
+		runFind(findConfig{
+			SourceDir: "./testdata/find",
+			Methods:   []string{"Get(string) string", "Put(string, string)"},
+		})
+		gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), "no interface declares the 2 given methods\n")
+	})
+
+	t.Run("error_methods", func(t *testing.T) {
+		gsmockassert.Panic(t, func() {
+			runFind(findConfig{SourceDir: "./testdata/find"})
+		}, "no methods given")
+		gsmockassert.Panic(t, func() {
+			runFind(findConfig{SourceDir: "./testdata/find", Methods: []string{"Get("}})
+		}, `error parsing methods\(Get\(\)`)
+		gsmockassert.Panic(t, func() {
+			runFind(findConfig{SourceDir: "./testdata/find", Methods: []string{"io.Reader"}})
+		}, `methods\(io.Reader\) can't embed interfaces`)
+	})
+}
+
+func TestToolVersion(t *testing.T) {
+	// The tool and the runtime library are released together
+	gsmockassert.Equal(t, ToolVersion, gsmock.RuntimeVersion)
+}
+
+func TestServe(t *testing.T) {
+	in, err := os.Open("./testdata/serve/input.jsonl")
+	gsmockassert.Nil(t, err)
+	defer func() { _ = in.Close() }()
+
+	out := bytes.NewBuffer(nil)
+	runServe(serveConfig{}, in, out)
+
+	b, err := os.ReadFile("./testdata/serve/output.jsonl")
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, out.String(), string(b))
+}
+
+func TestScaffold(t *testing.T) {
+	t.Run("examples", func(t *testing.T) {
+		old := stdErr
+		stdErr = bytes.NewBuffer(nil)
+		defer func() { stdErr = old }()
+
+		b := generateScaffold(scaffoldConfig{SourceDir: "./testdata/scaffold"})
+		gsmockassert.Equal(t, stdErr.(*bytes.Buffer).String(),
+			"gs-mock: warning: no example for Repository: generic interfaces need type arguments\n")
+
+		expect, err := os.ReadFile("./testdata/scaffold/output.txt")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, string(b), string(expect))
+	})
+
+	t.Run("files", func(t *testing.T) {
+		old := stdErr
+		stdErr = bytes.NewBuffer(nil)
+		defer func() { stdErr = old }()
+
+		dir := t.TempDir()
+		src, err := os.ReadFile("./testdata/scaffold/src.go")
+		gsmockassert.Nil(t, err)
+		gsmockassert.Nil(t, os.WriteFile(filepath.Join(dir, "src.go"), src, 0644))
+
+		param := scaffoldConfig{SourceDir: dir, MockInterfaces: "Service"}
+		runScaffold(param)
+		_, err = os.Stat(filepath.Join(dir, "scaffold_mock.go"))
+		gsmockassert.Nil(t, err)
+		example := filepath.Join(dir, "scaffold_mocks_example_test.go")
+		b, err := os.ReadFile(example)
+		gsmockassert.Nil(t, err)
+		gsmockassert.Match(t, string(b), `func TestServiceMockExample\(t \*testing.T\)`)
+
+		// The example, meant to be edited, is kept when scaffolding again
+		gsmockassert.Nil(t, os.WriteFile(example, []byte("package scaffold\n"), 0644))
+		runScaffold(param)
+		b, err = os.ReadFile(example)
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, string(b), "package scaffold\n")
+		gsmockassert.Equal(t, stdErr.(*bytes.Buffer).String(),
+			"gs-mock: warning: scaffold_mocks_example_test.go already exists and is kept\n")
+	})
+}
+
+func TestVerify(t *testing.T) {
+	old := stdOut
+	stdOut = bytes.NewBuffer(nil)
+	defer func() { stdOut = old }()
+
+	dir := t.TempDir()
+	for name, data := range map[string]string{"src.go": "src.go", "src_mock.go": "stale.txt"} {
+		b, err := os.ReadFile("./testdata/verify/" + data)
+		gsmockassert.Nil(t, err)
+		gsmockassert.Nil(t, os.WriteFile(filepath.Join(dir, name), b, 0644))
+	}
+	param := runConfig{SourceDir: dir, OutputFile: "src_mock.go"}
+	mockFile := filepath.Join(dir, "src_mock.go")
+	deltaFile := filepath.Join(dir, "src_mock_delta.go")
+
+	// The stale mocks are reported with the methods their interfaces gained
+	gsmockassert.Equal(t, runVerify(param, false), false)
+	gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), mockFile+" is stale; run go generate, or gs-mock verify --fix\n"+
+		"interface Service gained Delete, Close, which its mock would panic on\n"+
+		"interface Cache gained Put, which its mock would panic on\n")
+	_, err := os.Stat(deltaFile)
+	gsmockassert.Equal(t, os.IsNotExist(err), true)
+
+	// Fixing regenerates the mocks and writes the stubs of the added methods
+	stdOut.(*bytes.Buffer).Reset()
+	gsmockassert.Equal(t, runVerify(param, true), true)
+	gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), "regenerated "+mockFile+"\n"+
+		"wrote the TODO stubs of the added methods to "+deltaFile+"\n")
+	b, err := os.ReadFile(mockFile)
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, string(b), string(generate(param)))
+	b, err = os.ReadFile(deltaFile)
+	gsmockassert.Nil(t, err)
+	expect, err := os.ReadFile("./testdata/verify/delta.txt")
+	gsmockassert.Nil(t, err)
+	gsmockassert.Equal(t, string(b), string(expect))
+
+	stdOut.(*bytes.Buffer).Reset()
+	gsmockassert.Equal(t, runVerify(param, false), true)
+	gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), mockFile+" is up to date\n")
+
+	// The stubs of a previous fix must be reviewed before fixing again
+	b, err = os.ReadFile("./testdata/verify/stale.txt")
+	gsmockassert.Nil(t, err)
+	gsmockassert.Nil(t, os.WriteFile(mockFile, b, 0644))
+	gsmockassert.Panic(t, func() {
+		runVerify(param, true)
+	}, `src_mock_delta.go already declares ServiceDefaultStubsDelta: review its stubs and remove it first`)
+}
+
+func TestConfig(t *testing.T) {
+	old := stdOut
+	stdOut = bytes.NewBuffer(nil)
+	defer func() { stdOut = old }()
+
+	dir := t.TempDir()
+	b, err := os.ReadFile("./testdata/verify/src.go")
+	gsmockassert.Nil(t, err)
+	gsmockassert.Nil(t, os.Mkdir(filepath.Join(dir, "store"), os.ModePerm))
+	gsmockassert.Nil(t, os.WriteFile(filepath.Join(dir, "store", "src.go"), b, 0644))
+
+	configFile := filepath.Join(dir, ".gsmock.yaml")
+	gsmockassert.Nil(t, os.WriteFile(configFile, []byte(`
+mock-suffix: Fake
+no-cache: true
+mocks:
+  - dir: store
+    output: store_mock.go
+    interfaces: Service,Clock
+  - dir: store
+    output: io_mock.go
+    pkg: io
+    interfaces: Reader
+    mock-suffix: Mock
+`), 0644))
+
+	// The mocks listed are generated like with the flags of the same names
+	runProject(configFile)
+	storeDir := filepath.Join(dir, "store")
+	gsmockassert.Equal(t, stdOut.(*bytes.Buffer).String(), ""+
+		"generated "+filepath.Join(storeDir, "store_mock.go")+"\n"+
+		"generated "+filepath.Join(storeDir, "io_mock.go")+"\n")
+	for _, param := range []runConfig{
+		{SourceDir: storeDir, OutputFile: "store_mock.go", MockInterfaces: "Service,Clock", MockSuffix: "Fake"},
+		{SourceDir: storeDir, OutputFile: "io_mock.go", Pkg: "io", MockInterfaces: "Reader", MockSuffix: "Mock"},
+	} {
+		b, err = os.ReadFile(filepath.Join(storeDir, param.OutputFile))
+		gsmockassert.Nil(t, err)
+		gsmockassert.Equal(t, string(b), string(generate(param)))
+	}
+	_, err = os.Stat(defaultCacheDir)
+	gsmockassert.Equal(t, os.IsNotExist(err), true)
+
+	// Misspelled options and mocks without output are rejected
+	gsmockassert.Nil(t, os.WriteFile(configFile, []byte("mocks:\n  - dir: store\n    interface: Service\n"), 0644))
+	gsmockassert.Panic(t, func() {
+		runProject(configFile)
+	}, `error parsing config\(.*\): yaml: unmarshal errors:\n  line 3: field interface not found`)
+	gsmockassert.Nil(t, os.WriteFile(configFile, []byte("mocks:\n  - dir: store\n"), 0644))
+	gsmockassert.Panic(t, func() {
+		runProject(configFile)
+	}, `mocks\[0\] of config\(.*\) has no output`)
+}
+
+// TestGRPCServicesBuild compiles the golden mocks of the gRPC services
+// with their protoc-gen-go-grpc fixture, against the real gRPC module.
+func TestGRPCServicesBuild(t *testing.T) {
+	if testing.Short() {
+		t.Skip("downloads the gRPC module")
+	}
+	root, err := filepath.Abs(".")
+	gsmockassert.Nil(t, err)
+
+	dir := t.TempDir()
+	for name, data := range map[string]string{
+		"greeter.pb.go":      "testdata/grpc_services/greeter.pb.go",
+		"greeter_grpc.pb.go": "testdata/grpc_services/greeter_grpc.pb.go",
+		"greeter_mock.go":    "testdata/grpc_services/output.txt",
+		"go.sum":             "go.sum",
+	} {
+		b, err := os.ReadFile(data)
+		gsmockassert.Nil(t, err)
+		gsmockassert.Nil(t, os.WriteFile(filepath.Join(dir, name), b, 0644))
+	}
+	gomod := "module example.com/grpc_services\n\ngo 1.26\n\n" +
+		"require (\n\tgithub.com/go-spring/gs-mock v0.0.0\n\tgoogle.golang.org/grpc v1.84.0\n)\n\n" +
+		"replace github.com/go-spring/gs-mock => " + root + "\n"
+	gsmockassert.Nil(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(gomod), 0644))
+
+	// -mod=mod adds the requirements of gRPC missing from go.mod and go.sum
+	cmd := exec.Command("go", "vet", "-mod=mod", ".")
+	cmd.Dir = dir
+	if b, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go vet: %v\n%s", err, b)
+	}
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/build"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// goModule holds the directives of a go.mod file needed to derive the
+// import paths of the packages of the module and of its replacements.
+type goModule struct {
+	Dir      string            // directory of the go.mod file
+	Path     string            // module path
+	Replaces map[string]string // module path => directory replacing it
+}
+
+// findModule parses the go.mod file of the module enclosing dir, i.e.
+// the nearest one in dir or its parents, so nested modules own their dirs.
+func findModule(dir string) goModule {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		panic(fmt.Errorf("error resolving directory(%s): %w", dir, err))
+	}
+	for modDir := absDir; ; {
+		if b, err := os.ReadFile(filepath.Join(modDir, "go.mod")); err == nil {
+			return parseModule(modDir, b)
+		}
+		parent := filepath.Dir(modDir)
+		if parent == modDir {
+			panic(fmt.Sprintf("no go.mod file found for %s", dir))
+		}
+		modDir = parent
+	}
+}
+
+// parseModule parses the module path and the directory replacements of
+// the go.mod file b in modDir. Version replacements are ignored, as they
+// don't change the import paths.
+func parseModule(modDir string, b []byte) goModule {
+	m := goModule{Dir: modDir, Replaces: make(map[string]string)}
+	inReplace := false
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "//")
+		line = strings.TrimSpace(line)
+		switch {
+		case inReplace:
+			if line == ")" {
+				inReplace = false
+			} else {
+				m.replace(line)
+			}
+		case line == "replace (":
+			inReplace = true
+		case strings.HasPrefix(line, "replace "):
+			m.replace(strings.TrimPrefix(line, "replace "))
+		case strings.HasPrefix(line, "module "):
+			m.Path = strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "module ")), `"`)
+		}
+	}
+	if m.Path == "" {
+		panic(fmt.Sprintf("no module path in %s", filepath.Join(modDir, "go.mod")))
+	}
+	return m
+}
+
+// replace records the replacement 'path [version] => dir' if it replaces
+// a module by a local directory.
+func (m *goModule) replace(spec string) {
+	old, repl, ok := strings.Cut(spec, "=>")
+	if !ok {
+		return
+	}
+	oldFields, replFields := strings.Fields(old), strings.Fields(repl)
+	if len(oldFields) == 0 || len(replFields) != 1 {
+		return
+	}
+	dir := strings.Trim(replFields[0], `"`)
+	if !filepath.IsAbs(dir) && !strings.HasPrefix(dir, "./") && !strings.HasPrefix(dir, "../") {
+		return
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(m.Dir, filepath.FromSlash(dir))
+	}
+	m.Replaces[strings.Trim(oldFields[0], `"`)] = filepath.Clean(dir)
+}
+
+// subPath returns the slash-separated path of dir relative to root, and
+// whether dir is root or one of its subdirectories.
+func subPath(root, dir string) (string, bool) {
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// importPathOf returns the import path of the package in dir, derived
+// from the module path declared by the go.mod file of the enclosing module.
+func importPathOf(dir string) string {
+	m := findModule(dir)
+	absDir, _ := filepath.Abs(dir)
+	rel, _ := subPath(m.Dir, absDir)
+	return path.Join(m.Path, rel)
+}
+
+// importPathFrom returns the import path by which the package in dir is
+// imported from the package in fromDir: a directory replacing a module in
+// the go.mod file of fromDir takes the path of the replaced module, other
+// directories are resolved by their own enclosing module.
+func importPathFrom(dir string, fromDir string) string {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		panic(fmt.Errorf("error resolving directory(%s): %w", dir, err))
+	}
+	from := findModule(fromDir)
+	var modPath, modDir string
+	for p, d := range from.Replaces {
+		// the innermost replacement wins, as for nested modules
+		if _, ok := subPath(d, absDir); ok && len(d) > len(modDir) {
+			modPath, modDir = p, d
+		}
+	}
+	if modDir == "" {
+		return importPathOf(dir)
+	}
+	if m := findModule(dir); len(m.Dir) > len(modDir) {
+		return importPathOf(dir) // a module nested in the replacement
+	}
+	rel, _ := subPath(modDir, absDir)
+	return path.Join(modPath, rel)
+}
+
+// packageDir returns the directory of the package pkgPath if it can be
+// located without the go command, i.e. in the standard library, in the
+// module enclosing dir, or in a directory replacing one of its requirements.
+func packageDir(pkgPath string, dir string) (string, bool) {
+	if first, _, _ := strings.Cut(pkgPath, "/"); !strings.Contains(first, ".") {
+		return filepath.Join(build.Default.GOROOT, "src", filepath.FromSlash(pkgPath)), true
+	}
+	m := findModule(dir)
+	roots := maps.Clone(m.Replaces)
+	roots[m.Path] = m.Dir
+	for modPath, modDir := range roots {
+		if rel, ok := strings.CutPrefix(pkgPath, modPath); ok && (rel == "" || rel[0] == '/') {
+			return filepath.Join(modDir, filepath.FromSlash(rel)), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// defaultMockSuffix is appended to the names of interfaces to name the
+// types of their mocks, e.g. "ServiceMockImpl".
+const defaultMockSuffix = "MockImpl"
+
+// assignMockTypes names the mock types of interfaces with suffix, and sets
+// their constructors accordingly. A "Mock" ending the name of an interface
+// isn't repeated, e.g. the mock of FooMock is FooMockImpl rather than
+// FooMockMockImpl, unless Foo is mocked too. Names taken by the types
+// declared in the package receiving the mocks, or by other mocks, get a
+// numeric suffix, e.g. FooMockImpl2 when FooMockImpl is declared.
+func assignMockTypes(interfaces []Interface, suffix string, declared map[string]bool) {
+	if suffix == "" {
+		suffix = defaultMockSuffix
+	}
+	if !token.IsIdentifier("X" + suffix) {
+		panic(fmt.Sprintf("invalid mock suffix %q", suffix))
+	}
+	names := make(map[string]bool)
+	for _, i := range interfaces {
+		names[i.Name] = true
+	}
+	taken := make(map[string]bool)
+	for n := range declared {
+		taken[n] = true
+	}
+	for k := range interfaces {
+		i := &interfaces[k]
+		if i.FuncVar != "" || i.Func != "" {
+			continue
+		}
+		var candidates []string
+		if base, ok := strings.CutSuffix(i.Name, "Mock"); ok && !names[base] {
+			if rest, ok := strings.CutPrefix(suffix, "Mock"); ok {
+				candidates = append(candidates, i.Name+rest)
+			}
+		}
+		candidates = append(candidates, i.Name+suffix)
+		name := ""
+		for _, c := range candidates {
+			if !taken[c] {
+				name = c
+				break
+			}
+		}
+		for n := 2; name == ""; n++ {
+			if c := i.Name + suffix + strconv.Itoa(n); !taken[c] {
+				name = c
+			}
+		}
+		taken[name] = true
+		i.MockType = name
+		i.Constructor = helperName("New", name)
+	}
+}
+
+// declaredTypes returns the names of the types declared at the top level
+// of the Go files of dir, which may not exist yet.
+func declaredTypes(dir string, outputFile string) map[string]bool {
+	ret := make(map[string]bool)
+	if _, err := os.Stat(dir); err != nil {
+		return ret
+	}
+	for _, f := range goFiles(dir, outputFile) {
+		node, err := parser.ParseFile(token.NewFileSet(), f, nil, parser.SkipObjectResolution)
+		if err != nil {
+			continue // reported when the file is scanned
+		}
+		for _, decl := range node.Decls {
+			if d, ok := decl.(*ast.GenDecl); ok && d.Tok == token.TYPE {
+				for _, spec := range d.Specs {
+					ret[spec.(*ast.TypeSpec).Name.Name] = true
+				}
+			}
+		}
+	}
+	return ret
+}
+
+// mockTypeBase returns the name of the interface mocked by the mock type
+// named name with suffix, ignoring a numeric suffix, or false if name
+// isn't named like a mock type.
+func mockTypeBase(name string, suffix string) (string, bool) {
+	if suffix == "" {
+		suffix = defaultMockSuffix
+	}
+	return strings.CutSuffix(strings.TrimRight(name, "0123456789"), suffix)
+}
+
+// uniqueHelperNames renames the generated helpers of methods, such as
+// MockGet, that are named like a method of the interface or like another
+// helper, by appending a numeric suffix, e.g. MockGet2 when the interface
+// declares both Get and MockGet. It returns the name of the method
+// applying stubs, likewise "ApplyStubs" unless the interface declares it.
+func uniqueHelperNames(methods []Method, methodNames []string) string {
+	taken := make(map[string]bool)
+	for _, n := range methodNames {
+		taken[n] = true
+	}
+	unique := func(name string) string {
+		if name == "" {
+			return ""
+		}
+		n := name
+		for k := 2; taken[n]; k++ {
+			n = name + strconv.Itoa(k)
+		}
+		taken[n] = true
+		return n
+	}
+	applyStubs := unique("ApplyStubs")
+	for k := range methods {
+		m := &methods[k]
+		m.MockName = unique(m.MockName)
+		m.ExpectNoName = unique(m.ExpectNoName)
+		m.ReturnsName = unique(m.ReturnsName)
+		m.ReturnSelfName = unique(m.ReturnSelfName)
+		m.PagesName = unique(m.PagesName)
+		m.StubName = unique(m.StubName)
+	}
+	return applyStubs
+}
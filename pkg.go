@@ -0,0 +1,54 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"go/build"
+	"path/filepath"
+)
+
+// scanPkg scans the interfaces of the package pkgPath, such as "io" or a
+// package of another module, for mocks generated into the package in
+// destDir, which imports it. Like the dependencies of --for-deps, their
+// types are qualified by the name of the declaring package, and its
+// unexported interfaces are skipped.
+func scanPkg(pkgPath string, destDir string, ctx scanContext) []Interface {
+	absDir, err := filepath.Abs(destDir)
+	if err != nil {
+		panic(fmt.Errorf("error resolving directory(%s): %w", destDir, err))
+	}
+	bp, err := build.Import(pkgPath, absDir, 0)
+	if err != nil {
+		panic(fmt.Errorf("error importing package(%s): %w", pkgPath, err))
+	}
+	c := ctx
+	c.OutputFile = "" // relative to destDir
+	c.Funcs = nil     // only those of the scanned package
+	c.Qualifier = bp.Name
+	c.QualifierPath = pkgPath
+	destPkg := destPackageName(destDir, ctx.OutputFile)
+	var ret []Interface
+	for _, f := range bp.GoFiles {
+		for _, i := range scanFileCached(c, filepath.Join(bp.Dir, f)) {
+			i.Package = destPkg
+			i.PkgPath = pkgPath
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/go-spring/gs-mock/gsmock"
+)
+
+// pruneConfig holds configuration parameters for the prune subcommand.
+type pruneConfig struct {
+	SourceDir      string   // Directory containing source Go files to scan.
+	MockInterfaces string   // Comma-separated interface filter string.
+	Reports        []string // Report files written by gsmock.Manager.WriteReport.
+	MockSuffix     string   // Suffix naming the mock types, as given to the generation.
+}
+
+// pruneMain runs the prune subcommand with its command-line arguments.
+func pruneMain(args []string) {
+	var param pruneConfig
+	fs := flag.NewFlagSet("prune", flag.ExitOnError)
+	fs.StringVar(&param.MockInterfaces, "i", "", "Comma-separated list of interface names to check, or to exclude with a '!' prefix, as given to the generation. Defaults to all interfaces.")
+	fs.StringVar(&param.MockSuffix, "mock-suffix", defaultMockSuffix, "Suffix naming the mock types after their interfaces, as given to the generation.")
+	_ = fs.Parse(args)
+	param.SourceDir = "."
+	param.Reports = fs.Args()
+	runPrune(param)
+}
+
+// mockMethodName matches the receiver type and the method of the functions
+// of pointer receivers, such as generated mocks, e.g.
+// "example.com/pkg.(*ServiceMockImpl[...]).Get".
+var mockMethodName = regexp.MustCompile(`\.\(\*(\w+)(?:\[[^]]*])?\)\.(\w+)$`)
+
+// runPrune reads the reports of test runs, written by
+// gsmock.Manager.WriteReport, and suggests to stop generating the mocked
+// methods of the interfaces of param.SourceDir that no test used, i.e.
+// neither mocked nor called. Mocks are identified by the names of their
+// types and methods, whatever package they are generated in.
+func runPrune(param pruneConfig) {
+	if len(param.Reports) == 0 {
+		panic("no report files given")
+	}
+	used := make(map[string]struct{}) // "MockType.Method"
+	for _, file := range param.Reports {
+		for _, e := range readReport(file) {
+			if e.Mockers == 0 && e.Calls == 0 {
+				continue
+			}
+			if m := mockMethodName.FindStringSubmatch(e.Func); m != nil {
+				used[m[1]+"."+m[2]] = struct{}{}
+			}
+		}
+	}
+
+	ctx := scanContext{
+		IncludeInterfaces: make(map[string]struct{}),
+		ExcludeInterfaces: make(map[string]struct{}),
+	}
+	ctx.parse(strings.Trim(param.MockInterfaces, `'"`))
+
+	interfaces := scanDir(param.SourceDir, ctx)
+	assignMockTypes(interfaces, param.MockSuffix, declaredTypes(param.SourceDir, ""))
+	for _, i := range interfaces {
+		var unused []string
+		for _, m := range i.Methods {
+			if _, ok := used[i.MockType+"."+m.Name]; !ok {
+				unused = append(unused, m.Name)
+			}
+		}
+		switch {
+		case len(unused) == 0:
+			continue
+		case len(unused) == len(i.Methods):
+			_, _ = fmt.Fprintf(stdOut, "interface %s has %d mocked methods never used in any test — consider filtering it out with -i '!%s'\n",
+				i.Name, len(unused), i.Name)
+		default:
+			_, _ = fmt.Fprintf(stdOut, "interface %s has %d of %d mocked methods never used in any test (%s) — consider opting them out with %s\n",
+				i.Name, len(unused), len(i.Methods), strings.Join(unused, ", "), skipDirective)
+		}
+	}
+}
+
+// readReport reads the entries of a report written by gsmock.Manager.WriteReport.
+func readReport(fileName string) []gsmock.ReportEntry {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		panic(fmt.Errorf("error reading report(%s): %w", fileName, err))
+	}
+	var entries []gsmock.ReportEntry
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	scanner.Buffer(nil, len(b)+1)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var e gsmock.ReportEntry
+		if err = json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			panic(fmt.Errorf("error parsing report(%s) line %d: %w", fileName, line, err))
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
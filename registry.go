@@ -0,0 +1,142 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+)
+
+// registryEntry records the package holding the mock of an interface.
+type registryEntry struct {
+	Fingerprint string `json:"fingerprint"`        // hash of the method set of the interface
+	Package     string `json:"package"`            // import path of the package holding the mock
+	MockType    string `json:"mockType,omitempty"` // name of the mock type, see assignMockTypes
+}
+
+// mockRegistry is a file shared by the packages of a repository, which
+// records where the mock of each interface is generated, so that other
+// packages mocking the same interface alias it instead of duplicating it.
+// It maps the import path and name of interfaces (e.g. "io.Writer") to
+// their entries.
+type mockRegistry struct {
+	file    string
+	entries map[string]registryEntry
+	changed bool
+}
+
+// loadRegistry reads a registry file, which may not exist yet.
+func loadRegistry(file string) *mockRegistry {
+	g := &mockRegistry{file: file, entries: make(map[string]registryEntry)}
+	b, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return g
+	} else if err != nil {
+		panic(fmt.Errorf("error reading registry(%s): %w", file, err))
+	}
+	if err = json.Unmarshal(b, &g.entries); err != nil {
+		panic(fmt.Errorf("error parsing registry(%s): %w", file, err))
+	}
+	return g
+}
+
+// save writes the registry file back if entries were added or updated.
+func (g *mockRegistry) save() {
+	if !g.changed {
+		return
+	}
+	b, err := json.MarshalIndent(g.entries, "", "  ")
+	if err != nil {
+		panic(fmt.Errorf("error encoding registry(%s): %w", g.file, err))
+	}
+	if err = os.WriteFile(g.file, append(b, '\n'), 0644); err != nil {
+		panic(fmt.Errorf("error writing registry(%s): %w", g.file, err))
+	}
+}
+
+// apply decides whether the mock of i is generated in the package
+// localPath or aliased from the package of its registry entry:
+//
+//   - without an entry, or with an entry of localPath, the mock is
+//     generated and the entry is recorded;
+//   - with an entry of another package and the same fingerprint, the mock
+//     is aliased;
+//   - with an entry of another package and another fingerprint, the
+//     registered mock is stale, so the mock is generated with a warning.
+func (g *mockRegistry) apply(i *Interface, localPath string) {
+	key := i.PkgPath + "." + i.Name
+	fp := fingerprint(*i)
+	e, ok := g.entries[key]
+	switch {
+	case ok && e.Package != localPath && e.Fingerprint == fp:
+		i.alias(e.Package, e.MockType)
+	case ok && e.Package != localPath:
+		_, _ = fmt.Fprintf(stdErr, "gs-mock: warning: the mock of %s registered in %s is stale, regenerate it\n", key, e.Package)
+	case !ok || e.Fingerprint != fp || e.MockType != i.MockType:
+		g.entries[key] = registryEntry{Fingerprint: fp, Package: localPath, MockType: i.MockType}
+		g.changed = true
+	}
+}
+
+// fingerprint returns a hash of the type parameters and the method set of
+// i. The qualifier of the package declaring i is removed from the types, so
+// that the fingerprint is the same in that package and in other packages.
+func fingerprint(i Interface) string {
+	normalize := func(s string) string { return s }
+	for name, pkgPath := range i.Imports {
+		if pkgPath == i.PkgPath {
+			re := regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\.`)
+			normalize = func(s string) string { return re.ReplaceAllString(s, "") }
+		}
+	}
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\n", normalize(i.TypeParams))
+	for _, m := range i.Methods {
+		_, _ = fmt.Fprintf(h, "%s %s%s\n", m.Name, m.VariadicFlag, normalize(m.MockerTmplTypes))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// alias turns i into an alias of the mock named mockType generated in the
+// package mockPath, or named by default if the registry predates mock type
+// names. Only the imports of its type parameters are kept, as its methods are not
+// generated, and only the names of its methods, aliasing their constants.
+func (i *Interface) alias(mockPath string, mockType string) {
+	name := path.Base(mockPath)
+	imports := map[string]string{name: mockPath}
+	for _, m := range pkgNameSelector.FindAllString(i.TypeParams, -1) {
+		if pkgPath, ok := i.Imports[m[:len(m)-1]]; ok {
+			imports[m[:len(m)-1]] = pkgPath
+		}
+	}
+	i.Imports = imports
+	i.MockPackage = name
+	if mockType == "" {
+		mockType = i.Name + defaultMockSuffix
+	}
+	i.MockType = mockType
+	i.Constructor = helperName("New", mockType)
+	for k, m := range i.Methods {
+		i.Methods[k] = Method{Name: m.Name}
+	}
+	i.Delegates = nil
+}
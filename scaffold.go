@@ -0,0 +1,282 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/types"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+)
+
+// scaffoldConfig holds configuration parameters for the scaffold subcommand.
+type scaffoldConfig struct {
+	SourceDir      string // Directory containing source Go files to scan.
+	OutputFile     string // Path to the Go file of the generated mocks.
+	MockInterfaces string // Comma-separated interface filter string.
+	MockSuffix     string // Suffix naming the mock types, defaultMockSuffix if empty.
+}
+
+// scaffoldMain runs the scaffold subcommand with its command-line arguments.
+func scaffoldMain(args []string) {
+	var param scaffoldConfig
+	fs := flag.NewFlagSet("scaffold", flag.ExitOnError)
+	fs.StringVar(&param.OutputFile, "o", "", "Path to the Go file of the generated mocks. Defaults to '<pkg>_mock.go'.")
+	fs.StringVar(&param.MockInterfaces, "i", "", "Comma-separated list of interface names to mock, or to exclude with a '!' prefix. Defaults to all interfaces.")
+	fs.StringVar(&param.MockSuffix, "mock-suffix", defaultMockSuffix, "Suffix naming the mock types after their interfaces.")
+	_ = fs.Parse(args)
+	param.SourceDir = "."
+	runScaffold(param)
+}
+
+// runScaffold generates the mocks of the interfaces of param.SourceDir,
+// like the generation without subcommand, along with an example test file
+// "<pkg>_mocks_example_test.go" showing how to use them. The example test
+// file is meant to be edited, so an existing one is kept.
+func runScaffold(param scaffoldConfig) {
+	pkg := packageName(param.SourceDir, param.OutputFile)
+	if len(param.OutputFile) == 0 {
+		param.OutputFile = pkg + "_mock.go"
+	}
+	run(runConfig{
+		SourceDir:      param.SourceDir,
+		OutputFile:     param.OutputFile,
+		MockInterfaces: param.MockInterfaces,
+		MockSuffix:     param.MockSuffix,
+	})
+
+	testFile := pkg + "_mocks_example_test.go"
+	if _, err := os.Stat(filepath.Join(param.SourceDir, testFile)); err == nil {
+		_, _ = fmt.Fprintf(stdErr, "gs-mock: warning: %s already exists and is kept\n", testFile)
+		return
+	}
+	writeFile(param.SourceDir, testFile, generateScaffold(param))
+}
+
+// scaffoldExample is the example test of the mock of an interface.
+type scaffoldExample struct {
+	Test        string   // name of the test function
+	Name        string   // name of the interface
+	Constructor string   // constructor of the mock
+	Method      Method   // method mocked and called by the example
+	WhenParams  string   // parameters of the When predicate
+	Vars        []string // declarations of the arguments of the call
+	Args        string   // arguments of the call
+	Results     string   // named results of the Return function
+	Assign      string   // left-hand side assigned the results of the call
+}
+
+// tmplScaffold is a template for the example tests of the mocks
+// generated by the scaffold subcommand.
+var tmplScaffold = template.Must(template.New("").Parse(`
+// Code generated by gs-mock {{.ToolVersion}} scaffold. Edit it as needed.
+// Tool: https://github.com/go-spring/gs-mock
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{range .Examples}}
+// {{.Test}} shows how to use the mock of {{.Name}}: replace the
+// call of {{.Method.Name}} with the code under test.
+func {{.Test}}(t *testing.T) {
+	r := gsmock.NewManager()
+	r.EnableRecording(gsmock.RetentionPolicy{})
+	m := {{.Constructor}}(r)
+
+	m.{{.Method.MockName}}().
+		When(func({{.WhenParams}}) bool {
+			return true
+		}).
+{{- if .Results}}
+		Return(func() {{.Results}} {
+			return
+		})
+{{- else}}
+		Return(func() {})
+{{- end}}
+{{if .Vars}}
+	var (
+{{- range .Vars}}
+		{{.}}
+{{- end}}
+	)
+{{- end}}
+	{{.Assign}}m.{{.Method.Name}}({{.Args}})
+
+	gsmockassert.Called(t, r, m, m.{{.Method.Name}}, 1)
+}
+{{end}}`))
+
+// generateScaffold returns the formatted example test file of the mocks
+// of the interfaces of param.SourceDir, with one test per interface
+// registering a When/Return mock of its first method, calling it and
+// verifying the call. Generic interfaces are skipped, as their mocks need
+// type arguments.
+func generateScaffold(param scaffoldConfig) []byte {
+	ctx := scanContext{
+		OutputFile:        param.OutputFile,
+		IncludeInterfaces: make(map[string]struct{}),
+		ExcludeInterfaces: make(map[string]struct{}),
+	}
+	ctx.parse(strings.Trim(param.MockInterfaces, `'"`))
+	interfaces := scanDir(param.SourceDir, ctx)
+	if len(interfaces) == 0 {
+		panic(fmt.Sprintf("no interfaces matched filter in %s", param.SourceDir))
+	}
+	resolveDelegates(interfaces, param.SourceDir)
+	assignMockTypes(interfaces, param.MockSuffix, declaredTypes(param.SourceDir, param.OutputFile))
+	imports := resolveImports(interfaces, nil)
+
+	var examples []scaffoldExample
+	for _, i := range interfaces {
+		switch {
+		case i.TypeParams != "":
+			_, _ = fmt.Fprintf(stdErr, "gs-mock: warning: no example for %s: generic interfaces need type arguments\n", i.Name)
+		case len(i.Methods) == 0:
+			_, _ = fmt.Fprintf(stdErr, "gs-mock: warning: no example for %s: it has no mocked method\n", i.Name)
+		case !slices.ContainsFunc(i.Methods, func(m Method) bool { return !m.Tuple }):
+			_, _ = fmt.Fprintf(stdErr, "gs-mock: warning: no example for %s: its methods use tuple mocks\n", i.Name)
+		default:
+			examples = append(examples, newScaffoldExample(i))
+		}
+	}
+
+	// Import the packages the examples refer to only
+	used := make(map[string]struct{})
+	for _, e := range examples {
+		usedQualifiers(used, "func("+e.WhenParams+") "+e.Results)
+	}
+	lines := []string{`"testing"`, `"` + gsmockPath + `"`, `"` + gsmockPath + `/gsmockassert"`}
+	for alias, pkgPath := range imports {
+		if _, ok := used[alias]; !ok || pkgPath == gsmockPath {
+			continue
+		}
+		if ss := strings.Split(pkgPath, "/"); alias == ss[len(ss)-1] {
+			lines = append(lines, strconv.Quote(pkgPath))
+		} else {
+			lines = append(lines, alias+" "+strconv.Quote(pkgPath))
+		}
+	}
+	slices.Sort(lines)
+
+	s := bytes.NewBuffer(nil)
+	if err := tmplScaffold.Execute(s, map[string]any{
+		"ToolVersion": ToolVersion,
+		"Package":     interfaces[0].Package,
+		"Imports":     lines,
+		"Examples":    examples,
+	}); err != nil {
+		panic(fmt.Errorf("error executing template(scaffold): %w", err))
+	}
+	return formatSource(s.Bytes())
+}
+
+// newScaffoldExample returns the example test of the mock of i, which
+// mocks and calls its first method using a numbered mocker.
+func newScaffoldExample(i Interface) scaffoldExample {
+	m := i.Methods[slices.IndexFunc(i.Methods, func(m Method) bool { return !m.Tuple })]
+	e := scaffoldExample{
+		Test:        "Test" + upperFirst(i.Name) + "MockExample",
+		Name:        i.Name,
+		Constructor: i.Constructor,
+		Method:      m,
+		WhenParams:  m.Params,
+	}
+	params := m.Params
+	if m.VariadicFlag != "" {
+		params = m.VarParams
+		e.WhenParams = m.VarParams
+	}
+	names, paramTypes := fieldList(params)
+	// The arguments are declared along with the locals of the example
+	names = uniqueParamNames(names, map[string]struct{}{"t": {}, "r": {}, "m": {}})
+	for k, name := range names {
+		e.Vars = append(e.Vars, name+" "+paramTypes[k])
+	}
+	e.Args = strings.Join(names, ", ")
+	if m.VariadicFlag != "" {
+		e.Args += "..."
+	}
+	if m.ResultCount > 0 {
+		_, resultTypes := fieldList(strings.Trim(m.ResultTypes, "()"))
+		var results, blanks []string
+		for k, t := range resultTypes {
+			results = append(results, "r"+strconv.Itoa(k+1)+" "+t)
+			blanks = append(blanks, "_")
+		}
+		e.Results = "(" + strings.Join(results, ", ") + ")"
+		e.Assign = strings.Join(blanks, ", ") + " = "
+	}
+	return e
+}
+
+// fieldList splits a list of parameters, such as "a int, b []string" or
+// "int, error", into the names and the types of its fields.
+func fieldList(list string) (names []string, fieldTypes []string) {
+	expr, err := parser.ParseExpr("func(" + list + ")")
+	if err != nil {
+		panic(fmt.Errorf("error parsing parameters(%s): %w", list, err))
+	}
+	for _, f := range expr.(*ast.FuncType).Params.List {
+		t := types.ExprString(f.Type)
+		if len(f.Names) == 0 {
+			fieldTypes = append(fieldTypes, t)
+		}
+		for _, name := range f.Names {
+			names = append(names, name.Name)
+			fieldTypes = append(fieldTypes, t)
+		}
+	}
+	return names, fieldTypes
+}
+
+// usedQualifiers adds to used the package names qualifying the types of
+// the function type funcType.
+func usedQualifiers(used map[string]struct{}, funcType string) {
+	expr, err := parser.ParseExpr(funcType)
+	if err != nil {
+		panic(fmt.Errorf("error parsing function type(%s): %w", funcType, err))
+	}
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if s, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := s.X.(*ast.Ident); ok {
+				used[x.Name] = struct{}{}
+			}
+		}
+		return true
+	})
+}
+
+// upperFirst returns s with its first letter in upper case.
+func upperFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[n:]
+}
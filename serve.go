@@ -0,0 +1,239 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// JSON-RPC 2.0 error codes returned by the serve subcommand.
+const (
+	rpcParseError     = -32700
+	rpcInvalidRequest = -32600
+	rpcMethodNotFound = -32601
+	rpcInvalidParams  = -32602
+	rpcServerError    = -32000
+)
+
+// serveConfig holds configuration parameters for the serve subcommand.
+type serveConfig struct {
+	CacheDir string // Directory caching scanned files, disabled if empty.
+}
+
+// serveMain runs the serve subcommand with its command-line arguments.
+func serveMain(args []string) {
+	var noCache bool
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	fs.BoolVar(&noCache, "no-cache", false, "Disable the cache of scanned files kept in the "+defaultCacheDir+" directory.")
+	_ = fs.Parse(args)
+	param := serveConfig{CacheDir: defaultCacheDir}
+	if noCache {
+		param.CacheDir = ""
+	}
+	runServe(param, os.Stdin, stdOut)
+}
+
+// rpcRequest is a JSON-RPC 2.0 request. Requests without id are
+// notifications, which get no response.
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// rpcResponse is a JSON-RPC 2.0 response.
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError is the error of a failed JSON-RPC request.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// serveParams are the parameters of the list and generate methods.
+//
+// Dir is the package directory, which defaults to the directory of File,
+// or else to the current directory. File and Line locate the interface
+// under the cursor of an editor, and take precedence over Interfaces.
+type serveParams struct {
+	Dir        string `json:"dir"`
+	Interfaces string `json:"interfaces"` // Interface filter, as the -i flag.
+	File       string `json:"file"`       // Source file, relative to Dir if not absolute.
+	Line       int    `json:"line"`       // 1-based line within File.
+	Output     string `json:"output"`     // Output file the mocks are meant for, as the -o flag.
+}
+
+// serveInterface is an interface returned by the list method.
+type serveInterface struct {
+	Name    string   `json:"name"`
+	File    string   `json:"file"`
+	Methods []string `json:"methods"`
+}
+
+// runServe serves the mock generator over a JSON-RPC 2.0 protocol, reading
+// one request per line from in and writing one response per line to out.
+// It lets editor plugins list the interfaces of a package and generate
+// mocks without temporary files nor shell invocations. The methods are:
+//
+//   - version: returns {"version": ToolVersion}.
+//   - list: returns the interfaces of a package.
+//   - generate: returns {"code": ...}, the mocks of the selected interfaces.
+//
+// It returns when in is exhausted.
+func runServe(param serveConfig, in io.Reader, out io.Writer) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	enc := json.NewEncoder(out)
+	enc.SetEscapeHTML(false)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		resp := rpcResponse{JSONRPC: "2.0", ID: json.RawMessage("null")}
+		var req rpcRequest
+		if err := json.Unmarshal([]byte(line), &req); err != nil {
+			resp.Error = &rpcError{Code: rpcParseError, Message: err.Error()}
+		} else if req.JSONRPC != "2.0" || req.Method == "" {
+			resp.Error = &rpcError{Code: rpcInvalidRequest, Message: "invalid request"}
+		} else {
+			if len(req.ID) == 0 {
+				serveRequest(param, req) // notification
+				continue
+			}
+			resp.ID = req.ID
+			resp.Result, resp.Error = serveRequest(param, req)
+		}
+		if err := enc.Encode(resp); err != nil {
+			panic(fmt.Errorf("error writing response: %w", err))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		panic(fmt.Errorf("error reading requests: %w", err))
+	}
+}
+
+// serveRequest handles a request, turning the panics of the generator
+// into errors.
+func serveRequest(param serveConfig, req rpcRequest) (result any, rpcErr *rpcError) {
+	defer func() {
+		if r := recover(); r != nil {
+			result, rpcErr = nil, &rpcError{Code: rpcServerError, Message: fmt.Sprint(r)}
+		}
+	}()
+
+	if req.Method == "version" {
+		return map[string]string{"version": ToolVersion}, nil
+	}
+
+	var p serveParams
+	if len(req.Params) > 0 {
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: err.Error()}
+		}
+	}
+	if p.File != "" {
+		if p.Dir == "" {
+			p.Dir = filepath.Dir(p.File)
+		} else if !filepath.IsAbs(p.File) {
+			p.File = filepath.Join(p.Dir, p.File)
+		}
+	}
+	if p.Dir == "" {
+		p.Dir = "."
+	}
+
+	switch req.Method {
+	case "list":
+		ctx := scanContext{
+			CacheDir:          param.CacheDir,
+			IncludeInterfaces: make(map[string]struct{}),
+			ExcludeInterfaces: make(map[string]struct{}),
+		}
+		ctx.parse(p.Interfaces)
+		list := []serveInterface{}
+		for _, i := range scanDir(p.Dir, ctx) {
+			s := serveInterface{Name: i.Name, File: filepath.Base(i.File), Methods: []string{}}
+			for _, m := range i.Methods {
+				s.Methods = append(s.Methods, m.Name)
+			}
+			list = append(list, s)
+		}
+		return list, nil
+	case "generate":
+		if p.File != "" {
+			if p.Line <= 0 {
+				return nil, &rpcError{Code: rpcInvalidParams, Message: "line is required with file"}
+			}
+			p.Interfaces = interfaceAt(p.File, p.Line)
+		}
+		b := generate(runConfig{
+			SourceDir:      p.Dir,
+			OutputFile:     p.Output,
+			MockInterfaces: p.Interfaces,
+			CacheDir:       param.CacheDir,
+		})
+		return map[string]string{"code": string(b)}, nil
+	default:
+		return nil, &rpcError{Code: rpcMethodNotFound, Message: fmt.Sprintf("unknown method %q", req.Method)}
+	}
+}
+
+// interfaceAt returns the name of the interface declared at the line of file.
+func interfaceAt(file string, line int) string {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+	if err != nil {
+		panic(fmt.Errorf("error parsing file(%s): %w", file, err))
+	}
+	for _, decl := range f.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range d.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if _, ok = ts.Type.(*ast.InterfaceType); !ok {
+				continue
+			}
+			start := ts.Pos()
+			if len(d.Specs) == 1 {
+				start = d.Pos() // includes the type keyword
+			}
+			if fset.Position(start).Line <= line && line <= fset.Position(ts.End()).Line {
+				return ts.Name.Name
+			}
+		}
+	}
+	panic(fmt.Sprintf("no interface declared at %s:%d", file, line))
+}
@@ -0,0 +1,247 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-spring/gs-mock/gsmock"
+)
+
+// setupMock is an interface mock created by the generated setup function.
+type setupMock struct {
+	Var         string // name of the variable holding the mock
+	Type        string // type of the mock, e.g. "*ServiceMockImpl"
+	Constructor string // constructor of the mock, e.g. "NewServiceMockImpl"
+}
+
+// setupFunc is a mocked function parsed from its full name, such as
+// "example.com/pkg.(*ServiceMockImpl).Get" or "example.com/pkg.Do".
+type setupFunc struct {
+	PkgPath  string // import path of the package declaring the function
+	Receiver string // receiver type without the pointer, empty for functions
+	Pointer  bool   // whether the receiver is a pointer
+	Name     string // name of the function or method
+}
+
+// receiverName matches the receiver and the method of a method name,
+// e.g. "(*Service).Get" or "Service.Get".
+var receiverName = regexp.MustCompile(`^(?:\(\*(\w+)\)|(\w+))\.(\w+)$`)
+
+// ctxParam matches the contexts in the formatted values.
+var ctxParam = regexp.MustCompile(`\bctx\b`)
+
+// parseSetupFunc splits the full name of a function into its parts.
+func parseSetupFunc(name string) setupFunc {
+	i := strings.LastIndex(name, "/") + 1
+	j := strings.Index(name[i:], ".")
+	if j < 0 {
+		panic(fmt.Sprintf("invalid function name %q in transcript", name))
+	}
+	f := setupFunc{PkgPath: name[:i+j], Name: name[i+j+1:]}
+	if m := receiverName.FindStringSubmatch(f.Name); m != nil {
+		f.Receiver, f.Pointer, f.Name = m[1]+m[2], m[1] != "", m[3]
+	}
+	return f
+}
+
+// generateSetup writes a function registering the mocks that reproduce
+// the calls recorded in the transcript param.SetupFrom, written by
+// gsmock.Manager.WriteTranscript. Calls of generated interface mocks are
+// set up on mocks created by their constructors, the other calls through
+// the gsmock.FuncNN functions. Packages only referenced by the recorded
+// values are not imported, and variadic functions are treated like the
+// others, which the developer fixes while editing the generated code.
+func generateSetup(s *bytes.Buffer, param runConfig, toolCommand string) {
+	entries := readTranscript(param.SetupFrom)
+	localPkg := packageName(param.SourceDir, param.OutputFile)
+
+	// localQualifier matches the qualifier of the local package in the
+	// formatted values, which must be removed in the generated code.
+	localQualifier := regexp.MustCompile(`(^|[^\w.])` + regexp.QuoteMeta(localPkg) + `\.`)
+	expr := func(values []string) string {
+		for i, v := range values {
+			values[i] = localQualifier.ReplaceAllString(v, "$1")
+		}
+		return strings.Join(values, ", ")
+	}
+
+	imports := map[string]string{"github.com/go-spring/gs-mock/gsmock": "gsmock"}
+	qualify := func(f setupFunc, name string) string {
+		if pkg := path.Base(f.PkgPath); pkg != localPkg {
+			imports[f.PkgPath] = pkg
+			return pkg + "." + name
+		}
+		return name
+	}
+
+	var (
+		mocks      []setupMock
+		mockIndex  = make(map[string]int)
+		statements []string
+		seen       = make(map[string]bool)
+	)
+	for _, e := range entries {
+		params := expr(e.Params)
+		if !e.Matched {
+			statements = append(statements, fmt.Sprintf("// unmatched call: %s(%s)", e.Func, params))
+			continue
+		}
+		if key := e.Func + "(" + params + ")"; seen[key] {
+			continue // the first setup of the same call already matches it
+		} else {
+			seen[key] = true
+		}
+
+		var target string
+		f := parseSetupFunc(e.Func)
+		base, isMock := mockTypeBase(f.Receiver, param.MockSuffix)
+		switch {
+		case f.Pointer && isMock:
+			mockType := f.PkgPath + "." + f.Receiver
+			idx, ok := mockIndex[mockType]
+			if !ok {
+				idx = len(mocks)
+				mockIndex[mockType] = idx
+				v := base
+				if v == "" {
+					v = f.Receiver // the mock of an interface named Mock
+				}
+				v = strings.ToLower(v[:1]) + v[1:] + "Mock"
+				if slices.ContainsFunc(mocks, func(m setupMock) bool { return m.Var == v }) {
+					v += fmt.Sprint(idx + 1) // same mock type in another package
+				}
+				mocks = append(mocks, setupMock{
+					Var:         v,
+					Type:        "*" + qualify(f, f.Receiver),
+					Constructor: qualify(f, helperName("New", f.Receiver)),
+				})
+			}
+			target = fmt.Sprintf("%s.%s()", mocks[idx].Var, helperName("Mock", f.Name))
+			if len(e.Params) > gsmock.MaxParamCount-1 || len(e.Results) > gsmock.MaxResultCount {
+				// The mock uses gsmock.MockerT, taking tuple structs
+				tuple := qualify(f, f.Receiver+f.Name)
+				if len(e.Params) > 0 {
+					target += ".WhenArgs(" + tuple + "Args{" + params + "})"
+				}
+				statements = append(statements, target+".ReturnValue("+tuple+"Results{"+expr(e.Results)+"})")
+				continue
+			}
+		case len(e.Params) > gsmock.MaxParamCount || len(e.Results) > gsmock.MaxResultCount:
+			// No numbered mocker fits, and the tuple structs of gsmock.FuncT
+			// are only generated for the methods of mocks
+			statements = append(statements, fmt.Sprintf("// call beyond the numbered mockers, mock it with gsmock.FuncT: %s(%s)", e.Func, params))
+			continue
+		case f.Receiver != "":
+			recv := qualify(f, f.Receiver)
+			if f.Pointer {
+				recv = "(*" + recv + ")"
+			}
+			target = fmt.Sprintf("gsmock.Func%d%d(%s.%s, r)", len(e.Params), len(e.Results), recv, f.Name)
+		default:
+			target = fmt.Sprintf("gsmock.Func%d%d(%s, r)", len(e.Params), len(e.Results), qualify(f, f.Name))
+		}
+		if len(e.Params) > 0 {
+			target += ".WhenArgs(" + params + ")"
+		}
+		statements = append(statements, target+".ReturnValue("+expr(e.Results)+")")
+	}
+
+	var usesCtx bool
+	for _, stmt := range statements {
+		if !strings.HasPrefix(stmt, "//") && ctxParam.MatchString(stmt) {
+			usesCtx = true
+		}
+		if strings.Contains(stmt, "errors.New(") {
+			imports["errors"] = "errors"
+		}
+	}
+	if usesCtx {
+		imports["context"] = "context"
+	}
+
+	// Standard packages come first, separated by an empty line.
+	paths := make([]string, 0, len(imports))
+	for p := range imports {
+		paths = append(paths, p)
+	}
+	isStd := func(p string) bool { return !strings.Contains(strings.Split(p, "/")[0], ".") }
+	slices.SortFunc(paths, func(a, b string) int {
+		if isStd(a) != isStd(b) {
+			if isStd(a) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	var importList []string
+	for i, p := range paths {
+		if i > 0 && isStd(paths[i-1]) && !isStd(p) {
+			importList = append(importList, "")
+		}
+		if path.Base(p) == imports[p] {
+			importList = append(importList, fmt.Sprintf("%q", p))
+		} else {
+			importList = append(importList, fmt.Sprintf("%s %q", imports[p], p))
+		}
+	}
+
+	if err := tmplSetup.Execute(s, map[string]any{
+		"ToolVersion": ToolVersion,
+		"ToolCommand": toolCommand,
+		"Transcript":  filepath.Base(param.SetupFrom),
+		"Package":     localPkg,
+		"Imports":     importList,
+		"UsesCtx":     usesCtx,
+		"Mocks":       mocks,
+		"Statements":  statements,
+	}); err != nil {
+		panic(fmt.Errorf("error executing template(setup): %w", err))
+	}
+}
+
+// readTranscript reads the entries of a transcript file.
+func readTranscript(fileName string) []gsmock.TranscriptEntry {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		panic(fmt.Errorf("error reading transcript(%s): %w", fileName, err))
+	}
+	var entries []gsmock.TranscriptEntry
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	scanner.Buffer(nil, len(b)+1)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var e gsmock.TranscriptEntry
+		if err = json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			panic(fmt.Errorf("error parsing transcript(%s) line %d: %w", fileName, line, err))
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
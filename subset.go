@@ -0,0 +1,146 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// subsetSpecs holds the --subset flag values.
+type subsetSpecs []string
+
+// String implements flag.Value.
+func (a *subsetSpecs) String() string {
+	return strings.Join(*a, ";")
+}
+
+// Set implements flag.Value.
+func (a *subsetSpecs) Set(s string) error {
+	*a = append(*a, s)
+	return nil
+}
+
+// subsetSpec describes a narrow interface extracted from another one.
+type subsetSpec struct {
+	Source  string   // Name of the interface the methods are taken from
+	Methods []string // Names of the methods of the subset
+	Name    string   // Name of the generated interface
+}
+
+// parseSubsetSpec parses a subset of the form "Service=Process,Convert:LeanService".
+func parseSubsetSpec(s string) subsetSpec {
+	s = strings.Trim(s, `'"`)
+	source, rest, ok1 := strings.Cut(s, "=")
+	methods, name, ok2 := strings.Cut(rest, ":")
+	if !ok1 || !ok2 {
+		panic(fmt.Sprintf("invalid subset %s", s))
+	}
+	spec := subsetSpec{Source: strings.TrimSpace(source), Name: strings.TrimSpace(name)}
+	for m := range strings.SplitSeq(methods, ",") {
+		if m = strings.TrimSpace(m); len(m) > 0 {
+			spec.Methods = append(spec.Methods, m)
+		}
+	}
+	if spec.Source == "" || spec.Name == "" || len(spec.Methods) == 0 {
+		panic(fmt.Sprintf("invalid subset %s", s))
+	}
+	return spec
+}
+
+// widen makes ctx scan the source interfaces of the subsets, which are
+// needed even if the filters exclude their own mocks.
+func (ctx *scanContext) widen(specs []subsetSpec) scanContext {
+	ret := scanContext{
+		OutputFile:        ctx.OutputFile,
+		GRPCServices:      ctx.GRPCServices,
+		CacheDir:          ctx.CacheDir,
+		SkipBroken:        ctx.SkipBroken,
+		IncludeInterfaces: make(map[string]struct{}),
+		ExcludeInterfaces: make(map[string]struct{}),
+	}
+	for name := range ctx.IncludeInterfaces {
+		ret.IncludeInterfaces[name] = struct{}{}
+	}
+	for name := range ctx.ExcludeInterfaces {
+		ret.ExcludeInterfaces[name] = struct{}{}
+	}
+	for _, spec := range specs {
+		if len(ret.IncludeInterfaces) > 0 {
+			ret.IncludeInterfaces[spec.Source] = struct{}{}
+		}
+		delete(ret.ExcludeInterfaces, spec.Source)
+	}
+	return ret
+}
+
+// applySubsets appends the subset interfaces to interfaces, and then
+// removes the source interfaces that ctx doesn't mock. A subset is
+// declared by the generated file, with the methods of the source listed,
+// in the order they are listed, and gets its own mock.
+func applySubsets(interfaces []Interface, specs []subsetSpec, ctx scanContext) []Interface {
+	for _, spec := range specs {
+		if slices.ContainsFunc(interfaces, func(i Interface) bool { return i.Name == spec.Name }) {
+			panic(fmt.Sprintf("subset %s conflicts with interface %s", spec.Name, spec.Name))
+		}
+		k := slices.IndexFunc(interfaces, func(i Interface) bool { return i.Name == spec.Source })
+		if k < 0 {
+			panic(fmt.Sprintf("interface %s of subset %s not found", spec.Source, spec.Name))
+		}
+		src := interfaces[k]
+
+		i := Interface{
+			Package:        src.Package,
+			Name:           spec.Name,
+			ApplyStubs:     src.ApplyStubs,
+			SelfType:       spec.Name + src.TypeParamNames,
+			TypeParams:     src.TypeParams,
+			TypeParamNames: src.TypeParamNames,
+			File:           src.File,
+			SubsetOf:       src.SelfType,
+			Stamp:          src.Stamp,
+		}
+		for _, name := range spec.Methods {
+			n := slices.IndexFunc(src.Methods, func(m Method) bool { return m.Name == name })
+			if n < 0 {
+				panic(fmt.Sprintf("method %s of subset %s not found in %s", name, spec.Name, spec.Source))
+			}
+			m := src.Methods[n]
+			m.ReturnSelfName = "" // the source interface is not returned by its subset
+			i.Methods = append(i.Methods, m)
+		}
+
+		// Only keep the imports used by the subset, as the source
+		// interface may not be generated.
+		texts := []string{i.TypeParams}
+		for _, m := range i.Methods {
+			texts = append(texts, m.Params, m.ResultTypes, m.Fallback)
+		}
+		i.Imports = make(map[string]string)
+		for _, s := range pkgNameSelector.FindAllString(strings.Join(texts, " "), -1) {
+			if pkgPath, ok := src.Imports[s[:len(s)-1]]; ok {
+				i.Imports[s[:len(s)-1]] = pkgPath
+			}
+		}
+		interfaces = append(interfaces, i)
+	}
+
+	return slices.DeleteFunc(interfaces, func(i Interface) bool {
+		return i.SubsetOf == "" && !ctx.mock(i.Name)
+	})
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package adversarial_params
+
+import (
+	"context"
+	"net/http"
+)
+
+type Service interface {
+	Params(params []any, r1 int, _ string, _ int64)
+	Shadow(impl, gsmock string, ret, ok bool) (bool, error)
+	Blank(_ int, r0 string) error
+	Imports(http *http.Request, context context.Context) *http.Response
+	Named(key string) (n int, err error)
+	NamedShadow(key string) (key2 int, impl, gsmock error)
+	NamedPair(n int) (found, ok bool)
+}
+
+type Generic[r0 any] interface {
+	Get(int) r0
+}
@@ -0,0 +1,10 @@
+module example.com/app
+
+go 1.24
+
+require example.com/lib v0.0.0
+
+replace (
+	example.com/lib => ../lib // local copy
+	golang.org/x/net v1.2.3 => golang.org/x/net v1.2.4
+)
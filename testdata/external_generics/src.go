@@ -0,0 +1,40 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package external_generics
+
+import (
+	"iter"
+
+	"github.com/acme/go-result/v2"
+	"github.com/acme/lazy"
+	"gopkg.in/pair.v1"
+)
+
+type User struct {
+	Name string
+}
+
+type Store interface {
+	Get(id string) result.Result[User]
+	GetAll(ids ...string) result.Result[[]*User]
+	Pairs() iter.Seq[pair.Pair[string, result.Result[*User]]]
+	Lazy(f func() lazy.Value[map[string]pair.Pair[int, User]]) error
+}
+
+type Cache[K comparable, V any] interface {
+	Load(key K) (result.Result[pair.Pair[K, V]], bool)
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cache
+
+import (
+	"context"
+
+	"example.com/find/store"
+)
+
+type Cache interface {
+	error
+	Get(ctx context.Context, key string) (*store.User, error)
+	Put(ctx context.Context, key string, u *store.User) error
+	Delete(ctx context.Context, key string) error
+}
+
+type Lookup interface {
+	Get(key string) string
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package store
+
+import (
+	"context"
+	"io"
+)
+
+type User struct {
+	ID string
+}
+
+type UserGetter interface {
+	Get(ctx context.Context, id string) (*User, error)
+}
+
+type UserStore interface {
+	UserGetter
+	Put(ctx context.Context, u *User) error
+}
+
+type UserCache interface {
+	Get(context.Context, string) (*User, error)
+	Delete(ctx context.Context, id string) error
+	io.Closer
+}
+
+type ID interface {
+	~int | ~int64
+}
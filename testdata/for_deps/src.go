@@ -0,0 +1,41 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package for_deps
+
+import (
+	"io"
+	"time"
+
+	store "github.com/go-spring/gs-mock/testdata/for_deps/dep"
+)
+
+type Clock interface {
+	Now() time.Time
+}
+
+type Unused interface {
+	Unused()
+}
+
+type Server struct {
+	clock  Clock
+	repo   store.Repository
+	cache  store.Cache[string]
+	out    io.Writer
+	config *store.Config
+	name   string
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package func_types
+
+import (
+	"context"
+)
+
+type Request struct {
+	Path string
+}
+
+type Response struct {
+	Status int
+}
+
+// Handler serves a request.
+type Handler func(ctx context.Context, req *Request) (*Response, error)
+
+// Logf logs a formatted message.
+type Logf func(format string, args ...any)
+
+// Transform converts a value.
+type Transform[T any] func(v T) T
+
+// Option is not mocked, since it is not named.
+type Option func(*Request)
+
+type Server interface {
+	Handle(path string, h Handler)
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package func_vars
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+)
+
+//gsmock:var
+var Now = time.Now
+
+var (
+	readFile = os.ReadFile //gsmock:var
+
+	//gsmock:var
+	fetch = fetchURL
+
+	//gsmock:var
+	handle func(ctx context.Context, args ...string) error
+
+	//gsmock:var
+	logf = func(format string, args ...any) {}
+
+	//gsmock:var
+	client = http.DefaultClient
+
+	//gsmock:var
+	retries int = 3
+
+	sleep = time.Sleep
+)
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package funcs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type Store interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// Fetch reads the body of url.
+func Fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+func logf(ctx context.Context, format string, args ...any) {
+	fmt.Printf(format, args...)
+}
+
+func Sum[T int | float64](ctx context.Context, values ...T) (sum T) {
+	for _, v := range values {
+		sum += v
+	}
+	return
+}
+
+func Plain(n int) int {
+	return n
+}
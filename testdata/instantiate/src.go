@@ -0,0 +1,42 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package instantiate
+
+import (
+	"context"
+	"time"
+)
+
+type User struct {
+	Name string
+}
+
+type Order struct {
+	ID int
+}
+
+type Repository[T any] interface {
+	Get(ctx context.Context, id int) (T, error)
+}
+
+type Cache[K comparable, V any] interface {
+	Load(key K) (V, bool)
+}
+
+type Clock interface {
+	Now() time.Time
+}
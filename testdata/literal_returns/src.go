@@ -0,0 +1,41 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package literal_returns
+
+import (
+	"context"
+	"net/url"
+)
+
+type Item struct {
+	ID string
+}
+
+type Repository interface {
+	List(ctx context.Context) ([]*Item, error)
+	IDs() []string
+	Counts(ctx context.Context) (map[string]int, error)
+	Params() map[string][]url.Values
+	Raw() ([]byte, error)
+	Fixed() [2]int
+	Page(ctx context.Context) ([]Item, int, error)
+}
+
+type Builder[T any] interface {
+	With(v T) Builder[T]
+	Clone() (Builder[T], error)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mock_names
+
+import (
+	"context"
+)
+
+// Mock is named like the suffix of its mock, minus "Impl".
+type Mock interface {
+	Do(ctx context.Context) error
+}
+
+// MockImpl is named like the default suffix of mocks.
+type MockImpl interface {
+	Do(ctx context.Context) error
+}
+
+type Mocker interface {
+	Mock(ctx context.Context) error
+}
+
+type Invoke interface {
+	Invoke(ctx context.Context, fn any) ([]any, bool)
+}
+
+// FooMock gets FooMockImpl rather than FooMockMockImpl.
+type FooMock interface {
+	Foo(ctx context.Context) error
+}
+
+// BarMock doesn't, as BarMockImpl is the mock of Bar.
+type BarMock interface {
+	Bar(ctx context.Context) error
+}
+
+type Bar interface {
+	Bar(ctx context.Context) error
+}
+
+// BazMockImpl is a hand-written mock of Baz, whose generated one is BazMockImpl2.
+type BazMockImpl struct{}
+
+type Baz interface {
+	Baz(ctx context.Context) error
+}
+
+// Getter declares methods named like the helpers of its other methods.
+type Getter interface {
+	Get(ctx context.Context, key string) (string, error)
+	MockGet(ctx context.Context) error
+	ExpectNoGet() bool
+	ApplyStubs()
+}
@@ -0,0 +1,35 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package paged_list
+
+import (
+	"context"
+)
+
+type Item struct {
+	ID int
+}
+
+type Lister interface {
+	List(ctx context.Context, pageToken string) ([]*Item, string, error)
+	Search(ctx context.Context, query string, pageToken string) ([]*Item, string, error)
+	Names(ctx context.Context, offset int) ([]string, int, error)
+}
+
+type Repo[T any] interface {
+	Scan(ctx context.Context, after *int64, limit int) ([]T, *int64, error)
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package prune
+
+import (
+	"context"
+)
+
+type Logger interface {
+	Log(msg string)
+}
+
+type Service interface {
+	Get(ctx context.Context, id int) (string, error)
+	Put(ctx context.Context, id int, value string) error
+	Delete(ctx context.Context, id int) error
+	Stats() map[string]int
+}
+
+type Repository[T any] interface {
+	Find(id int) (T, error)
+	Save(v T) error
+}
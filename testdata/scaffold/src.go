@@ -0,0 +1,45 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scaffold
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+type Service interface {
+	Get(ctx context.Context, id int) (string, error)
+	Put(ctx context.Context, id int, value string) error
+}
+
+type Logger interface {
+	Logf(format string, args ...any)
+}
+
+type reader interface {
+	Read(r io.Reader, m, t []byte) (int, error)
+	Close()
+}
+
+type Client interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+type Repository[T any] interface {
+	Find(id int) (T, error)
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package setup_from
+
+import (
+	"context"
+)
+
+type Item struct {
+	ID   int
+	Name string
+}
+
+type Service interface {
+	Get(ctx context.Context, id int) (*Item, error)
+	List() []string
+	Save(ctx context.Context, a, b, c, d, e, f int) error
+}
+
+type Client struct{}
+
+func (c *Client) Close() error { return nil }
+
+func Do(ctx context.Context, n int) int { return n }
+
+func Sum(ctx context.Context, a, b, c, d, e, f, g int) int { return a + b + c + d + e + f + g }
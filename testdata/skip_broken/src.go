@@ -14,8 +14,12 @@
  * limitations under the License.
  */
 
-package error_input_params
+package skip_broken
+
+import (
+	"context"
+)
 
 type Service interface {
-	Save(r1, r2, r3, r4, r5, r6, r7 int)
+	Get(ctx context.Context, id int) (string, error)
 }
@@ -13,9 +13,8 @@
  * See the License for the specific language governing permissions and
  * limitations under the License.
  */
-
-package error_input_params
+package source
 
 type Service interface {
-	Save() (r1, r2, r3, r4, r5, r6 int)
+	Get(id int) (string, error)
 }
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package subset
+
+import (
+	"context"
+	"io"
+)
+
+type Request struct{}
+
+type Response struct{}
+
+type Service interface {
+	Process(ctx context.Context, req *Request) (*Response, error)
+	Convert(req *Request, opts ...string) *Response
+	Export(w io.Writer) error
+	Clone() Service
+}
+
+type Store[K comparable, V any] interface {
+	Get(ctx context.Context, key K) (V, error)
+	Put(ctx context.Context, key K, value V) error
+}
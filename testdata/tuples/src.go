@@ -0,0 +1,35 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tuples
+
+import "context"
+
+type Service interface {
+	Ping(ctx context.Context) error
+	Save(ctx context.Context, a, b, c, d, e, f int) error
+	Stats() (int, int, int, int, error)
+	Scan(ctx context.Context, key string, limit int, offset int, tags []string, desc bool, opts ...string) (n int, total int, next string, more bool, err error)
+	Find(ctx context.Context, id int, Id int, x, y, z, w int) (any, error)
+}
+
+type Store[K comparable, V any] interface {
+	Batch(k1, k2, k3, k4, k5, k6, k7 K) (V, error)
+}
+
+func Fetch(ctx context.Context, a, b, c, d, e, f int) (int, error) {
+	return 0, nil
+}
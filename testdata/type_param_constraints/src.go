@@ -0,0 +1,43 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package type_param_constraints
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+type Builder[T fmt.Stringer] interface {
+	Build() T
+}
+
+type Pair[K, V any] interface {
+	Get(key K) (V, bool)
+}
+
+type Union[T ~int | ~float64, R interface {
+	io.Reader
+	fmt.Stringer
+}] interface {
+	Sum(values ...T) T
+	Read(r R) error
+}
+
+type Inline[T interface{ Deadline() time.Time }] interface {
+	Wait(v T)
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package unsafe_cgo
+
+// #include <stdlib.h>
+import "C"
+
+import (
+	"io"
+	"unsafe"
+)
+
+type Buffer interface {
+	io.Closer
+	Data() unsafe.Pointer
+	Resize(p unsafe.Pointer, n int) unsafe.Pointer
+	CSize() C.size_t
+	Free(p *C.char)
+	// Debug is only used by interactive tools.
+	//gsmock:skip
+	Debug(w io.Writer)
+}
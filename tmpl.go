@@ -32,40 +32,349 @@ import (
 {{.Imports}}
 )`))
 
+// tmplEmptyFile is a template for a generated Go file without any mock,
+// which is excluded from builds.
+var tmplEmptyFile = template.Must(template.New("").Parse(`
+// Code generated by gs-mock {{.ToolVersion}}. DO NOT EDIT.
+// Tool: https://github.com/go-spring/gs-mock
+// gs mock {{.ToolCommand}}
+
+//go:build ignore
+
+package {{.Package}}
+`))
+
+// tmplSetup is a template for a function setting up the mocks
+// reproducing the calls of a transcript.
+var tmplSetup = template.Must(template.New("").Parse(`
+// Code generated by gs-mock {{.ToolVersion}}. Edit it as needed.
+// Tool: https://github.com/go-spring/gs-mock
+// gs mock {{.ToolCommand}}
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+
+// setupMocks registers the mocks reproducing the calls recorded in {{.Transcript}}.
+func setupMocks({{if .UsesCtx}}ctx context.Context, {{end}}r *gsmock.Manager){{if .Mocks}} ({{range $i, $m := .Mocks}}{{if $i}}, {{end}}{{$m.Type}}{{end}}){{end}} {
+{{- range .Mocks}}
+	{{.Var}} := {{.Constructor}}(r)
+{{- end}}
+{{- range .Statements}}
+	{{.}}
+{{- end}}
+{{- if .Mocks}}
+	return {{range $i, $m := .Mocks}}{{if $i}}, {{end}}{{$m.Var}}{{end}}
+{{- end}}
+}
+`))
+
 // tmplInterface is a template for generating a mock implementation of an interface.
-var tmplInterface = template.Must(template.New("").Parse(`
-// {{.Name}}MockImpl is a generated mock implementation of the {{.Name}} interface.
-type {{.Name}}MockImpl{{.TypeParams}} struct {
+var tmplInterface = template.Must(template.New("").Funcs(template.FuncMap{
+	"toolVersion": func() string { return ToolVersion },
+}).Parse(`
+{{- if .SubsetOf}}
+
+// {{.Name}} is the subset of {{.SubsetOf}} made of the methods mocked below.
+type {{.Name}}{{.TypeParams}} interface {
+{{- range .Methods}}
+	{{.Name}}({{.Params}}){{.Results}}
+{{- end}}
+}
+{{- end}}
+
+// {{.MockType}} is a generated mock implementation of the {{.Name}} interface.
+type {{.MockType}}{{.TypeParams}} struct {
 	{{.EmbedInterfaces}}
+{{- range .Delegates}}
+	{{.Field}} {{.Type}} // implementation of the embedded {{.Type}}, see Set{{.Field}}
+{{- end}}
 	r *gsmock.Manager
 }
+{{- if .Methods}}
+
+// Names of the mocked methods of {{.Name}}, as reported by diagnostics and
+// transcripts, and as looked up by gsmock.Manager.CallsByName.
+const (
+{{- range .Methods}}
+	{{$.Name}}Method{{.Name}} = "{{.Name}}"
+{{- end}}
+)
+{{- end}}
 
-// New{{.Name}}MockImpl creates a new mock instance for {{.Name}} with the given
+// {{.Constructor}} creates a new mock instance for {{.Name}} with the given
 // gsmock.Manager. Returns an initialized struct ready for registering mock behavior.
-func New{{.Name}}MockImpl{{.TypeParams}}(r *gsmock.Manager) *{{.Name}}MockImpl{{.TypeParamNames}} {
-	return &{{.Name}}MockImpl{{.TypeParamNames}}{r: r}
+// It fails fast if the gsmock runtime is incompatible with the generated code.
+func {{.Constructor}}{{.TypeParams}}(r *gsmock.Manager) *{{.MockType}}{{.TypeParamNames}} {
+	r.RequireVersion("{{toolVersion}}")
+	gsmock.RegisterStamp[{{.SelfType}}]("{{.Stamp}}")
+	return &{{.MockType}}{{.TypeParamNames}}{r: r}
+}
+{{- if not .TypeParams}}
+
+func init() {
+	gsmock.RegisterMock(func(r *gsmock.Manager) {{.SelfType}} { return {{.Constructor}}(r) })
+}
+{{- end}}
+
+// {{.Name}}Stubs holds optional implementations of the methods of {{.Name}},
+// registered at once by {{.ApplyStubs}}.
+type {{.Name}}Stubs{{.TypeParams}} struct {
+{{- range .Methods}}
+	{{.Name}} func({{.Params}}){{.Results}}
+{{- end}}
+}
+
+// {{.ApplyStubs}} registers the non-nil functions of stubs as the Handle mocks
+// of their methods.
+func (impl *{{.MockType}}{{.TypeParamNames}}) {{.ApplyStubs}}(stubs {{.Name}}Stubs{{.TypeParamNames}}) {
+{{- range .Methods}}
+	if stubs.{{.Name}} != nil {
+	{{- if .Tuple}}
+		impl.{{.MockName}}().Handle({{.StubHandler (print $.MockType .Name) $.TypeParamNames}})
+	{{- else if .VarParams}}
+		impl.{{.MockName}}().Handle(func({{.VarParams}}){{.ResultTypes}} {
+			{{if .ResultTypes}}return {{end}}stubs.{{.Name}}({{.ParamNames}}...)
+		})
+	{{- else}}
+		impl.{{.MockName}}().Handle(stubs.{{.Name}})
+	{{- end}}
+	}
+{{- end}}
+}
+{{- range $d := .Delegates}}
+
+// Set{{$d.Field}} sets the implementation of the embedded {{$d.Type}} interface,
+// such as its mock, to which the methods of {{$d.Type}} are delegated.
+func (impl *{{$.MockType}}{{$.TypeParamNames}}) Set{{$d.Field}}(v {{$d.Type}}) {
+	impl.{{$d.Field}} = v
 }
+{{- range $d.Methods}}
+
+// {{.Name}} delegates to the {{$d.Field}} field, set by Set{{$d.Field}}.
+func (impl *{{$.MockType}}{{$.TypeParamNames}}) {{.Name}}({{.Params}}){{.Results}} {
+	if impl.{{$d.Field}} == nil {
+		panic("{{$.MockType}}.{{$d.Field}} not set; call Set{{$d.Field}} or mock {{$d.Type}}")
+	}
+	{{if .Results}}return {{end}}impl.{{$d.Field}}.{{.Name}}({{.Args}})
+}
+{{- end}}
+{{- end}}
+`))
+
+// tmplFuncVar is a template for generating the mock of a function variable.
+var tmplFuncVar = template.Must(template.New("").Parse(`{{$p := .m.MockName}}
+// {{.i.FuncVar}} substitutes a mock for the {{.m.Name}} function variable, see {{.m.MockName}}.
+type {{.i.FuncVar}} struct {
+	r    *gsmock.Manager
+	orig func({{.m.Params}}){{.m.Results}}
+}
+
+//go:noinline
+func (impl *{{.i.FuncVar}}) funcCall() func({{.m.Params}}){{.m.Results}} {
+	return impl.call
+}
+
+// call calls the registered mock for {{.m.Name}} via gsmock.InvokeBoxed.
+// If no matching mock is registered, it calls the original function,
+// and panics if there is none.
+func (impl *{{.i.FuncVar}}) call({{.m.Params}}){{.m.Results}} {
+	if {{if .m.ResultTmplTypes}} ret {{else}} _ {{end}}, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcCall(), {{if .m.ParamNames}} gsmock.Box({{.m.ParamNames}}) {{else}} nil {{end}}); ok {
+		{{- if .m.ResultTmplTypes}}
+		defer gsmock.Release(ret)
+		{{- end}}
+		return {{if .m.ResultTmplTypes}} {{.m.Unbox $p ""}}{{end}}
+	}
+	if impl.orig == nil {
+		panic("no mock code matched for {{.m.Name}}, whose original function is nil")
+	}
+	{{if .m.ResultTmplTypes}}return {{end}}impl.orig({{.m.ParamNames}}{{if .m.VariadicFlag}}...{{end}})
+}
+
+// {{.m.MockName}} substitutes a mock driven by r for the {{.m.Name}} function
+// variable until the test t completes, and returns the {{.m.MockerName}}
+// registering its behavior. Unmatched calls go to the original function.
+func {{.m.MockName}}(t gsmock.TB, r *gsmock.Manager) *gsmock.{{.m.MockerType $p ""}} {
+	impl := &{{.i.FuncVar}}{r: r, orig: {{.m.Name}}}
+	gsmock.Swap(t, &{{.m.Name}}, impl.call)
+	return gsmock.{{.m.MockerCtor "Method" $p ""}}(impl, impl.funcCall(), r)
+}
+{{.m.TupleDecls .m.Name $p "" ""}}`))
+
+// tmplFuncType is a template for generating the mock of a function type,
+// whose function value is returned by the Func method of the mock.
+var tmplFuncType = template.Must(template.New("").Funcs(template.FuncMap{
+	"toolVersion": func() string { return ToolVersion },
+}).Parse(`{{$p := print .i.MockType .m.Name}}
+// {{.i.MockType}} is a generated mock of the {{.i.Name}} function type, see {{.m.MockName}}.
+type {{.i.MockType}}{{.i.TypeParams}} struct {
+	r *gsmock.Manager
+}
+
+// {{.i.Constructor}} creates a new mock of the {{.i.Name}} function type with the
+// given gsmock.Manager. The function returned by Func calls its mocks.
+// It fails fast if the gsmock runtime is incompatible with the generated code.
+func {{.i.Constructor}}{{.i.TypeParams}}(r *gsmock.Manager) *{{.i.MockType}}{{.i.TypeParamNames}} {
+	r.RequireVersion("{{toolVersion}}")
+	return &{{.i.MockType}}{{.i.TypeParamNames}}{r: r}
+}
+
+//go:noinline
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) funcCall() func({{.m.Params}}){{.m.Results}} {
+	return impl.call
+}
+
+// call calls the registered mock for {{.i.Name}} via gsmock.InvokeBoxed.
+// If no matching mock is registered, it panics.
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) call({{.m.Params}}){{.m.Results}} {
+	if {{if .m.ResultTmplTypes}} ret {{else}} _ {{end}}, ok := gsmock.InvokeBoxed(impl.r, impl, impl.funcCall(), {{if .m.ParamNames}} gsmock.Box({{.m.ParamNames}}) {{else}} nil {{end}}); ok {
+		{{- if .m.ResultTmplTypes}}
+		defer gsmock.Release(ret)
+		{{- end}}
+		return {{if .m.ResultTmplTypes}} {{.m.Unbox $p .i.TypeParamNames}}{{end}}
+	}
+	panic("no mock code matched for {{.i.MockType}}")
+}
+
+// Func returns the {{.i.Name}} function calling the mocks registered by {{.m.MockName}}.
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) Func() {{.i.SelfType}} {
+	return impl.call
+}
+
+// {{.m.MockName}} returns a {{.m.MockerName}}
+// for registering mock behavior of the function returned by Func.
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) {{.m.MockName}}() *gsmock.{{.m.MockerType $p .i.TypeParamNames}} {
+	return gsmock.{{.m.MockerCtor "Method" $p .i.TypeParamNames}}(impl, impl.funcCall(), impl.r)
+}
+{{.m.TupleDecls .i.Name $p .i.TypeParams .i.TypeParamNames}}`))
+
+// tmplFunc is a template for generating the mock of a function called
+// with a context, which is dispatched by gsmock.InvokeContext.
+var tmplFunc = template.Must(template.New("").Parse(`{{$p := .m.MockName}}
+// {{.m.MockName}} returns the {{.m.MockerName}} registering the behavior of the {{.i.Func}}
+// function for the calls whose context is bound to r by gsmock.WithManager.
+func {{.m.MockName}}(r *gsmock.Manager) *gsmock.{{.m.MockerType $p ""}} {
+	return gsmock.{{.m.MockerCtor "Func" $p ""}}({{.i.Func}}, r)
+}
+{{.m.TupleDecls .i.Func $p "" ""}}`))
+
+// tmplAlias is a template for aliasing the mock of an interface
+// generated in another package, as recorded by the mock registry.
+var tmplAlias = template.Must(template.New("").Parse(`
+// {{.MockType}} is the mock of the {{.Name}} interface generated in package {{.MockPackage}}.
+type {{.MockType}}{{.TypeParams}} = {{.MockPackage}}.{{.MockType}}{{.TypeParamNames}}
+
+// {{.Constructor}} creates a new mock instance for {{.Name}} with the given gsmock.Manager.
+func {{.Constructor}}{{.TypeParams}}(r *gsmock.Manager) *{{.MockType}}{{.TypeParamNames}} {
+	return {{.MockPackage}}.{{.Constructor}}{{.TypeParamNames}}(r)
+}
+
+// {{.Name}}Stubs holds optional implementations of the methods of {{.Name}}.
+type {{.Name}}Stubs{{.TypeParams}} = {{.MockPackage}}.{{.Name}}Stubs{{.TypeParamNames}}
+{{- if .Methods}}
+
+// Names of the mocked methods of {{.Name}}.
+const (
+{{- range .Methods}}
+	{{$.Name}}Method{{.Name}} = {{$.MockPackage}}.{{$.Name}}Method{{.Name}}
+{{- end}}
+)
+{{- end}}
+`))
+
+// tmplInstances is a template for the named aliases of the common
+// instantiations of the mock of a generic interface.
+var tmplInstances = template.Must(template.New("").Parse(`
+{{- range .Instances}}
+
+// {{.Name}} is the mock of {{$.Name}}{{.TypeArgs}}.
+type {{.Name}} = {{$.MockType}}{{.TypeArgs}}
+
+// {{.Constructor}} creates a new mock instance for {{$.Name}}{{.TypeArgs}} with the given gsmock.Manager.
+func {{.Constructor}}(r *gsmock.Manager) *{{.Name}} {
+	return {{$.Constructor}}{{.TypeArgs}}(r)
+}
+{{- if not $.FuncType}}
+
+func init() {
+	gsmock.RegisterMock(func(r *gsmock.Manager) {{.SelfType}} { return {{.Constructor}}(r) })
+}
+{{- end}}
+{{- end}}
 `))
 
 // tmplMethod is a template for generating a mock method implementation.
-var tmplMethod = template.Must(template.New("").Parse(`
+var tmplMethod = template.Must(template.New("").Parse(`{{$p := print .i.MockType .m.Name}}
 //go:noinline
-func (impl *{{.i.Name}}MockImpl{{.i.TypeParamNames}}) func{{.m.Name}}() func({{.m.Params}}){{.m.ResultTypes}}{
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) func{{.m.Name}}() func({{.m.Params}}){{.m.Results}}{
 	return impl.{{.m.Name}}
 }
 
-// {{.m.Name}} calls the registered mock for {{.m.Name}} via gsmock.Invoke.
-// If no matching mock is registered, it panics.
-func (impl *{{.i.Name}}MockImpl{{.i.TypeParamNames}}) {{.m.Name}}({{.m.Params}}){{.m.ResultTypes}}{
-	if {{if .m.ResultTmplTypes}} ret {{else}} _ {{end}}, ok := gsmock.Invoke(impl.r, impl, impl.func{{.m.Name}}(), {{.m.ParamNames}}); ok {
-		return {{if .m.ResultTmplTypes}} gsmock.Unbox{{.m.ResultCount}}{{.m.ResultTmplTypes}}(ret){{end}}
+// {{.m.Name}} calls the registered mock for {{.m.Name}} via gsmock.InvokeBoxed.
+{{- if .m.Fallback}}
+// If no matching mock is registered, it falls back to the gRPC default behavior.
+{{- else}}
+// If no matching mock is registered, it panics with gsmock.Unmatched.
+{{- end}}
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) {{.m.Name}}({{.m.Params}}){{.m.Results}}{
+	if {{if .m.ResultTmplTypes}} ret {{else}} _ {{end}}, ok := gsmock.InvokeBoxed(impl.r, impl, impl.func{{.m.Name}}(), {{if .m.ParamNames}} gsmock.Box({{.m.ParamNames}}) {{else}} nil {{end}}); ok {
+		{{- if .m.ResultTmplTypes}}
+		defer gsmock.Release(ret)
+		{{- end}}
+		return {{if .m.ResultTmplTypes}} {{.m.Unbox $p .i.TypeParamNames}}{{end}}
 	}
-	panic("no mock code matched for {{.i.Name}}MockImpl.{{.m.Name}}")
+	{{if .m.Fallback}}{{.m.Fallback}}{{else}}panic(gsmock.Unmatched[{{.i.SelfType}}]("{{.i.MockType}}." + {{.i.Name}}Method{{.m.Name}}, "{{.i.Stamp}}")){{end}}
 }
 
-// Mock{{.m.Name}} returns a {{.m.VariadicFlag}}Mocker{{.m.ParamCount}}{{.m.ResultCount}}
+// {{.m.ExpectNoName}} forbids any call to {{.m.Name}}: if one occurs, the test
+// fails immediately. Mocks of {{.m.Name}} registered earlier take precedence.
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) {{.m.ExpectNoName}}() {
+	impl.{{.m.MockName}}().Never()
+}
+
+// {{.m.MockName}} returns a {{.m.MockerName}}
 // for registering mock behavior of {{.m.Name}} with specific parameter and return types.
-func (impl *{{.i.Name}}MockImpl{{.i.TypeParamNames}}) Mock{{.m.Name}}() *gsmock.{{.m.VariadicFlag}}Mocker{{.m.ParamCount}}{{.m.ResultCount}}{{.m.MockerTmplTypes}} {
-	return gsmock.{{.m.VariadicFlag}}Method{{.m.ParamCount}}{{.m.ResultCount}}(impl, impl.func{{.m.Name}}(), impl.r)
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) {{.m.MockName}}() *gsmock.{{.m.MockerType $p .i.TypeParamNames}} {
+	return gsmock.{{.m.MockerCtor "Method" $p .i.TypeParamNames}}(impl, impl.func{{.m.Name}}(), impl.r)
 }
-`))
+{{- if .m.ReturnsName}}
+
+// {{.m.ReturnsName}} registers a mock of {{.m.Name}} that returns
+// {{.m.ReturnsDesc}}.
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) {{.m.ReturnsName}}({{.m.ReturnsParams}}) {
+	impl.{{.m.MockName}}().ReturnValue({{.m.ReturnsValue}})
+}
+{{- end}}
+{{- if .m.ReturnSelfName}}
+
+// {{.m.ReturnSelfName}} registers a mock of {{.m.Name}} that returns the mock
+// itself, so that fluent call chains keep calling this mock.
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) {{.m.ReturnSelfName}}() {
+	impl.{{.m.MockName}}().ReturnValue(impl)
+}
+{{- end}}
+{{- if .m.PagesName}}
+
+// {{.m.PagesName}} registers a mock of {{.m.Name}} that serves the pages in
+// sequence, following the cursor it returns. The last page returns a zero
+// cursor, unless finalErr is not nil, which the next call then returns.
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) {{.m.PagesName}}(pages [][]{{.m.PagesElem}}, finalErr error) {
+	p := gsmock.NewPager{{.m.PagesTypes}}(pages, finalErr)
+	impl.{{.m.MockName}}().Handle(func({{.m.Params}}) {{.m.ResultTypes}} {
+		return p.Page({{.m.PagesCursor}})
+	})
+}
+{{- end}}
+{{- if .m.StubName}}
+
+// {{.m.StubName}} returns a stub of {{.m.Name}} for the calls whose request
+// matches req, responding as set by its Respond or Fail method.
+func (impl *{{.i.MockType}}{{.i.TypeParamNames}}) {{.m.StubName}}(req {{.m.StubReq}}) *gsmock.Stub{{.m.StubTypes}} {
+	return gsmock.NewStub(impl.{{.m.MockName}}(), req)
+}
+{{- end}}
+{{.m.TupleDecls (print .i.Name "." .m.Name) $p .i.TypeParams .i.TypeParamNames}}`))
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// tupleField is a field of the tuple struct holding the parameters or the
+// results of a method mocked by gsmock.MockerT.
+type tupleField struct {
+	Name string
+	Type string
+}
+
+// tupleFields returns the fields of a tuple struct holding values of the
+// given types. Named values give their names to the fields, capitalized,
+// unless they don't make distinct exported names, and the fields are
+// otherwise named after their position, e.g. A1, A2 with prefix "A".
+// Variadic parameters are held as slices.
+func tupleFields(names []string, types []string, named bool, prefix string) []tupleField {
+	fields := make([]tupleField, len(types))
+	used := make(map[string]struct{})
+	for k, t := range types {
+		if rest, ok := strings.CutPrefix(t, "..."); ok {
+			t = "[]" + rest
+		}
+		fields[k] = tupleField{Name: fmt.Sprint(prefix, k+1), Type: t}
+		if !named {
+			continue
+		}
+		name := upperFirst(names[k])
+		if _, ok := used[name]; ok || !ast.IsExported(name) {
+			named = false
+			continue
+		}
+		used[name] = struct{}{}
+		fields[k].Name = name
+	}
+	if !named { // keep the positional names for all the fields
+		for k := range fields {
+			fields[k].Name = fmt.Sprint(prefix, k+1)
+		}
+	}
+	return fields
+}
+
+// MockerName returns the name of the gsmock mocker type of the method,
+// e.g. "Mocker12", "VarMocker21" or "MockerT".
+func (m Method) MockerName() string {
+	if m.Tuple {
+		return "MockerT"
+	}
+	return fmt.Sprintf("%sMocker%d%d", m.VariadicFlag, m.ParamCount, m.ResultCount)
+}
+
+// MockerType returns the gsmock mocker type of the method, with its type
+// arguments. The tuple structs of the method are named after prefix and
+// instantiated with typeArgs, the type parameters of their interface.
+func (m Method) MockerType(prefix string, typeArgs string) string {
+	return m.MockerName() + m.tupleTypes(prefix, typeArgs)
+}
+
+// MockerCtor returns the gsmock function creating the mocker of the method,
+// kind being "Method" or "Func", e.g. "VarMethod12" or "FuncT[FooArgs, FooResults]".
+func (m Method) MockerCtor(kind string, prefix string, typeArgs string) string {
+	if m.Tuple {
+		return kind + "T" + m.tupleTypes(prefix, typeArgs)
+	}
+	return fmt.Sprintf("%s%s%d%d", m.VariadicFlag, kind, m.ParamCount, m.ResultCount)
+}
+
+// Unbox returns the expression of the results of the method, unboxed from
+// the result slice ret.
+func (m Method) Unbox(prefix string, typeArgs string) string {
+	if m.Tuple {
+		return fmt.Sprintf("gsmock.UnboxT[%sResults%s](ret).values()", prefix, typeArgs)
+	}
+	return fmt.Sprintf("gsmock.Unbox%d%s(ret)", m.ResultCount, m.ResultTmplTypes)
+}
+
+// tupleTypes returns the type arguments of gsmock.MockerT for the tuple
+// structs of the method, or "" if it uses a numbered mocker.
+func (m Method) tupleTypes(prefix string, typeArgs string) string {
+	if !m.Tuple {
+		return m.MockerTmplTypes
+	}
+	return fmt.Sprintf("[%sArgs%s, %sResults%s]", prefix, typeArgs, prefix, typeArgs)
+}
+
+// TupleDecls returns the declarations of the tuple structs of the method,
+// named after prefix and declared with the type parameters typeParams, and
+// of the method returning the results in order, or "" if the method uses a
+// numbered mocker.
+func (m Method) TupleDecls(desc string, prefix string, typeParams string, typeArgs string) string {
+	if !m.Tuple {
+		return ""
+	}
+	var b strings.Builder
+	decl := func(name string, what string, fields []tupleField) {
+		fmt.Fprintf(&b, "\n// %s holds the %s of %s, mocked by gsmock.MockerT.\n", name, what, desc)
+		fmt.Fprintf(&b, "type %s%s struct {\n", name, typeParams)
+		for _, f := range fields {
+			fmt.Fprintf(&b, "\t%s %s\n", f.Name, f.Type)
+		}
+		b.WriteString("}\n")
+	}
+	decl(prefix+"Args", "parameters", m.ArgFields)
+	decl(prefix+"Results", "results", m.ResultFields)
+
+	values := make([]string, len(m.ResultFields))
+	for k, f := range m.ResultFields {
+		values[k] = "r." + f.Name
+	}
+	fmt.Fprintf(&b, "\n// values returns the results in order.\n")
+	fmt.Fprintf(&b, "func (r %sResults%s) values() %s {\n", prefix, typeArgs, m.ResultTypes)
+	if len(values) > 0 {
+		fmt.Fprintf(&b, "\treturn %s\n", strings.Join(values, ", "))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// StubHandler returns the handler registered by ApplyStubs for the stub
+// of the method, calling it with the fields of the parameter tuple.
+func (m Method) StubHandler(prefix string, typeArgs string) string {
+	args := make([]string, len(m.ArgFields))
+	for k, f := range m.ArgFields {
+		args[k] = "args." + f.Name
+	}
+	call := fmt.Sprintf("stubs.%s(%s", m.Name, strings.Join(args, ", "))
+	if m.VariadicFlag != "" {
+		call += "..."
+	}
+	call += ")"
+	if len(m.ResultFields) > 0 {
+		res := make([]string, len(m.ResultFields))
+		for k, f := range m.ResultFields {
+			res[k] = "res." + f.Name
+		}
+		call = strings.Join(res, ", ") + " = " + call
+	}
+	return fmt.Sprintf("func(args %sArgs%s) (res %sResults%s) {\n\t\t\t%s\n\t\t\treturn\n\t\t}",
+		prefix, typeArgs, prefix, typeArgs, call)
+}
@@ -0,0 +1,239 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// verifyMain runs the verify subcommand with its command-line arguments,
+// which are the flags of the generation, plus --fix. It exits with status
+// 1 if the mocks are stale and weren't fixed.
+func verifyMain(args []string) {
+	var fix bool
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	addGenerationFlags(fs)
+	fs.BoolVar(&fix, "fix", false, "Regenerate the stale mocks, and write TODO-marked stubs of the methods added to their interfaces into '<output>_delta.go'.")
+	_ = fs.Parse(args)
+	if !runVerify(flagsConfig(), fix) {
+		os.Exit(1)
+	}
+}
+
+// runVerify checks that the output file of the generation is up to date,
+// and reports the methods the interfaces gained since, which their mocks
+// lack, so that calls to them panic. If fix is true, it regenerates the
+// file, and appends to "<output>_delta.go" a <Interface>DefaultStubsDelta
+// function per interface, registering ReturnDefault stubs of the added
+// methods, marked with TODO comments for the test owners to review. It
+// returns whether the file was up to date or fixed.
+func runVerify(param runConfig, fix bool) bool {
+	if len(param.OutputFile) == 0 || len(param.SetupFrom) > 0 {
+		panic("verify requires the output file of the mocks given by -o")
+	}
+	dir := param.SourceDir
+	if len(param.DestDir) > 0 {
+		dir = param.DestDir
+	}
+	file := filepath.Join(dir, param.OutputFile)
+	old, err := os.ReadFile(file)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		panic(fmt.Errorf("error reading file(%s): %w", file, err))
+	}
+
+	b, interfaces := generateInterfaces(param)
+	if bytes.Equal(old, b) {
+		_, _ = fmt.Fprintf(stdOut, "%s is up to date\n", file)
+		return true
+	}
+
+	deltas := addedMethods(interfaces, mockMethods(old))
+	if !fix {
+		_, _ = fmt.Fprintf(stdOut, "%s is stale; run go generate, or gs-mock verify --fix\n", file)
+		for _, d := range deltas {
+			_, _ = fmt.Fprintf(stdOut, "interface %s gained %s, which its mock would panic on\n",
+				d.Interface.Name, strings.Join(d.Names(), ", "))
+		}
+		return false
+	}
+
+	writeFile(dir, param.OutputFile, b)
+	_, _ = fmt.Fprintf(stdOut, "regenerated %s\n", file)
+	if len(deltas) > 0 {
+		deltaFile := strings.TrimSuffix(file, ".go") + "_delta.go"
+		writeDelta(deltaFile, packageOf(b), deltas)
+		_, _ = fmt.Fprintf(stdOut, "wrote the TODO stubs of the added methods to %s\n", deltaFile)
+	}
+	return true
+}
+
+// stubsDelta lists the methods an interface gained since its mock was
+// last generated.
+type stubsDelta struct {
+	Interface Interface
+	Methods   []Method
+}
+
+// Names returns the names of the added methods.
+func (d stubsDelta) Names() []string {
+	names := make([]string, len(d.Methods))
+	for k, m := range d.Methods {
+		names[k] = m.Name
+	}
+	return names
+}
+
+// Func returns the name of the function stubbing the added methods.
+func (d stubsDelta) Func() string {
+	return helperName("", d.Interface.Name) + "DefaultStubsDelta"
+}
+
+// mockMethods returns the names of the methods of the mock types declared
+// by the generated code src, by mock type.
+func mockMethods(src []byte) map[string]map[string]bool {
+	ret := make(map[string]map[string]bool)
+	if len(src) == 0 {
+		return ret
+	}
+	node, err := parser.ParseFile(token.NewFileSet(), "", src, parser.SkipObjectResolution)
+	if err != nil {
+		return ret // rewritten anyway
+	}
+	for _, decl := range node.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil {
+			continue
+		}
+		t := fd.Recv.List[0].Type
+		if star, ok := t.(*ast.StarExpr); ok {
+			t = star.X
+		}
+		switch x := t.(type) {
+		case *ast.IndexExpr:
+			t = x.X
+		case *ast.IndexListExpr:
+			t = x.X
+		}
+		if id, ok := t.(*ast.Ident); ok {
+			if ret[id.Name] == nil {
+				ret[id.Name] = make(map[string]bool)
+			}
+			ret[id.Name][fd.Name.Name] = true
+		}
+	}
+	return ret
+}
+
+// addedMethods returns the methods of the interfaces whose Mock methods
+// the previously generated mock types lack. Interfaces without previous
+// mock, and those whose mocks aren't generated in the file, are ignored.
+func addedMethods(interfaces []Interface, old map[string]map[string]bool) []stubsDelta {
+	var ret []stubsDelta
+	for _, i := range interfaces {
+		methods, ok := old[i.MockType]
+		if !ok || i.MockPackage != "" || i.FuncVar != "" || i.Func != "" || i.FuncType {
+			continue
+		}
+		d := stubsDelta{Interface: i}
+		for _, m := range i.Methods {
+			if !methods[m.MockName] {
+				d.Methods = append(d.Methods, m)
+			}
+		}
+		if len(d.Methods) > 0 {
+			ret = append(ret, d)
+		}
+	}
+	return ret
+}
+
+// packageOf returns the package name of the Go source src.
+func packageOf(src []byte) string {
+	node, err := parser.ParseFile(token.NewFileSet(), "", src, parser.PackageClauseOnly)
+	if err != nil {
+		panic(fmt.Errorf("error parsing generated code: %w", err))
+	}
+	return node.Name.Name
+}
+
+// writeDelta appends the functions stubbing the added methods to the delta
+// file, created with the package clause pkg if needed. It panics if the
+// file already declares one of them, left over by a previous fix.
+func writeDelta(deltaFile string, pkg string, deltas []stubsDelta) {
+	src, err := os.ReadFile(deltaFile)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		panic(fmt.Errorf("error reading file(%s): %w", deltaFile, err))
+	}
+	if len(src) > 0 {
+		node, err := parser.ParseFile(token.NewFileSet(), deltaFile, src, parser.SkipObjectResolution)
+		if err != nil {
+			panic(fmt.Errorf("error parsing file(%s): %w", deltaFile, err))
+		}
+		for _, d := range deltas {
+			if slices.ContainsFunc(node.Decls, func(decl ast.Decl) bool {
+				fd, ok := decl.(*ast.FuncDecl)
+				return ok && fd.Name.Name == d.Func()
+			}) {
+				panic(fmt.Sprintf("%s already declares %s: review its stubs and remove it first", deltaFile, d.Func()))
+			}
+		}
+	}
+
+	s := bytes.NewBuffer(src)
+	if len(src) == 0 {
+		if err = tmplDeltaHeader.Execute(s, map[string]any{"Package": pkg}); err != nil {
+			panic(fmt.Errorf("error executing template(delta header): %w", err))
+		}
+	}
+	for _, d := range deltas {
+		if err = tmplDelta.Execute(s, d); err != nil {
+			panic(fmt.Errorf("error executing template(delta#%s): %w", d.Interface.Name, err))
+		}
+	}
+	writeFile(filepath.Dir(deltaFile), filepath.Base(deltaFile), formatSource(s.Bytes()))
+}
+
+var tmplDeltaHeader = template.Must(template.New("").Parse(`
+// Stubs written by gs-mock verify --fix for the methods added to the mocked
+// interfaces, which their mocks would otherwise panic on. Call them where
+// the mocks are created, review the TODOs and remove them once done.
+
+package {{.Package}}
+`))
+
+var tmplDelta = template.Must(template.New("").Parse(`
+// {{.Func}} stubs the methods added to {{.Interface.Name}} since its
+// mock was last generated, returning their default values.
+func {{.Func}}{{.Interface.TypeParams}}(impl *{{.Interface.MockType}}{{.Interface.TypeParamNames}}) {
+	{{- range .Methods}}
+	// TODO(gs-mock): stub {{.Name}}, added to {{$.Interface.Name}}.
+	impl.{{.MockName}}().ReturnDefault()
+	{{- end}}
+}
+`))